@@ -0,0 +1,188 @@
+// Package analytics collects thread impressions, detail views and profile clicks as requests are served
+// and sends them to thread-service in batches, so recording a view never adds a round trip to a request.
+package analytics
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Event kinds, matching thread-service's analytics package.
+const (
+	KindImpression   = "impression"
+	KindDetailView   = "detail_view"
+	KindProfileClick = "profile_click"
+)
+
+type Event struct {
+	ThreadID  uint32
+	ViewerID  uint32 // 0 for signed-out viewers
+	ViewerKey string
+	Kind      string
+	At        time.Time
+}
+
+// ViewerKey identifies a viewer for deduplication: their user ID when signed in, otherwise a hash of
+// their IP address and user agent so the raw values are never stored.
+func ViewerKey(userID uint32, clientIP, userAgent string) string {
+	if userID != 0 {
+		return fmt.Sprintf("u:%d", userID)
+	}
+	sum := sha256.Sum256([]byte(clientIP + "|" + userAgent))
+	return "a:" + hex.EncodeToString(sum[:16])
+}
+
+// Sink delivers one batch of events.
+type Sink func(ctx context.Context, events []Event) error
+
+type Config struct {
+	FlushInterval time.Duration
+	MaxBatch      int // events per Sink call; reaching it triggers a flush
+	MaxPending    int // events held while the sink is slow; more are dropped
+}
+
+func DefaultConfig() Config {
+	return Config{
+		FlushInterval: 10 * time.Second,
+		MaxBatch:      500,
+		MaxPending:    20000,
+	}
+}
+
+// ConfigFromEnv starts from DefaultConfig and applies ANALYTICS_* overrides.
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig()
+	if v, err := strconv.Atoi(os.Getenv("ANALYTICS_FLUSH_INTERVAL_SECONDS")); err == nil && v > 0 {
+		cfg.FlushInterval = time.Duration(v) * time.Second
+	}
+	if v, err := strconv.Atoi(os.Getenv("ANALYTICS_MAX_PENDING")); err == nil && v > 0 {
+		cfg.MaxPending = v
+	}
+	return cfg
+}
+
+// Batcher buffers events and drops repeats of the same viewer, thread and kind within an hour before
+// they leave the gateway. thread-service deduplicates again, so a repeat that slips through (after a
+// restart, or from another gateway instance) is harmless.
+type Batcher struct {
+	sink  Sink
+	cfg   Config
+	now   func() time.Time
+	flush chan struct{}
+
+	mu      sync.Mutex
+	pending []Event
+	seen    map[string]int64 // dedupe key -> hour it was seen in
+}
+
+func NewBatcher(sink Sink, cfg Config) *Batcher {
+	def := DefaultConfig()
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = def.FlushInterval
+	}
+	if cfg.MaxBatch <= 0 {
+		cfg.MaxBatch = def.MaxBatch
+	}
+	if cfg.MaxPending < cfg.MaxBatch {
+		cfg.MaxPending = cfg.MaxBatch
+	}
+	return &Batcher{
+		sink:  sink,
+		cfg:   cfg,
+		now:   time.Now,
+		flush: make(chan struct{}, 1),
+		seen:  make(map[string]int64),
+	}
+}
+
+// Record queues events without blocking. It is safe to call on a nil Batcher, which records nothing.
+func (b *Batcher) Record(events ...Event) {
+	if b == nil {
+		return
+	}
+	now := b.now()
+	hour := now.UTC().Truncate(time.Hour).Unix()
+
+	b.mu.Lock()
+	for _, e := range events {
+		if e.ThreadID == 0 || e.ViewerKey == "" {
+			continue
+		}
+		key := fmt.Sprintf("%d:%s:%s", e.ThreadID, e.ViewerKey, e.Kind)
+		if seenHour, ok := b.seen[key]; ok && seenHour == hour {
+			continue
+		}
+		if len(b.pending) >= b.cfg.MaxPending {
+			log.Printf("Analytics: %d events pending, dropping new events", len(b.pending))
+			break
+		}
+		b.seen[key] = hour
+		if e.At.IsZero() {
+			e.At = now
+		}
+		b.pending = append(b.pending, e)
+	}
+	full := len(b.pending) >= b.cfg.MaxBatch
+	b.mu.Unlock()
+
+	if full {
+		select {
+		case b.flush <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Flush sends everything pending in batches of cfg.MaxBatch and forgets dedupe keys from past hours.
+// A batch the sink rejects is dropped; impressions are best-effort.
+func (b *Batcher) Flush(ctx context.Context) {
+	hour := b.now().UTC().Truncate(time.Hour).Unix()
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = nil
+	for key, seenHour := range b.seen {
+		if seenHour != hour {
+			delete(b.seen, key)
+		}
+	}
+	b.mu.Unlock()
+
+	for start := 0; start < len(pending); start += b.cfg.MaxBatch {
+		end := start + b.cfg.MaxBatch
+		if end > len(pending) {
+			end = len(pending)
+		}
+		if err := b.sink(ctx, pending[start:end]); err != nil {
+			log.Printf("Analytics: Failed to send %d events: %v", end-start, err)
+		}
+	}
+}
+
+// Start blocks until ctx is cancelled, flushing every cfg.FlushInterval or as soon as a batch is full.
+// Whatever is pending at shutdown is flushed one last time.
+func (b *Batcher) Start(ctx context.Context) {
+	log.Printf("Analytics batcher started (interval: %v, batch: %d)", b.cfg.FlushInterval, b.cfg.MaxBatch)
+	ticker := time.NewTicker(b.cfg.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			b.Flush(shutdownCtx)
+			cancel()
+			log.Println("Analytics batcher stopped.")
+			return
+		case <-ticker.C:
+			b.Flush(ctx)
+		case <-b.flush:
+			b.Flush(ctx)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+type recordingSink struct {
+	batches [][]Event
+	err     error
+}
+
+func (s *recordingSink) send(ctx context.Context, events []Event) error {
+	s.batches = append(s.batches, append([]Event(nil), events...))
+	return s.err
+}
+
+func (s *recordingSink) total() int {
+	n := 0
+	for _, batch := range s.batches {
+		n += len(batch)
+	}
+	return n
+}
+
+func newTestBatcher(sink *recordingSink, cfg Config, now *time.Time) *Batcher {
+	b := NewBatcher(sink.send, cfg)
+	b.now = func() time.Time { return *now }
+	return b
+}
+
+func TestBatcher_DedupesWithinAnHour(t *testing.T) {
+	sink := &recordingSink{}
+	now := testNow.Add(10 * time.Minute)
+	b := newTestBatcher(sink, DefaultConfig(), &now)
+
+	view := Event{ThreadID: 1, ViewerKey: "u:2", Kind: KindImpression}
+	b.Record(view, view, Event{ThreadID: 1, ViewerKey: "u:2", Kind: KindDetailView})
+	now = testNow.Add(50 * time.Minute)
+	b.Record(view)
+	b.Flush(context.Background())
+	require.Len(t, sink.batches, 1)
+	assert.Len(t, sink.batches[0], 2, "one impression and one detail view")
+	assert.Equal(t, testNow.Add(10*time.Minute), sink.batches[0][0].At, "events are stamped when recorded")
+
+	b.Record(view)
+	b.Flush(context.Background())
+	assert.Equal(t, 2, sink.total(), "still deduped after a flush in the same hour")
+
+	now = testNow.Add(time.Hour)
+	b.Record(view)
+	b.Flush(context.Background())
+	assert.Equal(t, 3, sink.total(), "counted again in the next hour")
+}
+
+func TestBatcher_SkipsIncompleteEvents(t *testing.T) {
+	sink := &recordingSink{}
+	b := newTestBatcher(sink, DefaultConfig(), &testNow)
+
+	b.Record(Event{ViewerKey: "u:2", Kind: KindImpression}, Event{ThreadID: 1, Kind: KindImpression})
+	b.Flush(context.Background())
+	assert.Empty(t, sink.batches, "nothing to send")
+}
+
+func TestBatcher_SplitsAndCapsBatches(t *testing.T) {
+	sink := &recordingSink{err: errors.New("unavailable")}
+	b := newTestBatcher(sink, Config{FlushInterval: time.Minute, MaxBatch: 2, MaxPending: 3}, &testNow)
+
+	for id := uint32(1); id <= 5; id++ {
+		b.Record(Event{ThreadID: id, ViewerKey: "u:2", Kind: KindImpression})
+	}
+	select {
+	case <-b.flush:
+	default:
+		t.Fatal("a full batch should trigger a flush")
+	}
+
+	b.Flush(context.Background())
+	require.Len(t, sink.batches, 2)
+	assert.Len(t, sink.batches[0], 2)
+	assert.Len(t, sink.batches[1], 1, "events past MaxPending are dropped")
+
+	b.Flush(context.Background())
+	assert.Len(t, sink.batches, 2, "failed batches aren't retried")
+}
+
+func TestViewerKey(t *testing.T) {
+	assert.Equal(t, "u:7", ViewerKey(7, "1.2.3.4", "agent"))
+
+	anon := ViewerKey(0, "1.2.3.4", "agent")
+	assert.Equal(t, anon, ViewerKey(0, "1.2.3.4", "agent"))
+	assert.NotEqual(t, anon, ViewerKey(0, "1.2.3.5", "agent"))
+	assert.NotContains(t, anon, "1.2.3.4")
+}
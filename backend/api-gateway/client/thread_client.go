@@ -33,6 +33,10 @@ func (c *ThreadClient) CreateThread(ctx context.Context, req *threadpb.CreateThr
 	return c.client.CreateThread(ctx, req)
 }
 
+func (c *ThreadClient) CreateThreadChain(ctx context.Context, req *threadpb.CreateThreadChainRequest) (*threadpb.CreateThreadChainResponse, error) {
+	return c.client.CreateThreadChain(ctx, req)
+}
+
 func (c *ThreadClient) GetThread(ctx context.Context, req *threadpb.GetThreadRequest) (*threadpb.Thread, error) {
 	return c.client.GetThread(ctx, req)
 }
@@ -75,4 +79,151 @@ func (c *ThreadClient) GetBookmarkedThreads(ctx context.Context, req *threadpb.G
 
 func (c *ThreadClient) GetReplies(ctx context.Context, req *threadpb.GetRepliesRequest) (*threadpb.GetRepliesResponse, error) {
 	return c.client.GetReplies(ctx, req)
-}
\ No newline at end of file
+}
+func (c *ThreadClient) GetScheduledThreads(ctx context.Context, req *threadpb.GetScheduledThreadsRequest) (*threadpb.GetScheduledThreadsResponse, error) {
+	return c.client.GetScheduledThreads(ctx, req)
+}
+
+func (c *ThreadClient) RescheduleThread(ctx context.Context, req *threadpb.RescheduleThreadRequest) (*threadpb.Thread, error) {
+	return c.client.RescheduleThread(ctx, req)
+}
+
+func (c *ThreadClient) CancelScheduledThread(ctx context.Context, req *threadpb.CancelScheduledThreadRequest) (*emptypb.Empty, error) {
+	return c.client.CancelScheduledThread(ctx, req)
+}
+
+func (c *ThreadClient) Repost(ctx context.Context, req *threadpb.InteractThreadRequest) (*emptypb.Empty, error) {
+	return c.client.Repost(ctx, req)
+}
+
+func (c *ThreadClient) Unrepost(ctx context.Context, req *threadpb.InteractThreadRequest) (*emptypb.Empty, error) {
+	return c.client.Unrepost(ctx, req)
+}
+
+func (c *ThreadClient) GetQuotes(ctx context.Context, req *threadpb.GetQuotesRequest) (*threadpb.GetQuotesResponse, error) {
+	return c.client.GetQuotes(ctx, req)
+}
+
+func (c *ThreadClient) EditThread(ctx context.Context, req *threadpb.EditThreadRequest) (*threadpb.Thread, error) {
+	return c.client.EditThread(ctx, req)
+}
+
+func (c *ThreadClient) GetThreadRevisions(ctx context.Context, req *threadpb.GetThreadRevisionsRequest) (*threadpb.GetThreadRevisionsResponse, error) {
+	return c.client.GetThreadRevisions(ctx, req)
+}
+
+func (c *ThreadClient) GetConversation(ctx context.Context, req *threadpb.GetConversationRequest) (*threadpb.GetConversationResponse, error) {
+	return c.client.GetConversation(ctx, req)
+}
+
+func (c *ThreadClient) VotePoll(ctx context.Context, req *threadpb.VotePollRequest) (*threadpb.Poll, error) {
+	return c.client.VotePoll(ctx, req)
+}
+
+func (c *ThreadClient) GetPollResults(ctx context.Context, req *threadpb.GetPollResultsRequest) (*threadpb.Poll, error) {
+	return c.client.GetPollResults(ctx, req)
+}
+
+func (c *ThreadClient) GetThreadsByHashtag(ctx context.Context, req *threadpb.GetThreadsByHashtagRequest) (*threadpb.GetThreadsByHashtagResponse, error) {
+	return c.client.GetThreadsByHashtag(ctx, req)
+}
+
+func (c *ThreadClient) GetHashtagStats(ctx context.Context, req *threadpb.GetHashtagStatsRequest) (*threadpb.GetHashtagStatsResponse, error) {
+	return c.client.GetHashtagStats(ctx, req)
+}
+
+func (c *ThreadClient) GetMentions(ctx context.Context, req *threadpb.GetMentionsRequest) (*threadpb.GetMentionsResponse, error) {
+	return c.client.GetMentions(ctx, req)
+}
+
+func (c *ThreadClient) GetThreadsByCategory(ctx context.Context, req *threadpb.GetThreadsByCategoryRequest) (*threadpb.GetThreadsByCategoryResponse, error) {
+	return c.client.GetThreadsByCategory(ctx, req)
+}
+
+func (c *ThreadClient) GetCategoryStats(ctx context.Context, req *threadpb.GetCategoryStatsRequest) (*threadpb.GetCategoryStatsResponse, error) {
+	return c.client.GetCategoryStats(ctx, req)
+}
+
+func (c *ThreadClient) CreatePromotedThread(ctx context.Context, req *threadpb.CreatePromotedThreadRequest) (*threadpb.AdCampaign, error) {
+	return c.client.CreatePromotedThread(ctx, req)
+}
+
+func (c *ThreadClient) GetAdCampaigns(ctx context.Context, req *threadpb.GetAdCampaignsRequest) (*threadpb.GetAdCampaignsResponse, error) {
+	return c.client.GetAdCampaigns(ctx, req)
+}
+
+func (c *ThreadClient) RecordAdClick(ctx context.Context, req *threadpb.RecordAdClickRequest) (*emptypb.Empty, error) {
+	return c.client.RecordAdClick(ctx, req)
+}
+
+func (c *ThreadClient) FilterMutedThreads(ctx context.Context, req *threadpb.FilterMutedThreadsRequest) (*threadpb.FilterMutedThreadsResponse, error) {
+	return c.client.FilterMutedThreads(ctx, req)
+}
+
+func (c *ThreadClient) GetListThreads(ctx context.Context, req *threadpb.GetListThreadsRequest) (*threadpb.GetListThreadsResponse, error) {
+	return c.client.GetListThreads(ctx, req)
+}
+
+func (c *ThreadClient) HideReply(ctx context.Context, req *threadpb.ReplyVisibilityRequest) (*emptypb.Empty, error) {
+	return c.client.HideReply(ctx, req)
+}
+
+func (c *ThreadClient) UnhideReply(ctx context.Context, req *threadpb.ReplyVisibilityRequest) (*emptypb.Empty, error) {
+	return c.client.UnhideReply(ctx, req)
+}
+
+func (c *ThreadClient) GetHiddenReplies(ctx context.Context, req *threadpb.GetRepliesRequest) (*threadpb.GetRepliesResponse, error) {
+	return c.client.GetHiddenReplies(ctx, req)
+}
+
+func (c *ThreadClient) RemoveMention(ctx context.Context, req *threadpb.RemoveMentionRequest) (*emptypb.Empty, error) {
+	return c.client.RemoveMention(ctx, req)
+}
+
+func (c *ThreadClient) UpdateReplyRestriction(ctx context.Context, req *threadpb.UpdateReplyRestrictionRequest) (*threadpb.Thread, error) {
+	return c.client.UpdateReplyRestriction(ctx, req)
+}
+
+func (c *ThreadClient) SetThreadSensitivity(ctx context.Context, req *threadpb.SetThreadSensitivityRequest) (*threadpb.Thread, error) {
+	return c.client.SetThreadSensitivity(ctx, req)
+}
+
+func (c *ThreadClient) GetModerationLog(ctx context.Context, req *threadpb.GetModerationLogRequest) (*threadpb.GetModerationLogResponse, error) {
+	return c.client.GetModerationLog(ctx, req)
+}
+
+func (c *ThreadClient) RecordThreadEvents(ctx context.Context, req *threadpb.RecordThreadEventsRequest) (*emptypb.Empty, error) {
+	return c.client.RecordThreadEvents(ctx, req)
+}
+
+func (c *ThreadClient) GetThreadAnalytics(ctx context.Context, req *threadpb.GetThreadAnalyticsRequest) (*threadpb.ThreadAnalytics, error) {
+	return c.client.GetThreadAnalytics(ctx, req)
+}
+
+func (c *ThreadClient) CreateDraft(ctx context.Context, req *threadpb.SaveDraftRequest) (*threadpb.ThreadDraft, error) {
+	return c.client.CreateDraft(ctx, req)
+}
+
+func (c *ThreadClient) UpdateDraft(ctx context.Context, req *threadpb.SaveDraftRequest) (*threadpb.ThreadDraft, error) {
+	return c.client.UpdateDraft(ctx, req)
+}
+
+func (c *ThreadClient) GetDrafts(ctx context.Context, req *threadpb.GetDraftsRequest) (*threadpb.GetDraftsResponse, error) {
+	return c.client.GetDrafts(ctx, req)
+}
+
+func (c *ThreadClient) DeleteDraft(ctx context.Context, req *threadpb.DraftActionRequest) (*emptypb.Empty, error) {
+	return c.client.DeleteDraft(ctx, req)
+}
+
+func (c *ThreadClient) PublishDraft(ctx context.Context, req *threadpb.PublishDraftRequest) (*threadpb.Thread, error) {
+	return c.client.PublishDraft(ctx, req)
+}
+
+func (c *ThreadClient) ListDeletedThreads(ctx context.Context, req *threadpb.ListDeletedThreadsRequest) (*threadpb.ListDeletedThreadsResponse, error) {
+	return c.client.ListDeletedThreads(ctx, req)
+}
+
+func (c *ThreadClient) RestoreThread(ctx context.Context, req *threadpb.RestoreThreadRequest) (*threadpb.Thread, error) {
+	return c.client.RestoreThread(ctx, req)
+}
@@ -127,4 +127,71 @@ func (c *UserClient) IsFollowing(ctx context.Context, req *userpb.FollowCheckReq
 
 func (c *UserClient) ApplyForPremium(ctx context.Context, req *userpb.ApplyForPremiumRequest) (*emptypb.Empty, error) {
 	return c.client.ApplyForPremium(ctx, req)
-}
\ No newline at end of file
+}
+func (c *UserClient) MuteUser(ctx context.Context, req *userpb.MuteRequest) (*emptypb.Empty, error) {
+	return c.client.MuteUser(ctx, req)
+}
+
+func (c *UserClient) UnmuteUser(ctx context.Context, req *userpb.MuteRequest) (*emptypb.Empty, error) {
+	return c.client.UnmuteUser(ctx, req)
+}
+
+func (c *UserClient) GetMutedUsers(ctx context.Context, req *userpb.GetSocialListRequest) (*userpb.GetSocialListResponse, error) {
+	return c.client.GetMutedUsers(ctx, req)
+}
+
+func (c *UserClient) AddMutedWord(ctx context.Context, req *userpb.AddMutedWordRequest) (*userpb.MutedWord, error) {
+	return c.client.AddMutedWord(ctx, req)
+}
+
+func (c *UserClient) RemoveMutedWord(ctx context.Context, req *userpb.RemoveMutedWordRequest) (*emptypb.Empty, error) {
+	return c.client.RemoveMutedWord(ctx, req)
+}
+
+func (c *UserClient) GetMutedWords(ctx context.Context, req *userpb.GetMutedWordsRequest) (*userpb.GetMutedWordsResponse, error) {
+	return c.client.GetMutedWords(ctx, req)
+}
+
+func (c *UserClient) CreateList(ctx context.Context, req *userpb.CreateListRequest) (*userpb.List, error) {
+	return c.client.CreateList(ctx, req)
+}
+
+func (c *UserClient) UpdateList(ctx context.Context, req *userpb.UpdateListRequest) (*userpb.List, error) {
+	return c.client.UpdateList(ctx, req)
+}
+
+func (c *UserClient) DeleteList(ctx context.Context, req *userpb.ListRequest) (*emptypb.Empty, error) {
+	return c.client.DeleteList(ctx, req)
+}
+
+func (c *UserClient) GetList(ctx context.Context, req *userpb.ListRequest) (*userpb.List, error) {
+	return c.client.GetList(ctx, req)
+}
+
+func (c *UserClient) GetUserLists(ctx context.Context, req *userpb.GetUserListsRequest) (*userpb.GetListsResponse, error) {
+	return c.client.GetUserLists(ctx, req)
+}
+
+func (c *UserClient) GetFollowedLists(ctx context.Context, req *userpb.GetUserListsRequest) (*userpb.GetListsResponse, error) {
+	return c.client.GetFollowedLists(ctx, req)
+}
+
+func (c *UserClient) AddListMember(ctx context.Context, req *userpb.ListMemberRequest) (*emptypb.Empty, error) {
+	return c.client.AddListMember(ctx, req)
+}
+
+func (c *UserClient) RemoveListMember(ctx context.Context, req *userpb.ListMemberRequest) (*emptypb.Empty, error) {
+	return c.client.RemoveListMember(ctx, req)
+}
+
+func (c *UserClient) GetListMembers(ctx context.Context, req *userpb.GetListMembersRequest) (*userpb.GetSocialListResponse, error) {
+	return c.client.GetListMembers(ctx, req)
+}
+
+func (c *UserClient) FollowList(ctx context.Context, req *userpb.ListRequest) (*emptypb.Empty, error) {
+	return c.client.FollowList(ctx, req)
+}
+
+func (c *UserClient) UnfollowList(ctx context.Context, req *userpb.ListRequest) (*emptypb.Empty, error) {
+	return c.client.UnfollowList(ctx, req)
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
+	"context"
 	"os"
 	"strings"
 
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/api-gateway/analytics"
 	"github.com/Acad600-TPA/WEB-MJ-242/backend/api-gateway/client"
 	gwHTTPHandler "github.com/Acad600-TPA/WEB-MJ-242/backend/api-gateway/handler/http"
 	"github.com/Acad600-TPA/WEB-MJ-242/backend/api-gateway/handler/websocket"
@@ -90,12 +92,18 @@ func main() {
 
 	logrus.Info("gRPC Clients initialized")
 
+	// Thread impressions and views are batched and sent to thread-service in the background
+	analyticsCtx, stopAnalytics := context.WithCancel(context.Background())
+	defer stopAnalytics()
+	threadViews := analytics.NewBatcher(gwHTTPHandler.NewThreadEventSink(threadClient), analytics.ConfigFromEnv())
+	go threadViews.Start(analyticsCtx)
+
 	// Initialize handlers
 	authHandler := gwHTTPHandler.NewAuthHandler(userClient)
-	threadHandler := gwHTTPHandler.NewThreadHandler(threadClient, mediaClient, userClient)
+	threadHandler := gwHTTPHandler.NewThreadHandler(threadClient, mediaClient, userClient, threadViews)
 	mediaHandler := gwHTTPHandler.NewMediaHandler(mediaClient)
 	profileHandler := gwHTTPHandler.NewProfileHandler(userClient)
-	searchHandler := gwHTTPHandler.NewSearchHandler(searchClient, userClient, threadClient, mediaClient)
+	searchHandler := gwHTTPHandler.NewSearchHandler(searchClient, userClient, threadClient, mediaClient, threadViews)
 	notificationHandler := gwHTTPHandler.NewNotificationHandler(notificationClient)
 	messageHandler := gwHTTPHandler.NewMessageHandler(messageClient, userClient, mediaClient)
 	communityHandler := gwHTTPHandler.NewCommunityHandler(communityClient, userClient, threadClient, mediaClient)
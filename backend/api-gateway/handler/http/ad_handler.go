@@ -0,0 +1,175 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	userpb "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genproto/proto"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sessionIDHeader identifies the browser session feeds are requested in, so an ad is served once per session.
+const sessionIDHeader = "X-Session-ID"
+
+type CreatePromotedThreadPayload struct {
+	Content          string   `json:"content"`
+	ReplyRestriction string   `json:"reply_restriction,omitempty"`
+	MediaIDs         []uint32 `json:"media_ids,omitempty"`
+	Categories       []string `json:"categories,omitempty"`
+	BudgetCents      int64    `json:"budget_cents" binding:"required,min=1"`
+	StartsAt         *string  `json:"starts_at,omitempty"` // RFC3339; defaults to now
+	EndsAt           string   `json:"ends_at" binding:"required"`
+	TargetCategories []string `json:"target_categories,omitempty"`
+	TargetFollowerOf []string `json:"target_follower_of,omitempty"` // usernames; show to their followers
+}
+
+type FrontendAdCampaign struct {
+	ID                      uint32              `json:"id"`
+	ThreadID                uint32              `json:"thread_id"`
+	BudgetCents             int64               `json:"budget_cents"`
+	SpentCents              int64               `json:"spent_cents"`
+	StartsAt                string              `json:"starts_at"`
+	EndsAt                  string              `json:"ends_at"`
+	TargetCategories        []string            `json:"target_categories"`
+	TargetFollowerOfUserIDs []uint32            `json:"target_follower_of_user_ids"`
+	Impressions             int64               `json:"impressions"`
+	Clicks                  int64               `json:"clicks"`
+	Thread                  *FrontendThreadData `json:"thread,omitempty"` // missing if the thread was deleted
+	CreatedAt               string              `json:"created_at"`
+}
+
+func (h *ThreadHandler) CreatePromotedThreadHTTP(c *gin.Context) {
+	userID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var payload CreatePromotedThreadPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data: " + err.Error()})
+		return
+	}
+	if payload.Content == "" && len(payload.MediaIDs) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Thread must contain content or media"})
+		return
+	}
+
+	grpcReq := &threadpb.CreatePromotedThreadRequest{
+		Thread: &threadpb.CreateThreadRequest{
+			UserId:           userID,
+			Content:          payload.Content,
+			MediaIds:         payload.MediaIDs,
+			Categories:       payload.Categories,
+			ReplyRestriction: mapHTTPReplyRestrictionToProto(payload.ReplyRestriction),
+		},
+		BudgetCents:      payload.BudgetCents,
+		TargetCategories: payload.TargetCategories,
+	}
+	endsAt, err := time.Parse(time.RFC3339, payload.EndsAt)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ends_at format. Use ISO 8601 (RFC3339)."})
+		return
+	}
+	grpcReq.EndsAt = timestamppb.New(endsAt)
+	if payload.StartsAt != nil && *payload.StartsAt != "" {
+		startsAt, err := time.Parse(time.RFC3339, *payload.StartsAt)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid starts_at format. Use ISO 8601 (RFC3339)."})
+			return
+		}
+		grpcReq.StartsAt = timestamppb.New(startsAt)
+	}
+	for _, username := range payload.TargetFollowerOf {
+		targetUser, err := h.userClient.GetUserByUsername(c.Request.Context(), &userpb.GetUserByUsernameRequest{Username: username})
+		if err != nil {
+			handleGRPCError(c, "resolve target account @"+username, err)
+			return
+		}
+		grpcReq.TargetFollowerOfUserIds = append(grpcReq.TargetFollowerOfUserIds, targetUser.GetId())
+	}
+
+	campaign, err := h.threadClient.CreatePromotedThread(c.Request.Context(), grpcReq)
+	if err != nil {
+		handleGRPCError(c, "create promoted thread", err)
+		return
+	}
+	c.JSON(http.StatusCreated, h.mapAdCampaignsToFrontend(c, []*threadpb.AdCampaign{campaign})[0])
+}
+
+// GetAdCampaignsHTTP reports the current user's ad campaigns.
+func (h *ThreadHandler) GetAdCampaignsHTTP(c *gin.Context) {
+	userID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.threadClient.GetAdCampaigns(c.Request.Context(), &threadpb.GetAdCampaignsRequest{AdvertiserId: userID})
+	if err != nil {
+		handleGRPCError(c, "get ad campaigns", err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"campaigns": h.mapAdCampaignsToFrontend(c, resp.GetCampaigns())})
+}
+
+// RecordAdClickHTTP counts a click on an ad served in the caller's session.
+func (h *ThreadHandler) RecordAdClickHTTP(c *gin.Context) {
+	campaignID, ok := getUint32Param(c, "campaignId")
+	if !ok {
+		return
+	}
+	sessionID := c.GetHeader(sessionIDHeader)
+	if sessionID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": sessionIDHeader + " header is required"})
+		return
+	}
+
+	grpcReq := &threadpb.RecordAdClickRequest{CampaignId: campaignID, SessionId: sessionID}
+	if userIDAny, exists := c.Get("userID"); exists {
+		if userID, ok := userIDAny.(uint); ok {
+			val := uint32(userID)
+			grpcReq.UserId = &val
+		}
+	}
+	if _, err := h.threadClient.RecordAdClick(c.Request.Context(), grpcReq); err != nil {
+		handleGRPCError(c, "record ad click", err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *ThreadHandler) mapAdCampaignsToFrontend(c *gin.Context, campaigns []*threadpb.AdCampaign) []FrontendAdCampaign {
+	var threads []*threadpb.Thread
+	for _, campaign := range campaigns {
+		if campaign.GetThread() != nil {
+			threads = append(threads, campaign.GetThread())
+		}
+	}
+	hydrated := make(map[uint32]FrontendThreadData, len(threads))
+	for _, t := range h.hydrateThreadList(c.Request.Context(), threads) {
+		hydrated[t.ID] = t
+	}
+
+	feCampaigns := make([]FrontendAdCampaign, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		feCampaign := FrontendAdCampaign{
+			ID:                      campaign.GetId(),
+			ThreadID:                campaign.GetThreadId(),
+			BudgetCents:             campaign.GetBudgetCents(),
+			SpentCents:              campaign.GetSpentCents(),
+			StartsAt:                campaign.GetStartsAt().AsTime().Format(time.RFC3339),
+			EndsAt:                  campaign.GetEndsAt().AsTime().Format(time.RFC3339),
+			TargetCategories:        campaign.GetTargetCategories(),
+			TargetFollowerOfUserIDs: campaign.GetTargetFollowerOfUserIds(),
+			Impressions:             campaign.GetImpressions(),
+			Clicks:                  campaign.GetClicks(),
+			CreatedAt:               campaign.GetCreatedAt().AsTime().Format(time.RFC3339),
+		}
+		if t, ok := hydrated[campaign.GetThreadId()]; ok {
+			feCampaign.Thread = &t
+		}
+		feCampaigns = append(feCampaigns, feCampaign)
+	}
+	return feCampaigns
+}
@@ -0,0 +1,124 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/api-gateway/analytics"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/api-gateway/client"
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type FrontendAnalyticsBucket struct {
+	Hour                   string `json:"hour"`
+	Impressions            int64  `json:"impressions"`
+	FollowerImpressions    int64  `json:"follower_impressions"`
+	NonFollowerImpressions int64  `json:"non_follower_impressions"`
+	DetailViews            int64  `json:"detail_views"`
+	ProfileClicks          int64  `json:"profile_clicks"`
+}
+
+type FrontendThreadAnalytics struct {
+	ThreadID         uint32                    `json:"thread_id"`
+	Impressions      int64                     `json:"impressions"`
+	FollowerReach    int64                     `json:"follower_reach"`
+	NonFollowerReach int64                     `json:"non_follower_reach"`
+	DetailViews      int64                     `json:"detail_views"`
+	ProfileClicks    int64                     `json:"profile_clicks"`
+	Engagements      int64                     `json:"engagements"`
+	EngagementRate   float64                   `json:"engagement_rate"`
+	Buckets          []FrontendAnalyticsBucket `json:"buckets"`
+}
+
+// NewThreadEventSink sends batches of view events to thread-service.
+func NewThreadEventSink(threadClient *client.ThreadClient) analytics.Sink {
+	return func(ctx context.Context, events []analytics.Event) error {
+		req := &threadpb.RecordThreadEventsRequest{Events: make([]*threadpb.ThreadViewEvent, 0, len(events))}
+		for _, e := range events {
+			req.Events = append(req.Events, &threadpb.ThreadViewEvent{
+				ThreadId:   e.ThreadID,
+				ViewerId:   e.ViewerID,
+				ViewerKey:  e.ViewerKey,
+				Kind:       e.Kind,
+				OccurredAt: timestamppb.New(e.At),
+			})
+		}
+		_, err := threadClient.RecordThreadEvents(ctx, req)
+		return err
+	}
+}
+
+// recordThreadViews queues one event of kind for each thread served to the requester.
+func recordThreadViews(c *gin.Context, views *analytics.Batcher, kind string, threads []FrontendThreadData) {
+	if views == nil || len(threads) == 0 {
+		return
+	}
+	viewerID := getOptionalUserID(c)
+	viewerKey := analytics.ViewerKey(viewerID, c.ClientIP(), c.Request.UserAgent())
+	events := make([]analytics.Event, 0, len(threads))
+	for _, t := range threads {
+		events = append(events, analytics.Event{ThreadID: t.ID, ViewerID: viewerID, ViewerKey: viewerKey, Kind: kind})
+	}
+	views.Record(events...)
+}
+
+// RecordProfileClickHTTP is called by the frontend when the author's profile is opened from a thread.
+func (h *ThreadHandler) RecordProfileClickHTTP(c *gin.Context) {
+	threadID, ok := getUint32Param(c, "threadId")
+	if !ok {
+		return
+	}
+	recordThreadViews(c, h.views, analytics.KindProfileClick, []FrontendThreadData{{ID: threadID}})
+	c.Status(http.StatusNoContent)
+}
+
+// GetThreadAnalyticsHTTP returns impressions, reach and engagement of one of the requester's threads.
+// ?hours sets the length of the hourly series (default 168, at most 720).
+func (h *ThreadHandler) GetThreadAnalyticsHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	threadID, ok := getUint32Param(c, "threadId")
+	if !ok {
+		return
+	}
+	hours, _ := strconv.Atoi(c.Query("hours"))
+
+	resp, err := h.threadClient.GetThreadAnalytics(c.Request.Context(), &threadpb.GetThreadAnalyticsRequest{
+		ThreadId:        threadID,
+		RequesterUserId: requesterUserID,
+		Hours:           int32(hours),
+	})
+	if err != nil {
+		handleGRPCError(c, "get thread analytics", err)
+		return
+	}
+
+	feResp := FrontendThreadAnalytics{
+		ThreadID:         resp.GetThreadId(),
+		Impressions:      resp.GetImpressions(),
+		FollowerReach:    resp.GetFollowerReach(),
+		NonFollowerReach: resp.GetNonFollowerReach(),
+		DetailViews:      resp.GetDetailViews(),
+		ProfileClicks:    resp.GetProfileClicks(),
+		Engagements:      resp.GetEngagements(),
+		EngagementRate:   resp.GetEngagementRate(),
+		Buckets:          make([]FrontendAnalyticsBucket, 0, len(resp.GetBuckets())),
+	}
+	for _, b := range resp.GetBuckets() {
+		feResp.Buckets = append(feResp.Buckets, FrontendAnalyticsBucket{
+			Hour:                   b.GetHour().AsTime().Format(time.RFC3339),
+			Impressions:            b.GetImpressions(),
+			FollowerImpressions:    b.GetFollowerImpressions(),
+			NonFollowerImpressions: b.GetNonFollowerImpressions(),
+			DetailViews:            b.GetDetailViews(),
+			ProfileClicks:          b.GetProfileClicks(),
+		})
+	}
+	c.JSON(http.StatusOK, feResp)
+}
@@ -74,6 +74,8 @@ type UpdateProfilePayload struct {
 	DateOfBirth            *string `json:"date_of_birth,omitempty"`      
 	AccountPrivacy         *string `json:"account_privacy,omitempty"`    
 	SubscribedToNewsletter *bool   `json:"subscribed_to_newsletter,omitempty"`
+	MentionPermission      *string `json:"mention_permission,omitempty"` // everyone, following or none
+	ShowSensitiveContent   *bool   `json:"show_sensitive_content,omitempty"`
 }
 
 type ApplyForPremiumPayloadHTTP struct {
@@ -423,6 +425,8 @@ func (h *AuthHandler) UpdateOwnUserProfile(c *gin.Context) {
     if payload.DateOfBirth != nil { grpcReq.DateOfBirth = payload.DateOfBirth }
     if payload.AccountPrivacy != nil { grpcReq.AccountPrivacy = payload.AccountPrivacy }
     if payload.SubscribedToNewsletter != nil { grpcReq.SubscribedToNewsletter = payload.SubscribedToNewsletter }
+    if payload.MentionPermission != nil { grpcReq.MentionPermission = payload.MentionPermission }
+    if payload.ShowSensitiveContent != nil { grpcReq.ShowSensitiveContent = payload.ShowSensitiveContent }
 
 
     updatedUserPb, err := h.userClient.UpdateUserProfile(c.Request.Context(), grpcReq)
@@ -474,6 +478,8 @@ func grpcStatusCodeToHTTP(code codes.Code) int {
 		return http.StatusNotFound
 	case codes.AlreadyExists:
 		return http.StatusConflict
+	case codes.FailedPrecondition:
+		return http.StatusConflict
 	case codes.PermissionDenied:
 		return http.StatusForbidden
 	case codes.Unauthenticated:
@@ -503,6 +509,8 @@ func mapPbUserToFrontendUser(pbUser *userpb.User) interface{} {
         "subscribed_to_newsletter": pbUser.GetSubscribedToNewsletter(),
         "bio":                      pbUser.GetBio(),
 		"is_verified":            	pbUser.GetIsVerified(),
+        "mention_permission":       pbUser.GetMentionPermission(),
+        "show_sensitive_content":   pbUser.GetShowSensitiveContent(),
         "created_at":               pbUser.GetCreatedAt().AsTime().Format(time.RFC3339),
     }
 }
\ No newline at end of file
@@ -333,13 +333,7 @@ func (h *CommunityHandler) GetCommunityThreadsHTTP(c *gin.Context) {
         c.JSON(http.StatusOK, FrontendFeedResponse{Threads: []FrontendThreadData{}, HasMore: false})
         return
     }
-    authorIDsSet := make(map[uint32]bool); mediaIDsSet := make(map[uint32]bool)
-    for _, t := range threadServiceResp.GetThreads() {
-        if t.GetUserId() != 0 { authorIDsSet[t.GetUserId()] = true }
-        for _, mediaID := range t.GetMediaIds() { if mediaID != 0 { mediaIDsSet[mediaID] = true } }
-    }
-    var authorIDs []uint32; for id := range authorIDsSet { authorIDs = append(authorIDs, id) }
-    var mediaIDs []uint32; for id := range mediaIDsSet { mediaIDs = append(mediaIDs, id) }
+    authorIDs, mediaIDs := collectThreadHydrationIDs(threadServiceResp.GetThreads())
     var wg sync.WaitGroup; var authorsMap map[uint32]*userpb.User; var mediaMap map[uint32]*mediapb.Media
     var userErr, mediaErr error
     if len(authorIDs) > 0 && h.userClient != nil {
@@ -0,0 +1,79 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	"github.com/gin-gonic/gin"
+)
+
+// FrontendDeletedThread is a thread in the "recently deleted" list with when it stops being restorable.
+type FrontendDeletedThread struct {
+	FrontendThreadData
+	DeletedAt string `json:"deleted_at"`
+	PurgeAt   string `json:"purge_at"`
+}
+
+type FrontendDeletedThreadsResponse struct {
+	Threads    []FrontendDeletedThread `json:"threads"`
+	HasMore    bool                    `json:"has_more"`
+	NextCursor string                  `json:"next_cursor,omitempty"`
+}
+
+func (h *ThreadHandler) ListDeletedThreadsHTTP(c *gin.Context) {
+	userID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	page, limit := parsePagination(c)
+
+	grpcReq := &threadpb.ListDeletedThreadsRequest{UserId: userID, Page: page, Limit: limit, Cursor: c.Query("cursor")}
+	threadServiceResp, err := h.threadClient.ListDeletedThreads(c.Request.Context(), grpcReq)
+	if err != nil {
+		handleGRPCError(c, "list deleted threads", err)
+		return
+	}
+
+	deleted := threadServiceResp.GetThreads()
+	protoThreads := make([]*threadpb.Thread, len(deleted))
+	for i, d := range deleted {
+		protoThreads[i] = d.GetThread()
+	}
+	hydrated := h.hydrateThreadList(c.Request.Context(), protoThreads)
+
+	resp := FrontendDeletedThreadsResponse{
+		Threads:    make([]FrontendDeletedThread, len(deleted)),
+		HasMore:    threadServiceResp.GetHasMore(),
+		NextCursor: threadServiceResp.GetNextCursor(),
+	}
+	for i, d := range deleted {
+		resp.Threads[i] = FrontendDeletedThread{
+			FrontendThreadData: hydrated[i],
+			DeletedAt:          d.GetDeletedAt().AsTime().Format(time.RFC3339),
+			PurgeAt:            d.GetPurgeAt().AsTime().Format(time.RFC3339),
+		}
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *ThreadHandler) RestoreThreadHTTP(c *gin.Context) {
+	userID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	threadID, ok := getUint32Param(c, "threadId")
+	if !ok {
+		return
+	}
+
+	grpcReq := &threadpb.RestoreThreadRequest{ThreadId: threadID, UserId: userID}
+	threadProto, err := h.threadClient.RestoreThread(c.Request.Context(), grpcReq)
+	if err != nil {
+		handleGRPCError(c, "restore thread", err)
+		return
+	}
+
+	hydrated := h.hydrateThreadList(c.Request.Context(), []*threadpb.Thread{threadProto})
+	c.JSON(http.StatusOK, hydrated[0])
+}
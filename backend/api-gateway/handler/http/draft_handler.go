@@ -0,0 +1,218 @@
+package http
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	mediapb "github.com/Acad600-TPA/WEB-MJ-242/backend/media-service/genproto/proto"
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SaveDraftPayload is the whole composer state; saving replaces the previous version of the draft.
+type SaveDraftPayload struct {
+	Content          string   `json:"content"`
+	MediaIDs         []uint32 `json:"media_ids,omitempty"`
+	Categories       []string `json:"categories,omitempty"`
+	ParentThreadID   *uint32  `json:"parent_thread_id,omitempty"`
+	CommunityID      *uint32  `json:"community_id,omitempty"`
+	ReplyRestriction string   `json:"reply_restriction,omitempty"`
+	ContentWarning   string   `json:"content_warning,omitempty"`
+	IsSensitive      bool     `json:"is_sensitive,omitempty"`
+}
+
+type PublishDraftPayload struct {
+	ScheduledAt *string `json:"scheduled_at,omitempty"`
+}
+
+type FrontendDraft struct {
+	ID               uint32                  `json:"id"`
+	Content          string                  `json:"content"`
+	MediaIDs         []uint32                `json:"media_ids"`
+	Media            []FrontendMediaMetadata `json:"media,omitempty"`
+	Categories       []string                `json:"categories"`
+	ParentThreadID   *uint32                 `json:"parent_thread_id,omitempty"`
+	CommunityID      *uint32                 `json:"community_id,omitempty"`
+	ReplyRestriction string                  `json:"reply_restriction"`
+	ContentWarning   string                  `json:"content_warning,omitempty"`
+	IsSensitive      bool                    `json:"is_sensitive"`
+	CreatedAt        string                  `json:"created_at"`
+	UpdatedAt        string                  `json:"updated_at"`
+}
+
+type FrontendDraftsResponse struct {
+	Drafts  []FrontendDraft `json:"drafts"`
+	HasMore bool            `json:"has_more"`
+}
+
+func (h *ThreadHandler) CreateDraftHTTP(c *gin.Context) {
+	h.saveDraft(c, 0)
+}
+
+func (h *ThreadHandler) UpdateDraftHTTP(c *gin.Context) {
+	draftID, ok := getUint32Param(c, "draftId")
+	if !ok {
+		return
+	}
+	h.saveDraft(c, draftID)
+}
+
+// saveDraft creates a draft when draftID is 0 and overwrites it otherwise.
+func (h *ThreadHandler) saveDraft(c *gin.Context, draftID uint32) {
+	userID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var payload SaveDraftPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data: " + err.Error()})
+		return
+	}
+	if payload.Content == "" && len(payload.MediaIDs) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Draft must contain content or media"})
+		return
+	}
+
+	grpcReq := &threadpb.SaveDraftRequest{
+		DraftId:          draftID,
+		UserId:           userID,
+		Content:          payload.Content,
+		MediaIds:         payload.MediaIDs,
+		Categories:       payload.Categories,
+		ParentThreadId:   payload.ParentThreadID,
+		CommunityId:      payload.CommunityID,
+		ReplyRestriction: mapHTTPReplyRestrictionToProto(payload.ReplyRestriction),
+		ContentWarning:   payload.ContentWarning,
+		IsSensitive:      payload.IsSensitive,
+	}
+
+	var draft *threadpb.ThreadDraft
+	var err error
+	if draftID == 0 {
+		draft, err = h.threadClient.CreateDraft(c.Request.Context(), grpcReq)
+	} else {
+		draft, err = h.threadClient.UpdateDraft(c.Request.Context(), grpcReq)
+	}
+	if err != nil {
+		handleGRPCError(c, "save draft", err)
+		return
+	}
+
+	statusCode := http.StatusOK
+	if draftID == 0 {
+		statusCode = http.StatusCreated
+	}
+	c.JSON(statusCode, h.hydrateDrafts(c, []*threadpb.ThreadDraft{draft})[0])
+}
+
+func (h *ThreadHandler) GetDraftsHTTP(c *gin.Context) {
+	userID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	page, limit := parsePagination(c)
+
+	resp, err := h.threadClient.GetDrafts(c.Request.Context(), &threadpb.GetDraftsRequest{UserId: userID, Page: page, Limit: limit})
+	if err != nil {
+		handleGRPCError(c, "get drafts", err)
+		return
+	}
+	c.JSON(http.StatusOK, FrontendDraftsResponse{Drafts: h.hydrateDrafts(c, resp.GetDrafts()), HasMore: resp.GetHasMore()})
+}
+
+func (h *ThreadHandler) DeleteDraftHTTP(c *gin.Context) {
+	userID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	draftID, ok := getUint32Param(c, "draftId")
+	if !ok {
+		return
+	}
+
+	if _, err := h.threadClient.DeleteDraft(c.Request.Context(), &threadpb.DraftActionRequest{DraftId: draftID, UserId: userID}); err != nil {
+		handleGRPCError(c, "delete draft", err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// PublishDraftHTTP posts a draft as a thread or reply and deletes the draft. The body is optional.
+func (h *ThreadHandler) PublishDraftHTTP(c *gin.Context) {
+	userID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	draftID, ok := getUint32Param(c, "draftId")
+	if !ok {
+		return
+	}
+
+	var payload PublishDraftPayload
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&payload); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data: " + err.Error()})
+			return
+		}
+	}
+	grpcReq := &threadpb.PublishDraftRequest{DraftId: draftID, UserId: userID}
+	if payload.ScheduledAt != nil && *payload.ScheduledAt != "" {
+		t, err := time.Parse(time.RFC3339, *payload.ScheduledAt)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid scheduled_at format. Use ISO 8601 (RFC3339)."})
+			return
+		}
+		grpcReq.ScheduledAt = timestamppb.New(t)
+	}
+
+	createdThread, err := h.threadClient.PublishDraft(c.Request.Context(), grpcReq)
+	if err != nil {
+		handleGRPCError(c, "publish draft", err)
+		return
+	}
+	c.JSON(http.StatusCreated, createdThread)
+}
+
+// hydrateDrafts maps drafts for the composer, with metadata of their attached media so previews can be shown.
+func (h *ThreadHandler) hydrateDrafts(c *gin.Context, drafts []*threadpb.ThreadDraft) []FrontendDraft {
+	var mediaIDs []uint32
+	for _, d := range drafts {
+		mediaIDs = append(mediaIDs, d.GetMediaIds()...)
+	}
+	mediaMap := map[uint32]*mediapb.Media{}
+	if len(mediaIDs) > 0 && h.mediaClient != nil {
+		resp, err := h.mediaClient.GetMultipleMediaMetadata(c.Request.Context(), &mediapb.GetMultipleMediaMetadataRequest{MediaIds: mediaIDs})
+		if err != nil {
+			log.Printf("Error fetching media for drafts: %v", err)
+		} else {
+			mediaMap = resp.GetMediaItems()
+		}
+	}
+
+	feDrafts := make([]FrontendDraft, 0, len(drafts))
+	for _, d := range drafts {
+		feDraft := FrontendDraft{
+			ID:               d.GetId(),
+			Content:          d.GetContent(),
+			MediaIDs:         d.GetMediaIds(),
+			Categories:       d.GetCategories(),
+			ParentThreadID:   d.ParentThreadId,
+			CommunityID:      d.CommunityId,
+			ReplyRestriction: d.GetReplyRestriction().String(),
+			ContentWarning:   d.GetContentWarning(),
+			IsSensitive:      d.GetIsSensitive(),
+			CreatedAt:        d.GetCreatedAt().AsTime().Format(time.RFC3339),
+			UpdatedAt:        d.GetUpdatedAt().AsTime().Format(time.RFC3339),
+		}
+		for _, id := range d.GetMediaIds() {
+			if m, ok := mediaMap[id]; ok {
+				feDraft.Media = append(feDraft.Media, mapMediaToFrontend(m))
+			}
+		}
+		feDrafts = append(feDrafts, feDraft)
+	}
+	return feDrafts
+}
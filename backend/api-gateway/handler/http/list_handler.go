@@ -0,0 +1,360 @@
+package http
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	userpb "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genproto/proto"
+	"github.com/gin-gonic/gin"
+)
+
+type CreateListPayload struct {
+	Name        string `json:"name" binding:"required,max=25"`
+	Description string `json:"description" binding:"max=100"`
+	IsPrivate   bool   `json:"is_private"`
+}
+
+type UpdateListPayload struct {
+	Name        *string `json:"name,omitempty" binding:"omitempty,max=25"`
+	Description *string `json:"description,omitempty" binding:"omitempty,max=100"`
+	IsPrivate   *bool   `json:"is_private,omitempty"` // making a list private removes its followers
+}
+
+type FrontendList struct {
+	ID                    uint32               `json:"id"`
+	Owner                 *FrontendUserProfile `json:"owner,omitempty"`
+	Name                  string               `json:"name"`
+	Description           string               `json:"description"`
+	IsPrivate             bool                 `json:"is_private"`
+	MemberCount           int32                `json:"member_count"`
+	FollowerCount         int32                `json:"follower_count"`
+	IsFollowedByRequester bool                 `json:"is_followed_by_requester"`
+	CreatedAt             string               `json:"created_at"`
+}
+
+type FrontendListsResponse struct {
+	Lists   []FrontendList `json:"lists"`
+	HasMore bool           `json:"has_more"`
+}
+
+func (h *ProfileHandler) CreateListHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	var payload CreateListPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data: " + err.Error()})
+		return
+	}
+
+	list, err := h.userClient.CreateList(c.Request.Context(), &userpb.CreateListRequest{
+		OwnerId:     requesterUserID,
+		Name:        payload.Name,
+		Description: payload.Description,
+		IsPrivate:   payload.IsPrivate,
+	})
+	if err != nil {
+		handleGRPCError(c, "create list", err)
+		return
+	}
+	c.JSON(http.StatusCreated, mapPbListToFrontend(list))
+}
+
+func (h *ProfileHandler) GetListHTTP(c *gin.Context) {
+	listID, ok := getUint32Param(c, "listId")
+	if !ok {
+		return
+	}
+
+	list, err := h.userClient.GetList(c.Request.Context(), &userpb.ListRequest{ListId: listID, RequesterId: getOptionalUserID(c)})
+	if err != nil {
+		handleGRPCError(c, "get list", err)
+		return
+	}
+	c.JSON(http.StatusOK, mapPbListToFrontend(list))
+}
+
+func (h *ProfileHandler) UpdateListHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	listID, ok := getUint32Param(c, "listId")
+	if !ok {
+		return
+	}
+
+	var payload UpdateListPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data: " + err.Error()})
+		return
+	}
+
+	list, err := h.userClient.UpdateList(c.Request.Context(), &userpb.UpdateListRequest{
+		ListId:      listID,
+		RequesterId: requesterUserID,
+		Name:        payload.Name,
+		Description: payload.Description,
+		IsPrivate:   payload.IsPrivate,
+	})
+	if err != nil {
+		handleGRPCError(c, "update list", err)
+		return
+	}
+	c.JSON(http.StatusOK, mapPbListToFrontend(list))
+}
+
+func (h *ProfileHandler) DeleteListHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	listID, ok := getUint32Param(c, "listId")
+	if !ok {
+		return
+	}
+
+	if _, err := h.userClient.DeleteList(c.Request.Context(), &userpb.ListRequest{ListId: listID, RequesterId: requesterUserID}); err != nil {
+		handleGRPCError(c, "delete list", err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// GetMyListsHTTP lists the lists the current user created, private ones included.
+func (h *ProfileHandler) GetMyListsHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	page, limit := parsePagination(c)
+	resp, err := h.userClient.GetUserLists(c.Request.Context(), &userpb.GetUserListsRequest{
+		UserId:      requesterUserID,
+		RequesterId: requesterUserID,
+		Page:        page,
+		Limit:       limit,
+	})
+	if err != nil {
+		handleGRPCError(c, "get own lists", err)
+		return
+	}
+	c.JSON(http.StatusOK, mapPbListsToFrontend(resp))
+}
+
+func (h *ProfileHandler) GetMyFollowedListsHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+
+	page, limit := parsePagination(c)
+	resp, err := h.userClient.GetFollowedLists(c.Request.Context(), &userpb.GetUserListsRequest{
+		UserId:      requesterUserID,
+		RequesterId: requesterUserID,
+		Page:        page,
+		Limit:       limit,
+	})
+	if err != nil {
+		handleGRPCError(c, "get followed lists", err)
+		return
+	}
+	c.JSON(http.StatusOK, mapPbListsToFrontend(resp))
+}
+
+// GetUserListsHTTP lists the public lists a user created.
+func (h *ProfileHandler) GetUserListsHTTP(c *gin.Context) {
+	username := c.Param("username")
+	targetUserPb, err := h.userClient.GetUserByUsername(c.Request.Context(), &userpb.GetUserByUsernameRequest{Username: username})
+	if err != nil {
+		handleGRPCError(c, "find user for lists", err)
+		return
+	}
+
+	page, limit := parsePagination(c)
+	resp, err := h.userClient.GetUserLists(c.Request.Context(), &userpb.GetUserListsRequest{
+		UserId:      targetUserPb.GetId(),
+		RequesterId: getOptionalUserID(c),
+		Page:        page,
+		Limit:       limit,
+	})
+	if err != nil {
+		handleGRPCError(c, "get user lists", err)
+		return
+	}
+	c.JSON(http.StatusOK, mapPbListsToFrontend(resp))
+}
+
+func (h *ProfileHandler) GetListMembersHTTP(c *gin.Context) {
+	listID, ok := getUint32Param(c, "listId")
+	if !ok {
+		return
+	}
+
+	page, limit := parsePagination(c)
+	resp, err := h.userClient.GetListMembers(c.Request.Context(), &userpb.GetListMembersRequest{
+		ListId:      listID,
+		RequesterId: getOptionalUserID(c),
+		Page:        page,
+		Limit:       limit,
+	})
+	if err != nil {
+		handleGRPCError(c, "get list members", err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+func (h *ProfileHandler) AddListMemberHTTP(c *gin.Context) {
+	h.changeListMember(c, true)
+}
+
+func (h *ProfileHandler) RemoveListMemberHTTP(c *gin.Context) {
+	h.changeListMember(c, false)
+}
+
+func (h *ProfileHandler) changeListMember(c *gin.Context, add bool) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	listID, ok := getUint32Param(c, "listId")
+	if !ok {
+		return
+	}
+
+	username := c.Param("username")
+	targetUserPb, err := h.userClient.GetUserByUsername(c.Request.Context(), &userpb.GetUserByUsernameRequest{Username: username})
+	if err != nil {
+		handleGRPCError(c, "find list member", err)
+		return
+	}
+
+	grpcReq := &userpb.ListMemberRequest{ListId: listID, RequesterId: requesterUserID, UserId: targetUserPb.GetId()}
+	if add {
+		_, err = h.userClient.AddListMember(c.Request.Context(), grpcReq)
+	} else {
+		_, err = h.userClient.RemoveListMember(c.Request.Context(), grpcReq)
+	}
+	if err != nil {
+		handleGRPCError(c, "change list members", err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *ProfileHandler) FollowListHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	listID, ok := getUint32Param(c, "listId")
+	if !ok {
+		return
+	}
+
+	if _, err := h.userClient.FollowList(c.Request.Context(), &userpb.ListRequest{ListId: listID, RequesterId: requesterUserID}); err != nil {
+		handleGRPCError(c, "follow list", err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Successfully followed list"})
+}
+
+func (h *ProfileHandler) UnfollowListHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	listID, ok := getUint32Param(c, "listId")
+	if !ok {
+		return
+	}
+
+	if _, err := h.userClient.UnfollowList(c.Request.Context(), &userpb.ListRequest{ListId: listID, RequesterId: requesterUserID}); err != nil {
+		handleGRPCError(c, "unfollow list", err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Successfully unfollowed list"})
+}
+
+// GetListThreadsHTTP returns a list's timeline: threads by its members, newest first.
+func (h *ThreadHandler) GetListThreadsHTTP(c *gin.Context) {
+	listID, ok := getUint32Param(c, "listId")
+	if !ok {
+		return
+	}
+
+	requesterUserID := getOptionalUserID(c)
+	page, limit := parsePagination(c)
+
+	excludeUserIDs, err := h.getFeedExclusionIDs(c.Request.Context(), requesterUserID)
+	if err != nil {
+		log.Printf("GetListThreadsHTTP: Error getting exclusion IDs: %v", err)
+		excludeUserIDs = []uint32{}
+	}
+
+	threadServiceResp, err := h.threadClient.GetListThreads(c.Request.Context(), &threadpb.GetListThreadsRequest{
+		ListId:          listID,
+		RequesterUserId: &requesterUserID,
+		Page:            page,
+		Limit:           limit,
+		ExcludeUserIds:  excludeUserIDs,
+		Cursor:          c.Query("cursor"),
+	})
+	if err != nil {
+		handleGRPCError(c, "get list threads", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, FrontendFeedResponse{
+		Threads:    h.hydrateThreadList(c.Request.Context(), threadServiceResp.GetThreads()),
+		HasMore:    threadServiceResp.GetHasMore(),
+		NextCursor: threadServiceResp.GetNextCursor(),
+	})
+}
+
+// getOptionalUserID returns the logged-in user's ID on routes that also serve logged-out visitors, or 0.
+func getOptionalUserID(c *gin.Context) uint32 {
+	if userIDAny, exists := c.Get("userID"); exists {
+		if userID, ok := userIDAny.(uint); ok {
+			return uint32(userID)
+		}
+	}
+	return 0
+}
+
+func mapPbListsToFrontend(resp *userpb.GetListsResponse) FrontendListsResponse {
+	feResp := FrontendListsResponse{Lists: make([]FrontendList, 0, len(resp.GetLists())), HasMore: resp.GetHasMore()}
+	for _, list := range resp.GetLists() {
+		feResp.Lists = append(feResp.Lists, mapPbListToFrontend(list))
+	}
+	return feResp
+}
+
+func mapPbListToFrontend(list *userpb.List) FrontendList {
+	feList := FrontendList{
+		ID:                    list.GetId(),
+		Name:                  list.GetName(),
+		Description:           list.GetDescription(),
+		IsPrivate:             list.GetIsPrivate(),
+		MemberCount:           list.GetMemberCount(),
+		FollowerCount:         list.GetFollowerCount(),
+		IsFollowedByRequester: list.GetIsFollowedByRequester(),
+		CreatedAt:             list.GetCreatedAt().AsTime().Format(time.RFC3339),
+	}
+	if owner := list.GetOwner(); owner != nil {
+		feList.Owner = &FrontendUserProfile{
+			ID:             owner.GetId(),
+			Name:           owner.GetName(),
+			Username:       owner.GetUsername(),
+			ProfilePicture: owner.GetProfilePicture(),
+			AccountPrivacy: owner.GetAccountPrivacy(),
+			IsVerified:     owner.GetIsVerified(),
+		}
+	}
+	return feList
+}
@@ -0,0 +1,228 @@
+package http
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	"github.com/gin-gonic/gin"
+)
+
+type UpdateReplyRestrictionPayload struct {
+	ReplyRestriction string `json:"reply_restriction" binding:"required,oneof=EVERYONE FOLLOWING VERIFIED everyone following verified"`
+}
+
+type SetThreadSensitivityPayload struct {
+	IsSensitive    bool   `json:"is_sensitive"`
+	ContentWarning string `json:"content_warning"` // empty removes the warning
+}
+
+type FrontendModerationAction struct {
+	ID             uint32  `json:"id"`
+	ThreadID       uint32  `json:"thread_id"`
+	ActorID        uint32  `json:"actor_id"`
+	Action         string  `json:"action"` // hide_reply, unhide_reply, remove_mention, change_reply_restriction, mark_sensitive, unmark_sensitive
+	TargetThreadID *uint32 `json:"target_thread_id,omitempty"`
+	TargetUserID   *uint32 `json:"target_user_id,omitempty"`
+	Detail         string  `json:"detail,omitempty"`
+	CreatedAt      string  `json:"created_at"`
+}
+
+type FrontendModerationLogResponse struct {
+	Actions []FrontendModerationAction `json:"actions"`
+	HasMore bool                       `json:"has_more"`
+}
+
+// HideReplyHTTP hides a reply to one of the requester's threads.
+func (h *ThreadHandler) HideReplyHTTP(c *gin.Context) {
+	h.setReplyHidden(c, true)
+}
+
+func (h *ThreadHandler) UnhideReplyHTTP(c *gin.Context) {
+	h.setReplyHidden(c, false)
+}
+
+func (h *ThreadHandler) setReplyHidden(c *gin.Context, hidden bool) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	replyID, ok := getUint32Param(c, "threadId")
+	if !ok {
+		return
+	}
+
+	grpcReq := &threadpb.ReplyVisibilityRequest{ReplyThreadId: replyID, RequesterUserId: requesterUserID}
+	var err error
+	if hidden {
+		_, err = h.threadClient.HideReply(c.Request.Context(), grpcReq)
+	} else {
+		_, err = h.threadClient.UnhideReply(c.Request.Context(), grpcReq)
+	}
+	if err != nil {
+		handleGRPCError(c, "change reply visibility", err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *ThreadHandler) GetHiddenRepliesHTTP(c *gin.Context) {
+	parentThreadID, ok := getUint32Param(c, "threadId")
+	if !ok {
+		return
+	}
+
+	requesterUserID := getOptionalUserID(c)
+	page, limit := parsePagination(c)
+
+	excludeUserIDs, err := h.getFeedExclusionIDs(c.Request.Context(), requesterUserID)
+	if err != nil {
+		log.Printf("GetHiddenRepliesHTTP: Error getting exclusion IDs: %v", err)
+		excludeUserIDs = []uint32{}
+	}
+
+	threadServiceResp, err := h.threadClient.GetHiddenReplies(c.Request.Context(), &threadpb.GetRepliesRequest{
+		ParentThreadId:  parentThreadID,
+		RequesterUserId: &requesterUserID,
+		Page:            page,
+		Limit:           limit,
+		ExcludeUserIds:  excludeUserIDs,
+		Cursor:          c.Query("cursor"),
+	})
+	if err != nil {
+		handleGRPCError(c, "get hidden replies", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, FrontendFeedResponse{
+		Threads:    h.hydrateThreadList(c.Request.Context(), threadServiceResp.GetThreads()),
+		HasMore:    threadServiceResp.GetHasMore(),
+		NextCursor: threadServiceResp.GetNextCursor(),
+	})
+}
+
+// RemoveMentionHTTP takes the requester's @mention off someone else's thread.
+func (h *ThreadHandler) RemoveMentionHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	threadID, ok := getUint32Param(c, "threadId")
+	if !ok {
+		return
+	}
+
+	if _, err := h.threadClient.RemoveMention(c.Request.Context(), &threadpb.RemoveMentionRequest{ThreadId: threadID, UserId: requesterUserID}); err != nil {
+		handleGRPCError(c, "remove mention", err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *ThreadHandler) UpdateReplyRestrictionHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	threadID, ok := getUint32Param(c, "threadId")
+	if !ok {
+		return
+	}
+
+	var payload UpdateReplyRestrictionPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data: " + err.Error()})
+		return
+	}
+
+	updatedThread, err := h.threadClient.UpdateReplyRestriction(c.Request.Context(), &threadpb.UpdateReplyRestrictionRequest{
+		ThreadId:         threadID,
+		UserId:           requesterUserID,
+		ReplyRestriction: mapHTTPReplyRestrictionToProto(payload.ReplyRestriction),
+	})
+	if err != nil {
+		handleGRPCError(c, "update reply restriction", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, h.hydrateThreadList(c.Request.Context(), []*threadpb.Thread{updatedThread})[0])
+}
+
+// SetThreadSensitivityHTTP flags a thread as sensitive or sets its content warning. Moderators can also
+// flag other users' threads.
+func (h *ThreadHandler) SetThreadSensitivityHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	threadID, ok := getUint32Param(c, "threadId")
+	if !ok {
+		return
+	}
+
+	var payload SetThreadSensitivityPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data: " + err.Error()})
+		return
+	}
+
+	updatedThread, err := h.threadClient.SetThreadSensitivity(c.Request.Context(), &threadpb.SetThreadSensitivityRequest{
+		ThreadId:       threadID,
+		UserId:         requesterUserID,
+		IsSensitive:    payload.IsSensitive,
+		ContentWarning: payload.ContentWarning,
+	})
+	if err != nil {
+		handleGRPCError(c, "set thread sensitivity", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, h.hydrateThreadList(c.Request.Context(), []*threadpb.Thread{updatedThread})[0])
+}
+
+// GetModerationLogHTTP shows the author of a thread who hid replies, removed mentions or changed who can reply.
+func (h *ThreadHandler) GetModerationLogHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok {
+		return
+	}
+	threadID, ok := getUint32Param(c, "threadId")
+	if !ok {
+		return
+	}
+
+	page, limit := parsePagination(c)
+	resp, err := h.threadClient.GetModerationLog(c.Request.Context(), &threadpb.GetModerationLogRequest{
+		ThreadId:        threadID,
+		RequesterUserId: requesterUserID,
+		Page:            page,
+		Limit:           limit,
+	})
+	if err != nil {
+		handleGRPCError(c, "get moderation log", err)
+		return
+	}
+
+	feResp := FrontendModerationLogResponse{Actions: make([]FrontendModerationAction, 0, len(resp.GetActions())), HasMore: resp.GetHasMore()}
+	for _, a := range resp.GetActions() {
+		feAction := FrontendModerationAction{
+			ID:        a.GetId(),
+			ThreadID:  a.GetThreadId(),
+			ActorID:   a.GetActorId(),
+			Action:    a.GetAction(),
+			Detail:    a.GetDetail(),
+			CreatedAt: a.GetCreatedAt().AsTime().Format(time.RFC3339),
+		}
+		if a.TargetThreadId != nil {
+			val := a.GetTargetThreadId()
+			feAction.TargetThreadID = &val
+		}
+		if a.TargetUserId != nil {
+			val := a.GetTargetUserId()
+			feAction.TargetUserID = &val
+		}
+		feResp.Actions = append(feResp.Actions, feAction)
+	}
+	c.JSON(http.StatusOK, feResp)
+}
@@ -4,10 +4,12 @@ package http
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/Acad600-TPA/WEB-MJ-242/backend/api-gateway/client"
 	userpb "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genproto/proto"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ProfileHandler struct {
@@ -116,6 +118,129 @@ func (h *ProfileHandler) UnblockUser(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Successfully unblocked user"})
 }
 
+func (h *ProfileHandler) MuteUser(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok { return }
+
+	usernameToMute := c.Param("username")
+	targetUserPb, err := h.userClient.GetUserByUsername(c.Request.Context(), &userpb.GetUserByUsernameRequest{Username: usernameToMute})
+	if err != nil { handleGRPCError(c, "find user to mute", err); return }
+
+	_, err = h.userClient.MuteUser(c.Request.Context(), &userpb.MuteRequest{
+		MuterId: requesterUserID,
+		MutedId: targetUserPb.GetId(),
+	})
+	if err != nil { handleGRPCError(c, "mute user", err); return }
+	c.JSON(http.StatusOK, gin.H{"message": "Successfully muted user"})
+}
+
+func (h *ProfileHandler) UnmuteUser(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok { return }
+
+	usernameToUnmute := c.Param("username")
+	targetUserPb, err := h.userClient.GetUserByUsername(c.Request.Context(), &userpb.GetUserByUsernameRequest{Username: usernameToUnmute})
+	if err != nil { handleGRPCError(c, "find user to unmute", err); return }
+
+	_, err = h.userClient.UnmuteUser(c.Request.Context(), &userpb.MuteRequest{
+		MuterId: requesterUserID,
+		MutedId: targetUserPb.GetId(),
+	})
+	if err != nil { handleGRPCError(c, "unmute user", err); return }
+	c.JSON(http.StatusOK, gin.H{"message": "Successfully unmuted user"})
+}
+
+func (h *ProfileHandler) GetMutedAccountsHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok { return }
+
+	page, limit := parsePagination(c)
+	resp, err := h.userClient.GetMutedUsers(c.Request.Context(), &userpb.GetSocialListRequest{
+		UserId:          requesterUserID,
+		RequesterUserId: &requesterUserID,
+		Page:            page,
+		Limit:           limit,
+	})
+	if err != nil { handleGRPCError(c, "get muted accounts", err); return }
+	c.JSON(http.StatusOK, resp)
+}
+
+type AddMutedWordPayload struct {
+	Phrase    string   `json:"phrase" binding:"required"`
+	Scopes    []string `json:"scopes,omitempty"`     // "home", "replies", "notifications", "search"; empty mutes everywhere
+	ExpiresAt *string  `json:"expires_at,omitempty"` // RFC3339; omit to mute forever
+}
+
+type FrontendMutedWord struct {
+	ID        uint32   `json:"id"`
+	Phrase    string   `json:"phrase"`
+	Scopes    []string `json:"scopes"`
+	ExpiresAt *string  `json:"expires_at,omitempty"`
+	CreatedAt string   `json:"created_at"`
+}
+
+func mapPbMutedWordToFrontend(word *userpb.MutedWord) FrontendMutedWord {
+	feWord := FrontendMutedWord{
+		ID:        word.GetId(),
+		Phrase:    word.GetPhrase(),
+		Scopes:    word.GetScopes(),
+		CreatedAt: word.GetCreatedAt().AsTime().Format(time.RFC3339),
+	}
+	if word.ExpiresAt != nil {
+		expiresAt := word.GetExpiresAt().AsTime().Format(time.RFC3339)
+		feWord.ExpiresAt = &expiresAt
+	}
+	return feWord
+}
+
+func (h *ProfileHandler) GetMutedWordsHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok { return }
+
+	resp, err := h.userClient.GetMutedWords(c.Request.Context(), &userpb.GetMutedWordsRequest{UserId: requesterUserID})
+	if err != nil { handleGRPCError(c, "get muted words", err); return }
+
+	words := make([]FrontendMutedWord, 0, len(resp.GetMutedWords()))
+	for _, word := range resp.GetMutedWords() {
+		words = append(words, mapPbMutedWordToFrontend(word))
+	}
+	c.JSON(http.StatusOK, gin.H{"muted_words": words})
+}
+
+func (h *ProfileHandler) AddMutedWordHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok { return }
+
+	var payload AddMutedWordPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data: " + err.Error()})
+		return
+	}
+	grpcReq := &userpb.AddMutedWordRequest{UserId: requesterUserID, Phrase: payload.Phrase, Scopes: payload.Scopes}
+	if payload.ExpiresAt != nil && *payload.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339, *payload.ExpiresAt)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid expires_at format. Use ISO 8601 (RFC3339)."})
+			return
+		}
+		grpcReq.ExpiresAt = timestamppb.New(expiresAt)
+	}
+
+	word, err := h.userClient.AddMutedWord(c.Request.Context(), grpcReq)
+	if err != nil { handleGRPCError(c, "mute word", err); return }
+	c.JSON(http.StatusCreated, mapPbMutedWordToFrontend(word))
+}
+
+func (h *ProfileHandler) RemoveMutedWordHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok { return }
+	wordID, ok := getUint32Param(c, "wordId")
+	if !ok { return }
+
+	_, err := h.userClient.RemoveMutedWord(c.Request.Context(), &userpb.RemoveMutedWordRequest{UserId: requesterUserID, MutedWordId: wordID})
+	if err != nil { handleGRPCError(c, "unmute word", err); return }
+	c.Status(http.StatusNoContent)
+}
 
 func (h *ProfileHandler) GetFollowers(c *gin.Context) {
 	username := c.Param("username")
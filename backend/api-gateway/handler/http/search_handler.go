@@ -1,12 +1,14 @@
 package http
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"strconv"
 	"strings"
 	"sync"
 
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/api-gateway/analytics"
 	"github.com/Acad600-TPA/WEB-MJ-242/backend/api-gateway/client"
 	mediapb "github.com/Acad600-TPA/WEB-MJ-242/backend/media-service/genproto/proto"
 	searchpb "github.com/Acad600-TPA/WEB-MJ-242/backend/search-service/genproto/proto"
@@ -20,10 +22,11 @@ type SearchHandler struct {
 	userClient   *client.UserClient
 	threadClient *client.ThreadClient
 	mediaClient *client.MediaClient
+	views       *analytics.Batcher
 }
 
-func NewSearchHandler(sc *client.SearchClient, uc *client.UserClient, tc *client.ThreadClient, mc *client.MediaClient) *SearchHandler {
-	return &SearchHandler{searchClient: sc, userClient: uc, threadClient: tc, mediaClient: mc}
+func NewSearchHandler(sc *client.SearchClient, uc *client.UserClient, tc *client.ThreadClient, mc *client.MediaClient, views *analytics.Batcher) *SearchHandler {
+	return &SearchHandler{searchClient: sc, userClient: uc, threadClient: tc, mediaClient: mc, views: views}
 }
 
 type SearchUsersAPIResponse struct {
@@ -123,6 +126,7 @@ func (h *SearchHandler) SearchThreadsHTTP(c *gin.Context) {
 		c.JSON(http.StatusOK, SearchThreadsAPIResponse{Threads: []FrontendThreadData{}, HasMore: false})
 		return
 	}
+	h.dropMutedSearchResults(c.Request.Context(), searchServiceResp, requesterUserID)
 
 	// 2. Collect IDs
 	threadIDsToFetchFullDetails := make([]uint32, 0, len(searchServiceResp.GetThreadResults()))
@@ -238,12 +242,8 @@ func (h *SearchHandler) SearchThreadsHTTP(c *gin.Context) {
 			feThread := mapProtoThreadToFrontend(fullThreadData, authorsProfileMap, mediaMetadataMap)
 			frontendThreads = append(frontendThreads, feThread)
 		} else {
-			log.Printf("SearchThreadsHTTP: Full details not found or fetch failed for searched thread ID %d, using minimal data.", searchResult.GetId())
-             frontendThreads = append(frontendThreads, FrontendThreadData{
-                 ID: searchResult.GetId(),
-                 UserID: searchResult.GetUserId(),
-                 Content: searchResult.GetContentSnippet(),
-             })
+			// The indexed snippet isn't masked for sensitive threads, so results without details are left out
+			log.Printf("SearchThreadsHTTP: Full details not found or fetch failed for searched thread ID %d, skipping.", searchResult.GetId())
 		}
 	}
 
@@ -311,12 +311,33 @@ func (h *SearchHandler) SearchThreadsHTTP(c *gin.Context) {
         }
     }
 
+	recordThreadViews(c, h.views, analytics.KindImpression, finalFilteredThreads)
 	c.JSON(http.StatusOK, SearchThreadsAPIResponse{
 		Threads: finalFilteredThreads,
 		HasMore: searchServiceResp.GetHasMore(),
 	})
 }
 
+// dropMutedSearchResults removes the threads the requester muted for search. On error the results are kept.
+func (h *SearchHandler) dropMutedSearchResults(ctx context.Context, resp *searchpb.SearchThreadIDsResponse, requesterUserID uint32) {
+	if requesterUserID == 0 || h.threadClient == nil { return }
+	ids := make([]uint32, 0, len(resp.GetThreadResults()))
+	for _, result := range resp.GetThreadResults() { ids = append(ids, result.GetId()) }
+
+	filtered, err := h.threadClient.FilterMutedThreads(ctx, &threadpb.FilterMutedThreadsRequest{ViewerId: requesterUserID, Scope: "search", ThreadIds: ids})
+	if err != nil {
+		log.Printf("SearchThreadsHTTP: Failed to filter muted threads for user %d: %v", requesterUserID, err)
+		return
+	}
+	kept := make(map[uint32]bool, len(filtered.GetThreadIds()))
+	for _, id := range filtered.GetThreadIds() { kept[id] = true }
+	results := resp.ThreadResults[:0]
+	for _, result := range resp.GetThreadResults() {
+		if kept[result.GetId()] { results = append(results, result) }
+	}
+	resp.ThreadResults = results
+}
+
 func (h *SearchHandler) GetTrendingHashtagsHTTP(c *gin.Context) {
 	limitStr := c.DefaultQuery("limit", "10")
 	limit, err := strconv.Atoi(limitStr)
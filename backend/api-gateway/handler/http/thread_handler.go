@@ -2,7 +2,6 @@ package http
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -11,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/api-gateway/analytics"
 	"github.com/Acad600-TPA/WEB-MJ-242/backend/api-gateway/client"
 	mediapb "github.com/Acad600-TPA/WEB-MJ-242/backend/media-service/genproto/proto"
 	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
@@ -25,10 +25,11 @@ type ThreadHandler struct {
 	threadClient *client.ThreadClient
 	mediaClient  *client.MediaClient
 	userClient  *client.UserClient
+	views       *analytics.Batcher // nil disables view recording
 }
 
-func NewThreadHandler(threadClient *client.ThreadClient, mediaClient *client.MediaClient, userClient *client.UserClient) *ThreadHandler {
-	return &ThreadHandler{threadClient: threadClient, mediaClient: mediaClient, userClient: userClient}
+func NewThreadHandler(threadClient *client.ThreadClient, mediaClient *client.MediaClient, userClient *client.UserClient, views *analytics.Batcher) *ThreadHandler {
+	return &ThreadHandler{threadClient: threadClient, mediaClient: mediaClient, userClient: userClient, views: views}
 }
 
 // Payload for creating a thread (matches frontend structure)
@@ -40,6 +41,24 @@ type CreateThreadPayload struct {
 	CommunityID      *uint32  `json:"community_id,omitempty"`
 	MediaIDs         []uint32 `json:"media_ids,omitempty"`
 	Categories       []string `json:"categories,omitempty"`
+	QuotedThreadID   *uint32  `json:"quoted_thread_id,omitempty"`
+	PollOptions      []string `json:"poll_options,omitempty"`
+	PollClosesAt     *string  `json:"poll_closes_at,omitempty"` // defaults to 24h after the thread goes live
+	ContentWarning   string   `json:"content_warning,omitempty"`
+	IsSensitive      bool     `json:"is_sensitive,omitempty"`
+}
+
+type ChainPostPayload struct {
+	Content    string   `json:"content"`
+	MediaIDs   []uint32 `json:"media_ids,omitempty"`
+	Categories []string `json:"categories,omitempty"`
+}
+
+type CreateThreadChainPayload struct {
+	Posts            []ChainPostPayload `json:"posts" binding:"required,min=2,max=25"` // in order; each replies to the one before
+	ReplyRestriction string             `json:"reply_restriction,omitempty"`
+	ScheduledAt      *string            `json:"scheduled_at,omitempty"`
+	CommunityID      *uint32            `json:"community_id,omitempty"`
 }
 
 type FrontendMediaMetadata struct {
@@ -63,6 +82,30 @@ type FrontendUserProfile struct {
 	IsVerified	bool   `json:"is_verified"`
 }
 
+type FrontendPollOption struct {
+	ID        uint32 `json:"id"`
+	Text      string `json:"text"`
+	VoteCount int32  `json:"vote_count"` // 0 until results are visible
+}
+
+type FrontendPoll struct {
+	ID             uint32               `json:"id"`
+	Options        []FrontendPollOption `json:"options"`
+	ClosesAt       string               `json:"closes_at"`
+	IsClosed       bool                 `json:"is_closed"`
+	ResultsVisible bool                 `json:"results_visible"`
+	VotedOptionID  *uint32              `json:"voted_option_id,omitempty"`
+	TotalVotes     int32                `json:"total_votes"`
+}
+
+type FrontendLinkPreview struct {
+	URL         string `json:"url"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	ImageURL    string `json:"image_url,omitempty"`
+	SiteName    string `json:"site_name,omitempty"`
+}
+
 type FrontendThreadData struct {
 	ID               uint32                `json:"id"`
 	UserID           uint32                `json:"user_id"`
@@ -84,11 +127,29 @@ type FrontendThreadData struct {
 	IsLikedByCurrentUser        bool                  `json:"is_liked_by_current_user"`
 	IsBookmarkedByCurrentUser   bool                  `json:"is_bookmarked_by_current_user"`
 	Categories	   []string             `json:"categories,omitempty"`
+	CanReply                    bool                  `json:"can_reply"`
+	QuoteCount                  int32                 `json:"quote_count"`
+	IsRepostedByCurrentUser     bool                  `json:"is_reposted_by_current_user"`
+	QuotedThreadID              *uint32               `json:"quoted_thread_id,omitempty"`
+	QuotedThread                *FrontendThreadData   `json:"quoted_thread,omitempty"` // Hydrated, one level deep
+	RepostedBy                  *FrontendUserProfile  `json:"reposted_by,omitempty"`   // Set when a followed user's repost surfaced this thread
+	RepostedAt                  *string               `json:"reposted_at,omitempty"`
+	EditedAt                    *string               `json:"edited_at,omitempty"`
+	Poll                        *FrontendPoll         `json:"poll,omitempty"`
+	ParentDeleted               bool                  `json:"parent_deleted,omitempty"` // reply whose parent was deleted
+	MentionedUsernames          []string              `json:"mentioned_usernames"`         // only these @handles link to profiles
+	AdCampaignID                *uint32               `json:"ad_campaign_id,omitempty"`    // set on promoted threads placed in a feed
+	LinkPreview                 *FrontendLinkPreview  `json:"link_preview,omitempty"`      // card for the first link in content, once fetched
+	IsHidden                    bool                  `json:"is_hidden,omitempty"`         // reply hidden by the parent thread's author
+	ContentWarning              string                `json:"content_warning,omitempty"`
+	IsSensitive                 bool                  `json:"is_sensitive,omitempty"`
+	IsMasked                    bool                  `json:"is_masked,omitempty"`         // sensitive parts withheld; the viewer hasn't opted in
 }
 
 type FrontendFeedResponse struct {
-	Threads []FrontendThreadData `json:"threads"`
-	HasMore bool                 `json:"has_more"`
+	Threads    []FrontendThreadData `json:"threads"`
+	HasMore    bool                 `json:"has_more"`
+	NextCursor string               `json:"next_cursor,omitempty"` // pass back as ?cursor= for the next page
 }
 
 
@@ -113,6 +174,8 @@ func (h *ThreadHandler) CreateThread(c *gin.Context) {
 		Content:  payload.Content,
 		MediaIds: payload.MediaIDs,
 		Categories: payload.Categories,
+		ContentWarning: payload.ContentWarning,
+		IsSensitive: payload.IsSensitive,
 	}
 
 	grpcReq.ReplyRestriction = mapHTTPReplyRestrictionToProto(payload.ReplyRestriction)
@@ -123,6 +186,9 @@ func (h *ThreadHandler) CreateThread(c *gin.Context) {
 	if payload.CommunityID != nil {
 		grpcReq.CommunityId = payload.CommunityID
 	}
+	if payload.QuotedThreadID != nil {
+		grpcReq.QuotedThreadId = payload.QuotedThreadID
+	}
 	if payload.ScheduledAt != nil && *payload.ScheduledAt != "" {
 		t, err := time.Parse(time.RFC3339, *payload.ScheduledAt)
 		if err != nil {
@@ -131,6 +197,17 @@ func (h *ThreadHandler) CreateThread(c *gin.Context) {
 		}
 		grpcReq.ScheduledAt = timestamppb.New(t)
 	}
+	if len(payload.PollOptions) > 0 {
+		grpcReq.PollOptions = payload.PollOptions
+		if payload.PollClosesAt != nil && *payload.PollClosesAt != "" {
+			t, err := time.Parse(time.RFC3339, *payload.PollClosesAt)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid poll_closes_at format. Use ISO 8601 (RFC3339)."})
+				return
+			}
+			grpcReq.PollClosesAt = timestamppb.New(t)
+		}
+	}
 
 	// Call Thread Service
 	createdThread, err := h.threadClient.CreateThread(c.Request.Context(), grpcReq)
@@ -142,6 +219,47 @@ func (h *ThreadHandler) CreateThread(c *gin.Context) {
 	c.JSON(http.StatusCreated, createdThread)
 }
 
+// CreateThreadChain posts a series of threads as one self-reply chain. Either every post is created or none is.
+func (h *ThreadHandler) CreateThreadChain(c *gin.Context) {
+	userID, ok := getUserIDFromContext(c)
+	if !ok { return }
+
+	var payload CreateThreadChainPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data: " + err.Error()})
+		return
+	}
+
+	grpcReq := &threadpb.CreateThreadChainRequest{
+		UserId:           userID,
+		ReplyRestriction: mapHTTPReplyRestrictionToProto(payload.ReplyRestriction),
+		CommunityId:      payload.CommunityID,
+	}
+	for i, post := range payload.Posts {
+		if post.Content == "" && len(post.MediaIDs) == 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("Post %d must contain content or media", i+1)})
+			return
+		}
+		grpcReq.Posts = append(grpcReq.Posts, &threadpb.ChainPost{Content: post.Content, MediaIds: post.MediaIDs, Categories: post.Categories})
+	}
+	if payload.ScheduledAt != nil && *payload.ScheduledAt != "" {
+		t, err := time.Parse(time.RFC3339, *payload.ScheduledAt)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid scheduled_at format. Use ISO 8601 (RFC3339)."})
+			return
+		}
+		grpcReq.ScheduledAt = timestamppb.New(t)
+	}
+
+	createdChain, err := h.threadClient.CreateThreadChain(c.Request.Context(), grpcReq)
+	if err != nil {
+		handleGRPCError(c, "create thread chain", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, createdChain)
+}
+
 func (h *ThreadHandler) GetThread(c *gin.Context) {
 	currentUserID, _ := getUserIDFromContext(c)
 	threadID, ok := getUint32Param(c, "threadId")
@@ -153,25 +271,8 @@ func (h *ThreadHandler) GetThread(c *gin.Context) {
 		handleGRPCError(c, "get thread", err)
 		return
 	}
-	var authorsMap map[uint32]*userpb.User
-	var mediaMap map[uint32]*mediapb.Media
-	var userErr, mediaErr error // Not strictly needed if fetching for single thread without goroutines
-
-	if threadProto.GetUserId() != 0 {
-		// Fetch single author
-		resp, err := h.userClient.GetUserProfilesByIds(c.Request.Context(), &userpb.GetUserProfilesByIdsRequest{UserIds: []uint32{threadProto.GetUserId()}})
-		if err == nil && resp != nil { authorsMap = resp.GetUsers() } else { userErr = err }
-	}
-	if len(threadProto.GetMediaIds()) > 0 {
-		// Fetch multiple media
-		resp, err := h.mediaClient.GetMultipleMediaMetadata(c.Request.Context(), &mediapb.GetMultipleMediaMetadataRequest{MediaIds: threadProto.GetMediaIds()})
-		if err == nil && resp != nil { mediaMap = resp.GetMediaItems() } else { mediaErr = err }
-	}
-    if userErr != nil { log.Printf("Error fetching author for GetThread: %v", userErr) }
-    if mediaErr != nil { log.Printf("Error fetching media for GetThread: %v", mediaErr) }
-
-
-	feThread := mapProtoThreadToFrontend(threadProto, authorsMap, mediaMap)
+	feThread := h.hydrateThreadList(c.Request.Context(), []*threadpb.Thread{threadProto})[0]
+	recordThreadViews(c, h.views, analytics.KindDetailView, []FrontendThreadData{feThread})
 	c.JSON(http.StatusOK, feThread)
 }
 
@@ -220,6 +321,14 @@ func (h *ThreadHandler) UnbookmarkThread(c *gin.Context) {
 	h.handleInteraction(c, "unbookmark")
 }
 
+func (h *ThreadHandler) RepostThread(c *gin.Context) {
+	h.handleInteraction(c, "repost")
+}
+
+func (h *ThreadHandler) UnrepostThread(c *gin.Context) {
+	h.handleInteraction(c, "unrepost")
+}
+
 func (h *ThreadHandler) handleInteraction(c *gin.Context, action string) {
 	userID, ok := getUserIDFromContext(c)
 	if !ok { return }
@@ -243,6 +352,12 @@ func (h *ThreadHandler) handleInteraction(c *gin.Context, action string) {
 	case "unbookmark":
 		_, err = h.threadClient.UnbookmarkThread(c.Request.Context(), grpcReq)
 		operation = "unbookmark thread"
+	case "repost":
+		_, err = h.threadClient.Repost(c.Request.Context(), grpcReq)
+		operation = "repost thread"
+	case "unrepost":
+		_, err = h.threadClient.Unrepost(c.Request.Context(), grpcReq)
+		operation = "unrepost thread"
 	default:
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid interaction action"})
 		return
@@ -282,36 +397,20 @@ func (h *ThreadHandler) GetFeed(c *gin.Context) {
 		return
 	}
 
-	// 2. Get IDs to include (only if "following" feed and requester is authenticated)
-	var includeOnlyUserIDs []uint32
-	if feedType == "following" {
-		if requesterUserID == 0 {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Must be logged in to view the 'following' feed"})
-			return
-		}
-		followingResp, err := h.userClient.GetFollowingIDs(c.Request.Context(), &userpb.SocialListRequest{UserId: requesterUserID, Limit: 10000}) // Fetch all for now
-		if err != nil {
-			log.Printf("GetFeed: Error getting following IDs for user %d: %v", requesterUserID, err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load following feed data"})
-			return
-		}
-		if followingResp != nil {
-			includeOnlyUserIDs = followingResp.GetUserIds()
-		}
-		if len(includeOnlyUserIDs) == 0 { // User follows no one
-			c.JSON(http.StatusOK, FrontendFeedResponse{Threads: []FrontendThreadData{}, HasMore: false})
-			return
-		}
+	// 2. The following feed is read from the requester's timeline in thread-service
+	if feedType == "following" && requesterUserID == 0 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Must be logged in to view the 'following' feed"})
+		return
 	}
 
-
 	grpcReq := &threadpb.GetFeedThreadsRequest{
 		CurrentUserId:   &requesterUserID,
 		Page:     int32(page),
 		Limit:    int32(limit),
 		FeedType: feedType,
 		ExcludeUserIds:     excludeUserIDs,
-		IncludeOnlyUserIds: includeOnlyUserIDs,
+		Cursor:             c.Query("cursor"),
+		SessionId:          c.GetHeader(sessionIDHeader),
 	}
 
 	// 3. Fetch base threads from Thread Service
@@ -322,77 +421,14 @@ func (h *ThreadHandler) GetFeed(c *gin.Context) {
 	}
 
 	if len(threadServiceResp.GetThreads()) == 0 {
-		c.JSON(http.StatusOK, FrontendFeedResponse{Threads: []FrontendThreadData{}, HasMore: false})
+		c.JSON(http.StatusOK, FrontendFeedResponse{Threads: []FrontendThreadData{}, HasMore: threadServiceResp.GetHasMore(), NextCursor: threadServiceResp.GetNextCursor()})
 		return
 	}
 
-	// 4. Collect User IDs and Media IDs for batch fetching
-	authorIDsSet := make(map[uint32]bool)
-	mediaIDsSet := make(map[uint32]bool)
-	for _, t := range threadServiceResp.GetThreads() {
-		if t.GetUserId() != 0 {
-			authorIDsSet[t.GetUserId()] = true
-		}
-		for _, mediaID := range t.GetMediaIds() {
-			if mediaID != 0 {
-				mediaIDsSet[mediaID] = true
-			}
-		}
-	}
-
-	var authorIDs []uint32
-	for id := range authorIDsSet { authorIDs = append(authorIDs, id) }
-	var mediaIDs []uint32
-	for id := range mediaIDsSet { mediaIDs = append(mediaIDs, id) }
-
-	// 5. Fetch Author and Media data in parallel
-	var wg sync.WaitGroup
-	var authorsMap map[uint32]*userpb.User
-	var mediaMap map[uint32]*mediapb.Media
-	var userErr, mediaErr error
-
-	if len(authorIDs) > 0 {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			resp, err := h.userClient.GetUserProfilesByIds(c.Request.Context(), &userpb.GetUserProfilesByIdsRequest{UserIds: authorIDs})
-			if err != nil {
-				userErr = err
-				return
-			}
-			authorsMap = resp.GetUsers()
-		}()
-	}
+	// 4. Hydrate authors, reposters, quoted threads and media
+	hydratedThreads := h.hydrateThreadList(c.Request.Context(), threadServiceResp.GetThreads())
 
-	if len(mediaIDs) > 0 {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			resp, err := h.mediaClient.GetMultipleMediaMetadata(c.Request.Context(), &mediapb.GetMultipleMediaMetadataRequest{MediaIds: mediaIDs})
-			if err != nil {
-				mediaErr = err
-				return
-			}
-			mediaMap = resp.GetMediaItems()
-		}()
-	}
-	wg.Wait()
-
-	if userErr != nil {
-		log.Printf("Error fetching author profiles: %v", userErr)
-	}
-	if mediaErr != nil {
-		log.Printf("Error fetching media metadata: %v", mediaErr)
-	}
-
-	// 6. Hydrate Threads
-	hydratedThreads := make([]FrontendThreadData, 0, len(threadServiceResp.GetThreads()))
-	for _, tProto := range threadServiceResp.GetThreads() {
-		feThread := mapProtoThreadToFrontend(tProto, authorsMap, mediaMap)
-		hydratedThreads = append(hydratedThreads, feThread)
-	}
-
-	// 7. Apply Privacy Filtering
+	// 5. Apply Privacy Filtering
 	finalFilteredThreads := []FrontendThreadData{}
 	if requesterUserID != 0 { // Authenticated user: apply complex privacy checks
 		authorsToCheck := make(map[uint32]bool)
@@ -469,9 +505,11 @@ func (h *ThreadHandler) GetFeed(c *gin.Context) {
 		}
 	}
 
+	recordThreadViews(c, h.views, analytics.KindImpression, hydratedThreads)
 	c.JSON(http.StatusOK, FrontendFeedResponse{
 		Threads: hydratedThreads,
 		HasMore: threadServiceResp.GetHasMore(),
+		NextCursor: threadServiceResp.GetNextCursor(),
 	})
 }
 
@@ -576,6 +614,7 @@ func (h *ThreadHandler) GetUserSpecificThreads(c *gin.Context) {
 		Page:           page,
 		Limit:          limit,
 		ExcludeUserIds: excludeUserIDs,
+		Cursor:         c.Query("cursor"),
 	}
 
 	threadServiceResp, err := h.threadClient.GetUserThreads(c.Request.Context(), grpcReq)
@@ -589,62 +628,13 @@ func (h *ThreadHandler) GetUserSpecificThreads(c *gin.Context) {
 		c.JSON(http.StatusOK, FrontendFeedResponse{Threads: []FrontendThreadData{}, HasMore: false}) // Use same FrontendFeedResponse
 		return
 	}
-	authorIDsSet := make(map[uint32]bool)
-	mediaIDsSet := make(map[uint32]bool)
-	for _, t := range threadServiceResp.GetThreads() {
-		if t.GetUserId() != 0 { authorIDsSet[t.GetUserId()] = true }
-		for _, mediaID := range t.GetMediaIds() { if mediaID != 0 { mediaIDsSet[mediaID] = true } }
-	}
-	var authorIDs []uint32; for id := range authorIDsSet { authorIDs = append(authorIDs, id) }
-	var mediaIDs []uint32; for id := range mediaIDsSet { mediaIDs = append(mediaIDs, id) }
-
-	var wg sync.WaitGroup
-	var authorsMap map[uint32]*userpb.User
-	var mediaMap map[uint32]*mediapb.Media
-	var userErr, mediaErr error
-
-	if len(authorIDs) > 0 {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if h.userClient == nil {
-				log.Println("GetUserSpecificThreads: userClient in ThreadHandler is nil")
-				userErr = errors.New("user client not configured in thread handler for hydration")
-				return
-			}
-			resp, err := h.userClient.GetUserProfilesByIds(c.Request.Context(), &userpb.GetUserProfilesByIdsRequest{UserIds: authorIDs})
-			if err != nil { userErr = err; return }
-			authorsMap = resp.GetUsers()
-		}()
-	}
-	if len(mediaIDs) > 0 {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			if h.mediaClient == nil {
-				log.Println("GetUserSpecificThreads: mediaClient in ThreadHandler is nil")
-				mediaErr = errors.New("media client not configured in thread handler for hydration")
-				return
-			}
-			resp, err := h.mediaClient.GetMultipleMediaMetadata(c.Request.Context(), &mediapb.GetMultipleMediaMetadataRequest{MediaIds: mediaIDs})
-			if err != nil { mediaErr = err; return }
-			mediaMap = resp.GetMediaItems()
-		}()
-	}
-	wg.Wait()
-    if userErr != nil { log.Printf("Error fetching authors for user threads: %v", userErr)}
-    if mediaErr != nil { log.Printf("Error fetching media for user threads: %v", mediaErr)}
-
-
-	hydratedThreads := make([]FrontendThreadData, 0, len(threadServiceResp.GetThreads()))
-	for _, tProto := range threadServiceResp.GetThreads() {
-		feThread := mapProtoThreadToFrontend(tProto, authorsMap, mediaMap)
-		hydratedThreads = append(hydratedThreads, feThread)
-	}
+	hydratedThreads := h.hydrateThreadList(c.Request.Context(), threadServiceResp.GetThreads())
+	recordThreadViews(c, h.views, analytics.KindImpression, hydratedThreads)
 
 	c.JSON(http.StatusOK, FrontendFeedResponse{
 		Threads: hydratedThreads,
 		HasMore: threadServiceResp.GetHasMore(),
+		NextCursor: threadServiceResp.GetNextCursor(),
 	})
 }
 
@@ -665,6 +655,7 @@ func (h *ThreadHandler) GetBookmarkedThreadsHTTP(c *gin.Context) {
 		RequesterUserId: &requesterUserID,
 		Page:           page,
 		Limit:          limit,
+		Cursor:         c.Query("cursor"),
 	}
 
 	threadServiceResp, err := h.threadClient.GetBookmarkedThreads(c.Request.Context(), grpcReq)
@@ -678,55 +669,47 @@ func (h *ThreadHandler) GetBookmarkedThreadsHTTP(c *gin.Context) {
         c.JSON(http.StatusOK, FrontendFeedResponse{Threads: []FrontendThreadData{}, HasMore: false})
         return
     }
-	authorIDsSet := make(map[uint32]bool)
-	mediaIDsSet := make(map[uint32]bool)
-	for _, t := range threadServiceResp.GetThreads() {
-		if t.GetUserId() != 0 {
-			authorIDsSet[t.GetUserId()] = true
-		}
-		for _, mediaID := range t.GetMediaIds() {
-			if mediaID != 0 {
-				mediaIDsSet[mediaID] = true
-			}
-		}
-	}
-	var authorIDs []uint32
-	for id := range authorIDsSet {
-		authorIDs = append(authorIDs, id)
+	hydratedThreads := h.hydrateThreadList(c.Request.Context(), threadServiceResp.GetThreads())
+
+	c.JSON(http.StatusOK, FrontendFeedResponse{
+		Threads: hydratedThreads,
+		HasMore: threadServiceResp.GetHasMore(),
+		NextCursor: threadServiceResp.GetNextCursor(),
+	})
+}
+
+// GetMentionsHTTP lists the threads that mention the current user, newest first.
+func (h *ThreadHandler) GetMentionsHTTP(c *gin.Context) {
+	requesterUserID, ok := getUserIDFromContext(c)
+	if !ok { return }
+
+	page, limit := parsePagination(c)
+
+	excludeUserIDs, err := h.getFeedExclusionIDs(c.Request.Context(), requesterUserID)
+	if err != nil {
+		log.Printf("GetMentionsHTTP: Error getting exclusion IDs: %v", err)
+		excludeUserIDs = []uint32{}
 	}
-	var mediaIDs []uint32
-	for id := range mediaIDsSet {
-		mediaIDs = append(mediaIDs, id)
+
+	grpcReq := &threadpb.GetMentionsRequest{
+		UserId:          requesterUserID,
+		RequesterUserId: &requesterUserID,
+		Page:            page,
+		Limit:           limit,
+		ExcludeUserIds:  excludeUserIDs,
+		Cursor:          c.Query("cursor"),
 	}
-	var wg sync.WaitGroup
-	var authorsMap map[uint32]*userpb.User
-	var mediaMap map[uint32]*mediapb.Media
-	var userErr, mediaErr error
-    if len(authorIDs) > 0 {
-        wg.Add(1); go func() { defer wg.Done()
-            resp, err := h.userClient.GetUserProfilesByIds(c.Request.Context(), &userpb.GetUserProfilesByIdsRequest{UserIds: authorIDs})
-            if err != nil { userErr = err; return }; authorsMap = resp.GetUsers()
-        }()
-    }
-    if len(mediaIDs) > 0 {
-        wg.Add(1); go func() { defer wg.Done()
-            resp, err := h.mediaClient.GetMultipleMediaMetadata(c.Request.Context(), &mediapb.GetMultipleMediaMetadataRequest{MediaIds: mediaIDs})
-            if err != nil { mediaErr = err; return }; mediaMap = resp.GetMediaItems()
-        }()
-    }
-    wg.Wait()
-    if userErr != nil { log.Printf("Error fetching authors for bookmarks: %v", userErr) }
-    if mediaErr != nil { log.Printf("Error fetching media for bookmarks: %v", mediaErr) }
-    hydratedThreads := make([]FrontendThreadData, 0, len(threadServiceResp.GetThreads()))
-    for _, tProto := range threadServiceResp.GetThreads() {
-        feThread := mapProtoThreadToFrontend(tProto, authorsMap, mediaMap)
-        hydratedThreads = append(hydratedThreads, feThread)
-    }
 
+	threadServiceResp, err := h.threadClient.GetMentions(c.Request.Context(), grpcReq)
+	if err != nil {
+		handleGRPCError(c, "get mentions", err)
+		return
+	}
 
 	c.JSON(http.StatusOK, FrontendFeedResponse{
-		Threads: hydratedThreads,
-		HasMore: threadServiceResp.GetHasMore(),
+		Threads:    h.hydrateThreadList(c.Request.Context(), threadServiceResp.GetThreads()),
+		HasMore:    threadServiceResp.GetHasMore(),
+		NextCursor: threadServiceResp.GetNextCursor(),
 	})
 }
 
@@ -749,6 +732,7 @@ func (h *ThreadHandler) GetRepliesHTTP(c *gin.Context) {
 		Page:            page,
 		Limit:           limit,
 		ExcludeUserIds:  excludeUserIDs,
+		Cursor:          c.Query("cursor"), // e.g. more_replies_cursor from a conversation
 	}
 
 	threadServiceResp, err := h.threadClient.GetReplies(c.Request.Context(), grpcReq)
@@ -762,54 +746,518 @@ func (h *ThreadHandler) GetRepliesHTTP(c *gin.Context) {
 		return
 	}
 
-	authorIDsSet := make(map[uint32]bool)
-	mediaIDsSet := make(map[uint32]bool)
-	for _, t := range threadServiceResp.GetThreads() {
-		if t.GetUserId() != 0 { authorIDsSet[t.GetUserId()] = true }
-		for _, mediaID := range t.GetMediaIds() { if mediaID != 0 { mediaIDsSet[mediaID] = true } }
+	hydratedThreads := h.hydrateThreadList(c.Request.Context(), threadServiceResp.GetThreads())
+
+	c.JSON(http.StatusOK, FrontendFeedResponse{
+		Threads: hydratedThreads,
+		HasMore: threadServiceResp.GetHasMore(),
+		NextCursor: threadServiceResp.GetNextCursor(),
+	})
+}
+
+type FrontendConversationNode struct {
+	Thread            FrontendThreadData         `json:"thread"`
+	Replies           []FrontendConversationNode `json:"replies"`
+	MoreRepliesCursor string                     `json:"more_replies_cursor,omitempty"` // for GET /threads/:id/replies?cursor=
+}
+
+type FrontendConversationResponse struct {
+	Ancestors []FrontendThreadData     `json:"ancestors"` // root first
+	Focus     FrontendConversationNode `json:"focus"`
+}
+
+func (h *ThreadHandler) GetConversationHTTP(c *gin.Context) {
+	threadID, ok := getUint32Param(c, "threadId")
+	if !ok { return }
+	requesterUserID, _ := getUserIDFromContext(c)
+
+	depth, _ := strconv.Atoi(c.DefaultQuery("depth", "0"))
+	fanOut, _ := strconv.Atoi(c.DefaultQuery("fan_out", "0"))
+
+	excludeUserIDs, err := h.getFeedExclusionIDs(c.Request.Context(), requesterUserID)
+	if err != nil {
+		log.Printf("GetConversationHTTP: Error getting exclusion IDs: %v", err)
+		excludeUserIDs = []uint32{}
 	}
-	var authorIDs []uint32
-	for id := range authorIDsSet { authorIDs = append(authorIDs, id) }
-	var mediaIDs []uint32
-	for id := range mediaIDsSet { mediaIDs = append(mediaIDs, id) }
-	var wg sync.WaitGroup
-	var authorsMap map[uint32]*userpb.User
-	var mediaMap map[uint32]*mediapb.Media
-	var userErr, mediaErr error
 
-	if len(authorIDs) > 0 && h.userClient != nil {
-		wg.Add(1)
+	resp, err := h.threadClient.GetConversation(c.Request.Context(), &threadpb.GetConversationRequest{
+		ThreadId:        threadID,
+		RequesterUserId: &requesterUserID,
+		MaxDepth:        int32(depth),
+		FanOut:          int32(fanOut),
+		ExcludeUserIds:  excludeUserIDs,
+	})
+	if err != nil {
+		handleGRPCError(c, "get conversation", err)
+		return
+	}
+
+	// Hydrate the ancestors and the whole tree in one pass, then rebuild the tree in the same order
+	threads := append([]*threadpb.Thread{}, resp.GetAncestors()...)
+	var flatten func(node *threadpb.ConversationNode)
+	flatten = func(node *threadpb.ConversationNode) {
+		threads = append(threads, node.GetThread())
+		for _, reply := range node.GetReplies() { flatten(reply) }
+	}
+	flatten(resp.GetFocus())
+	hydrated := h.hydrateThreadList(c.Request.Context(), threads)
+
+	next := len(resp.GetAncestors())
+	var build func(node *threadpb.ConversationNode) FrontendConversationNode
+	build = func(node *threadpb.ConversationNode) FrontendConversationNode {
+		feNode := FrontendConversationNode{
+			Thread:            hydrated[next],
+			Replies:           []FrontendConversationNode{},
+			MoreRepliesCursor: node.GetMoreRepliesCursor(),
+		}
+		next++
+		for _, reply := range node.GetReplies() { feNode.Replies = append(feNode.Replies, build(reply)) }
+		return feNode
+	}
+
+	c.JSON(http.StatusOK, FrontendConversationResponse{
+		Ancestors: hydrated[:len(resp.GetAncestors())],
+		Focus:     build(resp.GetFocus()),
+	})
+}
+
+func (h *ThreadHandler) GetQuotesHTTP(c *gin.Context) {
+	threadID, ok := getUint32Param(c, "threadId")
+	if !ok { return }
+
+	requesterUserID, _ := getUserIDFromContext(c)
+	page, limit := parsePagination(c)
+
+	excludeUserIDs, err := h.getFeedExclusionIDs(c.Request.Context(), requesterUserID)
+	if err != nil {
+		log.Printf("GetQuotesHTTP: Error getting exclusion IDs: %v", err)
+		excludeUserIDs = []uint32{}
+	}
+
+	grpcReq := &threadpb.GetQuotesRequest{
+		ThreadId:        threadID,
+		RequesterUserId: &requesterUserID,
+		Page:            page,
+		Limit:           limit,
+		ExcludeUserIds:  excludeUserIDs,
+	}
+
+	threadServiceResp, err := h.threadClient.GetQuotes(c.Request.Context(), grpcReq)
+	if err != nil {
+		handleGRPCError(c, "get quotes", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, FrontendFeedResponse{
+		Threads: h.hydrateThreadList(c.Request.Context(), threadServiceResp.GetThreads()),
+		HasMore: threadServiceResp.GetHasMore(),
+	})
+}
+
+// --- Hashtags ---
+
+type FrontendRelatedHashtag struct {
+	Tag           string `json:"tag"`
+	CoOccurrences int64  `json:"co_occurrences"`
+}
+
+type FrontendHashtagThreadsResponse struct {
+	Hashtag         string                   `json:"hashtag"`
+	ThreadCount     *int64                   `json:"thread_count,omitempty"`     // first page only
+	RelatedHashtags []FrontendRelatedHashtag `json:"related_hashtags,omitempty"` // first page only
+	Threads         []FrontendThreadData     `json:"threads"`
+	HasMore         bool                     `json:"has_more"`
+	NextCursor      string                   `json:"next_cursor,omitempty"`
+}
+
+// GetHashtagThreadsHTTP lists a hashtag's threads (?sort=latest|top). The first page also carries
+// the tag's thread count and the hashtags most often used with it.
+func (h *ThreadHandler) GetHashtagThreadsHTTP(c *gin.Context) {
+	tag := strings.TrimPrefix(c.Param("tag"), "#")
+	requesterUserID, _ := getUserIDFromContext(c)
+	page, limit := parsePagination(c)
+	cursor := c.Query("cursor")
+
+	excludeUserIDs, err := h.getFeedExclusionIDs(c.Request.Context(), requesterUserID)
+	if err != nil {
+		log.Printf("GetHashtagThreadsHTTP: Error getting exclusion IDs: %v", err)
+		excludeUserIDs = []uint32{}
+	}
+
+	var stats *threadpb.GetHashtagStatsResponse
+	var statsWg sync.WaitGroup
+	if cursor == "" && page == 1 {
+		statsWg.Add(1)
 		go func() {
-			defer wg.Done()
-			resp, err := h.userClient.GetUserProfilesByIds(c.Request.Context(), &userpb.GetUserProfilesByIdsRequest{UserIds: authorIDs})
-			if err != nil { userErr = err; return }
-			authorsMap = resp.GetUsers()
+			defer statsWg.Done()
+			resp, errStats := h.threadClient.GetHashtagStats(c.Request.Context(), &threadpb.GetHashtagStatsRequest{Hashtag: tag})
+			if errStats != nil {
+				log.Printf("GetHashtagThreadsHTTP: Error getting stats for #%s: %v", tag, errStats)
+				return
+			}
+			stats = resp
 		}()
 	}
-	if len(mediaIDs) > 0 && h.mediaClient != nil {
+
+	grpcReq := &threadpb.GetThreadsByHashtagRequest{
+		Hashtag:         tag,
+		RequesterUserId: &requesterUserID,
+		SortType:        c.DefaultQuery("sort", "latest"),
+		Page:            page,
+		Limit:           limit,
+		ExcludeUserIds:  excludeUserIDs,
+		Cursor:          cursor,
+	}
+	threadServiceResp, err := h.threadClient.GetThreadsByHashtag(c.Request.Context(), grpcReq)
+	statsWg.Wait()
+	if err != nil {
+		handleGRPCError(c, "get hashtag threads", err)
+		return
+	}
+
+	resp := FrontendHashtagThreadsResponse{
+		Hashtag:    strings.ToLower(tag),
+		Threads:    h.hydrateThreadList(c.Request.Context(), threadServiceResp.GetThreads()),
+		HasMore:    threadServiceResp.GetHasMore(),
+		NextCursor: threadServiceResp.GetNextCursor(),
+	}
+	if stats != nil {
+		resp.Hashtag = stats.GetHashtag()
+		count := stats.GetThreadCount()
+		resp.ThreadCount = &count
+		for _, r := range stats.GetRelated() {
+			resp.RelatedHashtags = append(resp.RelatedHashtags, FrontendRelatedHashtag{Tag: r.GetTag(), CoOccurrences: r.GetCoOccurrences()})
+		}
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// --- Categories ---
+
+// GetCategoryThreadsHTTP lists a category's threads (?sort=latest|top, ?window_hours= for top).
+func (h *ThreadHandler) GetCategoryThreadsHTTP(c *gin.Context) {
+	requesterUserID, _ := getUserIDFromContext(c)
+	page, limit := parsePagination(c)
+	windowHours, _ := strconv.Atoi(c.DefaultQuery("window_hours", "0"))
+
+	excludeUserIDs, err := h.getFeedExclusionIDs(c.Request.Context(), requesterUserID)
+	if err != nil {
+		log.Printf("GetCategoryThreadsHTTP: Error getting exclusion IDs: %v", err)
+		excludeUserIDs = []uint32{}
+	}
+
+	grpcReq := &threadpb.GetThreadsByCategoryRequest{
+		Category:        c.Param("category"),
+		RequesterUserId: &requesterUserID,
+		SortType:        c.DefaultQuery("sort", "latest"),
+		Page:            page,
+		Limit:           limit,
+		ExcludeUserIds:  excludeUserIDs,
+		Cursor:          c.Query("cursor"),
+		WindowHours:     int32(windowHours),
+	}
+	threadServiceResp, err := h.threadClient.GetThreadsByCategory(c.Request.Context(), grpcReq)
+	if err != nil {
+		handleGRPCError(c, "get category threads", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, FrontendFeedResponse{
+		Threads:    h.hydrateThreadList(c.Request.Context(), threadServiceResp.GetThreads()),
+		HasMore:    threadServiceResp.GetHasMore(),
+		NextCursor: threadServiceResp.GetNextCursor(),
+	})
+}
+
+type FrontendCategoryExploreItem struct {
+	Category          string               `json:"category"`
+	PostCount         int64                `json:"post_count"`          // posts in the window
+	PreviousPostCount int64                `json:"previous_post_count"` // posts in the window before it
+	TopThreads        []FrontendThreadData `json:"top_threads"`
+}
+
+type FrontendCategoryExploreResponse struct {
+	WindowHours int32                         `json:"window_hours"`
+	Categories  []FrontendCategoryExploreItem `json:"categories"` // busiest first
+}
+
+const maxExploreThreadsPerCategory = 10
+
+// GetCategoryExploreHTTP returns every category's post volume over ?window_hours= (default 24)
+// together with its top ?per_category= threads from the same window.
+func (h *ThreadHandler) GetCategoryExploreHTTP(c *gin.Context) {
+	requesterUserID, _ := getUserIDFromContext(c)
+	windowHours, _ := strconv.Atoi(c.DefaultQuery("window_hours", "24"))
+	perCategory, _ := strconv.Atoi(c.DefaultQuery("per_category", "3"))
+	if perCategory <= 0 || perCategory > maxExploreThreadsPerCategory { perCategory = 3 }
+
+	ctx := c.Request.Context()
+	statsResp, err := h.threadClient.GetCategoryStats(ctx, &threadpb.GetCategoryStatsRequest{WindowHours: int32(windowHours)})
+	if err != nil {
+		handleGRPCError(c, "get category stats", err)
+		return
+	}
+
+	excludeUserIDs, err := h.getFeedExclusionIDs(ctx, requesterUserID)
+	if err != nil {
+		log.Printf("GetCategoryExploreHTTP: Error getting exclusion IDs: %v", err)
+		excludeUserIDs = []uint32{}
+	}
+
+	stats := statsResp.GetStats()
+	topThreads := make([][]*threadpb.Thread, len(stats))
+	var wg sync.WaitGroup
+	for i, stat := range stats {
 		wg.Add(1)
-		go func() {
+		go func(i int, category string) {
 			defer wg.Done()
-			resp, err := h.mediaClient.GetMultipleMediaMetadata(c.Request.Context(), &mediapb.GetMultipleMediaMetadataRequest{MediaIds: mediaIDs})
-			if err != nil { mediaErr = err; return }
-			mediaMap = resp.GetMediaItems()
-		}()
+			resp, errTop := h.threadClient.GetThreadsByCategory(ctx, &threadpb.GetThreadsByCategoryRequest{
+				Category:        category,
+				RequesterUserId: &requesterUserID,
+				SortType:        "top",
+				Limit:           int32(perCategory),
+				ExcludeUserIds:  excludeUserIDs,
+				WindowHours:     statsResp.GetWindowHours(),
+			})
+			if errTop != nil {
+				log.Printf("GetCategoryExploreHTTP: Error getting top threads for %s: %v", category, errTop)
+				return
+			}
+			topThreads[i] = resp.GetThreads()
+		}(i, stat.GetCategory())
 	}
 	wg.Wait()
-	if userErr != nil { log.Printf("Error fetching authors for replies: %v", userErr) }
-	if mediaErr != nil { log.Printf("Error fetching media for replies: %v", mediaErr) }
-	hydratedThreads := make([]FrontendThreadData, 0, len(threadServiceResp.GetThreads()))
-	for _, tProto := range threadServiceResp.GetThreads() {
-		feThread := mapProtoThreadToFrontend(tProto, authorsMap, mediaMap)
-		hydratedThreads = append(hydratedThreads, feThread)
+
+	// Hydrate all categories in one pass, then split the result back up
+	var allThreads []*threadpb.Thread
+	for _, threads := range topThreads { allThreads = append(allThreads, threads...) }
+	hydrated := h.hydrateThreadList(ctx, allThreads)
+
+	resp := FrontendCategoryExploreResponse{WindowHours: statsResp.GetWindowHours(), Categories: []FrontendCategoryExploreItem{}}
+	next := 0
+	for i, stat := range stats {
+		item := FrontendCategoryExploreItem{
+			Category:          stat.GetCategory(),
+			PostCount:         stat.GetPostCount(),
+			PreviousPostCount: stat.GetPreviousPostCount(),
+			TopThreads:        hydrated[next : next+len(topThreads[i])],
+		}
+		next += len(topThreads[i])
+		resp.Categories = append(resp.Categories, item)
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// --- Editing ---
+
+type EditThreadPayload struct {
+	Content  string   `json:"content"`
+	MediaIDs []uint32 `json:"media_ids,omitempty"`
+}
+
+type FrontendThreadRevision struct {
+	ID        uint32                  `json:"id"`
+	ThreadID  uint32                  `json:"thread_id"`
+	Content   string                  `json:"content"`
+	MediaIDs  []uint32                `json:"media_ids"`
+	Media     []FrontendMediaMetadata `json:"media,omitempty"`
+	CreatedAt string                  `json:"created_at"`
+	IsMasked  bool                    `json:"is_masked,omitempty"`
+}
+
+func (h *ThreadHandler) EditThreadHTTP(c *gin.Context) {
+	userID, ok := getUserIDFromContext(c)
+	if !ok { return }
+	threadID, ok := getUint32Param(c, "threadId")
+	if !ok { return }
+
+	var payload EditThreadPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data: " + err.Error()})
+		return
+	}
+	if payload.Content == "" && len(payload.MediaIDs) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Thread must contain content or media"})
+		return
+	}
+
+	grpcReq := &threadpb.EditThreadRequest{ThreadId: threadID, UserId: userID, Content: payload.Content, MediaIds: payload.MediaIDs}
+	threadProto, err := h.threadClient.EditThread(c.Request.Context(), grpcReq)
+	if err != nil {
+		handleGRPCError(c, "edit thread", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, h.hydrateThreadList(c.Request.Context(), []*threadpb.Thread{threadProto})[0])
+}
+
+func (h *ThreadHandler) GetThreadRevisionsHTTP(c *gin.Context) {
+	threadID, ok := getUint32Param(c, "threadId")
+	if !ok { return }
+	requesterUserID, _ := getUserIDFromContext(c)
+
+	grpcReq := &threadpb.GetThreadRevisionsRequest{ThreadId: threadID, RequesterUserId: &requesterUserID}
+	resp, err := h.threadClient.GetThreadRevisions(c.Request.Context(), grpcReq)
+	if err != nil {
+		handleGRPCError(c, "get thread revisions", err)
+		return
+	}
+
+	var mediaIDs []uint32
+	for _, rev := range resp.GetRevisions() { mediaIDs = append(mediaIDs, rev.GetMediaIds()...) }
+	var mediaMap map[uint32]*mediapb.Media
+	if len(mediaIDs) > 0 && h.mediaClient != nil {
+		mediaResp, err := h.mediaClient.GetMultipleMediaMetadata(c.Request.Context(), &mediapb.GetMultipleMediaMetadataRequest{MediaIds: mediaIDs})
+		if err != nil {
+			log.Printf("Error fetching media for thread revisions: %v", err)
+		} else {
+			mediaMap = mediaResp.GetMediaItems()
+		}
+	}
+
+	revisions := make([]FrontendThreadRevision, 0, len(resp.GetRevisions()))
+	for _, rev := range resp.GetRevisions() {
+		feRev := FrontendThreadRevision{
+			ID:        rev.GetId(),
+			ThreadID:  rev.GetThreadId(),
+			Content:   rev.GetContent(),
+			MediaIDs:  rev.GetMediaIds(),
+			CreatedAt: rev.GetCreatedAt().AsTime().Format(time.RFC3339),
+			IsMasked:  rev.GetIsMasked(),
+		}
+		for _, mediaID := range rev.GetMediaIds() {
+			if mediaProto, ok := mediaMap[mediaID]; ok && mediaProto != nil {
+				feRev.Media = append(feRev.Media, mapMediaToFrontend(mediaProto))
+			}
+		}
+		revisions = append(revisions, feRev)
+	}
+	c.JSON(http.StatusOK, gin.H{"revisions": revisions})
+}
+
+// --- Polls ---
+
+type VotePollPayload struct {
+	OptionID uint32 `json:"option_id" binding:"required"`
+}
+
+func (h *ThreadHandler) VotePollHTTP(c *gin.Context) {
+	userID, ok := getUserIDFromContext(c)
+	if !ok { return }
+	threadID, ok := getUint32Param(c, "threadId")
+	if !ok { return }
+
+	var payload VotePollPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data: " + err.Error()})
+		return
+	}
+
+	grpcReq := &threadpb.VotePollRequest{ThreadId: threadID, UserId: userID, OptionId: payload.OptionID}
+	pollProto, err := h.threadClient.VotePoll(c.Request.Context(), grpcReq)
+	if err != nil {
+		handleGRPCError(c, "vote in poll", err)
+		return
+	}
+	c.JSON(http.StatusOK, mapProtoPollToFrontend(pollProto))
+}
+
+func (h *ThreadHandler) GetPollResultsHTTP(c *gin.Context) {
+	threadID, ok := getUint32Param(c, "threadId")
+	if !ok { return }
+	requesterUserID, _ := getUserIDFromContext(c)
+
+	grpcReq := &threadpb.GetPollResultsRequest{ThreadId: threadID, RequesterUserId: &requesterUserID}
+	pollProto, err := h.threadClient.GetPollResults(c.Request.Context(), grpcReq)
+	if err != nil {
+		handleGRPCError(c, "get poll results", err)
+		return
+	}
+	c.JSON(http.StatusOK, mapProtoPollToFrontend(pollProto))
+}
+
+func mapProtoPollToFrontend(pProto *threadpb.Poll) *FrontendPoll {
+	fePoll := &FrontendPoll{
+		ID:             pProto.GetId(),
+		Options:        make([]FrontendPollOption, 0, len(pProto.GetOptions())),
+		ClosesAt:       pProto.GetClosesAt().AsTime().Format(time.RFC3339),
+		IsClosed:       pProto.GetIsClosed(),
+		ResultsVisible: pProto.GetResultsVisible(),
+		TotalVotes:     pProto.GetTotalVotes(),
+	}
+	if pProto.VotedOptionId != nil { val := pProto.GetVotedOptionId(); fePoll.VotedOptionID = &val }
+	for _, o := range pProto.GetOptions() {
+		fePoll.Options = append(fePoll.Options, FrontendPollOption{ID: o.GetId(), Text: o.GetText(), VoteCount: o.GetVoteCount()})
+	}
+	return fePoll
+}
+
+// --- Scheduled Threads ---
+
+type RescheduleThreadPayload struct {
+	ScheduledAt string `json:"scheduled_at" binding:"required"`
+}
+
+func (h *ThreadHandler) GetScheduledThreadsHTTP(c *gin.Context) {
+	userID, ok := getUserIDFromContext(c)
+	if !ok { return }
+	page, limit := parsePagination(c)
+
+	grpcReq := &threadpb.GetScheduledThreadsRequest{UserId: userID, Page: page, Limit: limit}
+	threadServiceResp, err := h.threadClient.GetScheduledThreads(c.Request.Context(), grpcReq)
+	if err != nil {
+		handleGRPCError(c, "get scheduled threads", err)
+		return
 	}
 
 	c.JSON(http.StatusOK, FrontendFeedResponse{
-		Threads: hydratedThreads,
+		Threads: h.hydrateThreadList(c.Request.Context(), threadServiceResp.GetThreads()),
 		HasMore: threadServiceResp.GetHasMore(),
 	})
 }
 
+func (h *ThreadHandler) RescheduleThreadHTTP(c *gin.Context) {
+	userID, ok := getUserIDFromContext(c)
+	if !ok { return }
+	threadID, ok := getUint32Param(c, "threadId")
+	if !ok { return }
+
+	var payload RescheduleThreadPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request data: " + err.Error()})
+		return
+	}
+	t, err := time.Parse(time.RFC3339, payload.ScheduledAt)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid scheduled_at format. Use ISO 8601 (RFC3339)."})
+		return
+	}
+
+	grpcReq := &threadpb.RescheduleThreadRequest{ThreadId: threadID, UserId: userID, ScheduledAt: timestamppb.New(t)}
+	threadProto, err := h.threadClient.RescheduleThread(c.Request.Context(), grpcReq)
+	if err != nil {
+		handleGRPCError(c, "reschedule thread", err)
+		return
+	}
+
+	hydrated := h.hydrateThreadList(c.Request.Context(), []*threadpb.Thread{threadProto})
+	c.JSON(http.StatusOK, hydrated[0])
+}
+
+func (h *ThreadHandler) CancelScheduledThreadHTTP(c *gin.Context) {
+	userID, ok := getUserIDFromContext(c)
+	if !ok { return }
+	threadID, ok := getUint32Param(c, "threadId")
+	if !ok { return }
+
+	grpcReq := &threadpb.CancelScheduledThreadRequest{ThreadId: threadID, UserId: userID}
+	if _, err := h.threadClient.CancelScheduledThread(c.Request.Context(), grpcReq); err != nil {
+		handleGRPCError(c, "cancel scheduled thread", err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Scheduled thread cancelled successfully"})
+}
+
 // --- Helper Functions ---
 // Helper to get combined list of users to exclude (blocked by me + blocking me)
 func (h *ThreadHandler) getFeedExclusionIDs(ctx context.Context, requesterID uint32) ([]uint32, error) {
@@ -845,6 +1293,70 @@ func (h *ThreadHandler) getFeedExclusionIDs(ctx context.Context, requesterID uin
 	return excludeIDs, nil
 }
 
+// hydrateThreadList attaches author profiles and media metadata to threads returned by the Thread Service.
+func (h *ThreadHandler) hydrateThreadList(ctx context.Context, threads []*threadpb.Thread) []FrontendThreadData {
+	hydratedThreads := make([]FrontendThreadData, 0, len(threads))
+	if len(threads) == 0 {
+		return hydratedThreads
+	}
+
+	authorIDs, mediaIDs := collectThreadHydrationIDs(threads)
+
+	var wg sync.WaitGroup
+	var authorsMap map[uint32]*userpb.User
+	var mediaMap map[uint32]*mediapb.Media
+	var userErr, mediaErr error
+
+	if len(authorIDs) > 0 && h.userClient != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := h.userClient.GetUserProfilesByIds(ctx, &userpb.GetUserProfilesByIdsRequest{UserIds: authorIDs})
+			if err != nil { userErr = err; return }
+			authorsMap = resp.GetUsers()
+		}()
+	}
+	if len(mediaIDs) > 0 && h.mediaClient != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := h.mediaClient.GetMultipleMediaMetadata(ctx, &mediapb.GetMultipleMediaMetadataRequest{MediaIds: mediaIDs})
+			if err != nil { mediaErr = err; return }
+			mediaMap = resp.GetMediaItems()
+		}()
+	}
+	wg.Wait()
+	if userErr != nil { log.Printf("Error fetching authors for thread list: %v", userErr) }
+	if mediaErr != nil { log.Printf("Error fetching media for thread list: %v", mediaErr) }
+
+	for _, tProto := range threads {
+		hydratedThreads = append(hydratedThreads, mapProtoThreadToFrontend(tProto, authorsMap, mediaMap))
+	}
+	return hydratedThreads
+}
+
+// collectThreadHydrationIDs returns the user and media IDs needed to render threads,
+// including reposters, mentioned users and the authors and media of quoted threads.
+func collectThreadHydrationIDs(threads []*threadpb.Thread) ([]uint32, []uint32) {
+	authorIDsSet := make(map[uint32]bool)
+	mediaIDsSet := make(map[uint32]bool)
+	addThread := func(t *threadpb.Thread) {
+		if t.GetUserId() != 0 { authorIDsSet[t.GetUserId()] = true }
+		for _, mentionedID := range t.GetMentionedUserIds() { authorIDsSet[mentionedID] = true }
+		for _, mediaID := range t.GetMediaIds() { if mediaID != 0 { mediaIDsSet[mediaID] = true } }
+	}
+	for _, t := range threads {
+		addThread(t)
+		if t.GetRepostedByUserId() != 0 { authorIDsSet[t.GetRepostedByUserId()] = true }
+		if t.GetQuotedThread() != nil { addThread(t.GetQuotedThread()) }
+	}
+	var authorIDs []uint32
+	for id := range authorIDsSet { authorIDs = append(authorIDs, id) }
+	var mediaIDs []uint32
+	for id := range mediaIDsSet { mediaIDs = append(mediaIDs, id) }
+	return authorIDs, mediaIDs
+}
+
 func getUserIDFromContext(c *gin.Context) (uint32, bool) {
 	userIDAny, exists := c.Get("userID")
 	if !exists {
@@ -909,17 +1421,48 @@ func mapProtoThreadToFrontend(tProto *threadpb.Thread, authorsMap map[uint32]*us
 		IsLikedByCurrentUser:        tProto.GetIsLikedByCurrentUser(),
 		IsBookmarkedByCurrentUser:   tProto.GetIsBookmarkedByCurrentUser(),
 		Categories: 				 tProto.GetCategories(),
+		CanReply:                    tProto.GetCanReply(),
+		QuoteCount:                  tProto.GetQuoteCount(),
+		IsRepostedByCurrentUser:     tProto.GetIsRepostedByCurrentUser(),
+		ParentDeleted:               tProto.GetParentDeleted(),
+		IsHidden:                    tProto.GetIsHidden(),
+		ContentWarning:              tProto.GetContentWarning(),
+		IsSensitive:                 tProto.GetIsSensitive(),
+		IsMasked:                    tProto.GetIsMasked(),
+		MentionedUsernames:          []string{},
 	}
 	if tProto.ParentThreadId != nil { val := tProto.GetParentThreadId(); feThread.ParentThreadID = &val }
+	if tProto.QuotedThreadId != nil { val := tProto.GetQuotedThreadId(); feThread.QuotedThreadID = &val }
+	if tProto.AdCampaignId != nil { val := tProto.GetAdCampaignId(); feThread.AdCampaignID = &val }
+	if tProto.GetQuotedThread() != nil {
+		quoted := mapProtoThreadToFrontend(tProto.GetQuotedThread(), authorsMap, mediaMap)
+		feThread.QuotedThread = &quoted
+	}
+	if tProto.GetEditedAt().IsValid() { val := tProto.GetEditedAt().AsTime().Format(time.RFC3339); feThread.EditedAt = &val }
+	if tProto.GetRepostedAt().IsValid() { val := tProto.GetRepostedAt().AsTime().Format(time.RFC3339); feThread.RepostedAt = &val }
 	if tProto.CommunityId != nil { val := tProto.GetCommunityId(); feThread.CommunityID = &val }
 	if tProto.GetScheduledAt().IsValid() { val := tProto.GetScheduledAt().AsTime().Format(time.RFC3339); feThread.ScheduledAt = &val }
+	if tProto.GetPoll() != nil { feThread.Poll = mapProtoPollToFrontend(tProto.GetPoll()) }
+	if preview := tProto.GetLinkPreview(); preview != nil {
+		feThread.LinkPreview = &FrontendLinkPreview{
+			URL:         preview.GetUrl(),
+			Title:       preview.GetTitle(),
+			Description: preview.GetDescription(),
+			ImageURL:    preview.GetImageUrl(),
+			SiteName:    preview.GetSiteName(),
+		}
+	}
 
 	if authorsMap != nil {
 		if authorProto, ok := authorsMap[tProto.GetUserId()]; ok && authorProto != nil {
-			feThread.Author = &FrontendUserProfile{
-				ID: authorProto.GetId(), Name: authorProto.GetName(), Username: authorProto.GetUsername(),
-				Email: authorProto.GetEmail(), ProfilePicture: authorProto.GetProfilePicture(), AccountPrivacy: authorProto.GetAccountPrivacy(),
-				IsVerified: authorProto.GetIsVerified(),
+			feThread.Author = mapUserToFrontendProfile(authorProto)
+		}
+		if reposterProto, ok := authorsMap[tProto.GetRepostedByUserId()]; ok && reposterProto != nil {
+			feThread.RepostedBy = mapUserToFrontendProfile(reposterProto)
+		}
+		for _, mentionedID := range tProto.GetMentionedUserIds() {
+			if mentionedProto, ok := authorsMap[mentionedID]; ok && mentionedProto != nil {
+				feThread.MentionedUsernames = append(feThread.MentionedUsernames, mentionedProto.GetUsername())
 			}
 		}
 	}
@@ -928,14 +1471,26 @@ func mapProtoThreadToFrontend(tProto *threadpb.Thread, authorsMap map[uint32]*us
 		feThread.Media = make([]FrontendMediaMetadata, 0)
 		for _, mediaID := range tProto.GetMediaIds() {
 			if mediaProto, ok := mediaMap[mediaID]; ok && mediaProto != nil {
-				feThread.Media = append(feThread.Media, FrontendMediaMetadata{
-					ID: mediaProto.GetId(), UploaderUserID: mediaProto.GetUploaderUserId(),
-					SupabasePath: mediaProto.GetSupabasePath(), BucketName: mediaProto.GetBucketName(),
-					MimeType: mediaProto.GetMimeType(), FileSize: mediaProto.GetFileSize(),
-					PublicURL: mediaProto.GetPublicUrl(), CreatedAt: mediaProto.GetCreatedAt().AsTime().Format(time.RFC3339),
-				})
+				feThread.Media = append(feThread.Media, mapMediaToFrontend(mediaProto))
 			}
 		}
 	}
 	return feThread
-}
\ No newline at end of file
+}
+
+func mapUserToFrontendProfile(u *userpb.User) *FrontendUserProfile {
+	return &FrontendUserProfile{
+		ID: u.GetId(), Name: u.GetName(), Username: u.GetUsername(),
+		Email: u.GetEmail(), ProfilePicture: u.GetProfilePicture(), AccountPrivacy: u.GetAccountPrivacy(),
+		IsVerified: u.GetIsVerified(),
+	}
+}
+
+func mapMediaToFrontend(m *mediapb.Media) FrontendMediaMetadata {
+	return FrontendMediaMetadata{
+		ID: m.GetId(), UploaderUserID: m.GetUploaderUserId(),
+		SupabasePath: m.GetSupabasePath(), BucketName: m.GetBucketName(),
+		MimeType: m.GetMimeType(), FileSize: m.GetFileSize(),
+		PublicURL: m.GetPublicUrl(), CreatedAt: m.GetCreatedAt().AsTime().Format(time.RFC3339),
+	}
+}
@@ -50,6 +50,7 @@ func SetupRouter(
 			"Content-Type",
 			"Accept",
 			"Authorization",
+			"X-Session-ID",
 		},
 		ExposeHeaders:    []string{"Content-Length"},
 		AllowCredentials: true,                     
@@ -104,6 +105,16 @@ func SetupRouter(
 
 		users.POST("/me/premium-application", profileHandler.ApplyForPremiumHTTP)
 
+		users.GET("/me/mentions", threadHandler.GetMentionsHTTP)
+
+		users.GET("/me/muted/accounts", profileHandler.GetMutedAccountsHTTP)
+		users.GET("/me/muted/words", profileHandler.GetMutedWordsHTTP)
+		users.POST("/me/muted/words", profileHandler.AddMutedWordHTTP)
+		users.DELETE("/me/muted/words/:wordId", profileHandler.RemoveMutedWordHTTP)
+
+		users.GET("/me/lists", profileHandler.GetMyListsHTTP)
+		users.GET("/me/lists/followed", profileHandler.GetMyFollowedListsHTTP)
+
 		users.GET("community-join-requests", communityHandler.GetUserJoinRequestsHTTP)
 	}
 
@@ -114,30 +125,60 @@ func SetupRouter(
 		userProfiles.GET("/:username/followers", profileHandler.GetFollowers)
 		userProfiles.GET("/:username/following", profileHandler.GetFollowing)
 		userProfiles.GET("/:username/threads", threadHandler.GetUserSpecificThreads)
+		userProfiles.GET("/:username/lists", profileHandler.GetUserListsHTTP)
 
 		// Actions requiring auth
 		userProfiles.POST("/:username/follow", authMiddleware, profileHandler.FollowUser)
 		userProfiles.DELETE("/:username/follow", authMiddleware, profileHandler.UnfollowUser)
 		userProfiles.POST("/:username/block", authMiddleware, profileHandler.BlockUser)
 		userProfiles.DELETE("/:username/block", authMiddleware, profileHandler.UnblockUser)
+		userProfiles.POST("/:username/mute", authMiddleware, profileHandler.MuteUser)
+		userProfiles.DELETE("/:username/mute", authMiddleware, profileHandler.UnmuteUser)
 	}
 
 	threads := v1.Group("/threads")
 	threads.Use(authMiddleware)
 	{
 		threads.POST("", threadHandler.CreateThread)
+		threads.POST("/chain", threadHandler.CreateThreadChain)
 		threads.GET("/feed", threadHandler.GetFeed)
 		threads.GET("/bookmarked", threadHandler.GetBookmarkedThreadsHTTP)
+		threads.GET("/scheduled", threadHandler.GetScheduledThreadsHTTP)
+		threads.GET("/deleted", threadHandler.ListDeletedThreadsHTTP)
+		threads.GET("/drafts", threadHandler.GetDraftsHTTP)
+		threads.POST("/drafts", threadHandler.CreateDraftHTTP)
+		threads.PUT("/drafts/:draftId", threadHandler.UpdateDraftHTTP)
+		threads.DELETE("/drafts/:draftId", threadHandler.DeleteDraftHTTP)
+		threads.POST("/drafts/:draftId/publish", threadHandler.PublishDraftHTTP)
 		threads.GET("/:threadId", threadHandler.GetThread)
 		threads.GET("/:threadId/replies", threadHandler.GetRepliesHTTP)
-
+		threads.GET("/:threadId/replies/hidden", threadHandler.GetHiddenRepliesHTTP)
+		threads.GET("/:threadId/moderation-log", threadHandler.GetModerationLogHTTP)
+		threads.GET("/:threadId/analytics", threadHandler.GetThreadAnalyticsHTTP)
+		threads.GET("/:threadId/quotes", threadHandler.GetQuotesHTTP)
+		threads.GET("/:threadId/revisions", threadHandler.GetThreadRevisionsHTTP)
+		threads.GET("/:threadId/conversation", threadHandler.GetConversationHTTP)
+		threads.GET("/:threadId/poll", threadHandler.GetPollResultsHTTP)
+
+		threads.PUT("/:threadId", threadHandler.EditThreadHTTP)
 		threads.DELETE("/:threadId", threadHandler.DeleteThread)
+		threads.POST("/:threadId/restore", threadHandler.RestoreThreadHTTP)
+		threads.PUT("/:threadId/schedule", threadHandler.RescheduleThreadHTTP)
+		threads.DELETE("/:threadId/schedule", threadHandler.CancelScheduledThreadHTTP)
+		threads.POST("/:threadId/poll/vote", threadHandler.VotePollHTTP)
+		threads.PUT("/:threadId/reply-restriction", threadHandler.UpdateReplyRestrictionHTTP)
+		threads.PUT("/:threadId/sensitivity", threadHandler.SetThreadSensitivityHTTP) // author or moderator
+		threads.POST("/:threadId/hide", threadHandler.HideReplyHTTP) // :threadId is the reply
+		threads.DELETE("/:threadId/hide", threadHandler.UnhideReplyHTTP)
+		threads.DELETE("/:threadId/mention", threadHandler.RemoveMentionHTTP)
+		threads.POST("/:threadId/profile-click", threadHandler.RecordProfileClickHTTP)
 
 		threads.POST("/:threadId/like", threadHandler.LikeThread)
 		threads.DELETE("/:threadId/like", threadHandler.UnlikeThread)
 		threads.POST("/:threadId/bookmark", threadHandler.BookmarkThread)
 		threads.DELETE("/:threadId/bookmark", threadHandler.UnbookmarkThread)
-		// Add repost routes later
+		threads.POST("/:threadId/repost", threadHandler.RepostThread)
+		threads.DELETE("/:threadId/repost", threadHandler.UnrepostThread)
 	}
 
 	media := v1.Group("/media")
@@ -161,6 +202,43 @@ func SetupRouter(
 		trending.GET("/hashtags", searchHandler.GetTrendingHashtagsHTTP)
 	}
 
+	hashtags := v1.Group("/hashtags")
+	hashtags.Use(attemptAuthMiddleware)
+	{
+		hashtags.GET("/:tag/threads", threadHandler.GetHashtagThreadsHTTP)
+	}
+
+	categories := v1.Group("/categories")
+	categories.Use(attemptAuthMiddleware)
+	{
+		categories.GET("/explore", threadHandler.GetCategoryExploreHTTP)
+		categories.GET("/:category/threads", threadHandler.GetCategoryThreadsHTTP)
+	}
+
+	lists := v1.Group("/lists")
+	lists.Use(attemptAuthMiddleware)
+	{
+		lists.GET("/:listId", profileHandler.GetListHTTP)
+		lists.GET("/:listId/members", profileHandler.GetListMembersHTTP)
+		lists.GET("/:listId/threads", threadHandler.GetListThreadsHTTP)
+
+		// Actions requiring auth
+		lists.POST("", authMiddleware, profileHandler.CreateListHTTP)
+		lists.PATCH("/:listId", authMiddleware, profileHandler.UpdateListHTTP)
+		lists.DELETE("/:listId", authMiddleware, profileHandler.DeleteListHTTP)
+		lists.POST("/:listId/members/:username", authMiddleware, profileHandler.AddListMemberHTTP)
+		lists.DELETE("/:listId/members/:username", authMiddleware, profileHandler.RemoveListMemberHTTP)
+		lists.POST("/:listId/follow", authMiddleware, profileHandler.FollowListHTTP)
+		lists.DELETE("/:listId/follow", authMiddleware, profileHandler.UnfollowListHTTP)
+	}
+
+	ads := v1.Group("/ads")
+	{
+		ads.POST("/campaigns", authMiddleware, threadHandler.CreatePromotedThreadHTTP)
+		ads.GET("/campaigns", authMiddleware, threadHandler.GetAdCampaignsHTTP)
+		ads.POST("/campaigns/:campaignId/click", attemptAuthMiddleware, threadHandler.RecordAdClickHTTP)
+	}
+
 	suggestions := v1.Group("/suggestions")
 	suggestions.Use(attemptAuthMiddleware)
 	{
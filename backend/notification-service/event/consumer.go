@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"html"
 	"log"
 	"os"
 
@@ -11,6 +12,7 @@ import (
 	notifUtils "github.com/Acad600-TPA/WEB-MJ-242/backend/notification-service/utils"
 	"github.com/Acad600-TPA/WEB-MJ-242/backend/notification-service/websocket"
 	userpb "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/mute"
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
@@ -28,6 +30,45 @@ type ThreadLikedEvent struct {
     LikedByUsername string `json:"liked_by_username"`
 }
 
+type ThreadRepostedEvent struct {
+	ThreadID           uint   `json:"thread_id"`
+	ThreadAuthorID     uint   `json:"thread_author_id"`
+	RepostedByUserID   uint   `json:"reposted_by_user_id"`
+	RepostedByUsername string `json:"reposted_by_username"`
+}
+
+type ThreadPollClosedEvent struct {
+	ThreadID       uint   `json:"thread_id"`
+	ThreadAuthorID uint   `json:"thread_author_id"`
+	PollID         uint   `json:"poll_id"`
+	TotalVotes     int64  `json:"total_votes"`
+	WinningOption  string `json:"winning_option"` // empty on no votes or a tie
+}
+
+type ThreadDeletedEvent struct {
+	ThreadID       uint `json:"thread_id"`
+	ThreadAuthorID uint `json:"thread_author_id"`
+}
+
+type ThreadRepliedEvent struct {
+	ThreadID             uint   `json:"thread_id"` // the reply
+	ParentThreadID       uint   `json:"parent_thread_id"`
+	ParentAuthorID       uint   `json:"parent_author_id"`
+	ReplyAuthorID        uint   `json:"reply_author_id"`
+	ReplyAuthorUsername  string `json:"reply_author_username"`
+	ParticipantIDs       []uint `json:"participant_ids"` // authors further up the reply chain
+	ThreadContentSnippet string `json:"thread_content_snippet"`
+}
+
+type ThreadQuotedEvent struct {
+	ThreadID             uint   `json:"thread_id"` // the quote
+	QuotedThreadID       uint   `json:"quoted_thread_id"`
+	QuotedAuthorID       uint   `json:"quoted_author_id"`
+	QuotingUserID        uint   `json:"quoting_user_id"`
+	QuotingUsername      string `json:"quoting_username"`
+	ThreadContentSnippet string `json:"thread_content_snippet"`
+}
+
 type NewFollowerEvent struct {
     FollowedUserID uint   `json:"followed_user_id"` // User who gained a follower
     FollowerUserID uint   `json:"follower_user_id"` // User who started following
@@ -52,8 +93,18 @@ const (
     ThreadEventsExchange = "thread_events"
     ThreadLikedQueue = "thread_liked_notif_queue"
     ThreadLikedRoutingKey = "thread.liked"
+    ThreadRepostedQueue = "thread_reposted_notif_queue"
+    ThreadRepostedRoutingKey = "thread.reposted"
     MentionQueue = "mention_notif_queue"
     MentionRoutingKey = "thread.mentioned"
+    ThreadPollClosedQueue = "thread_poll_closed_notif_queue"
+    ThreadPollClosedRoutingKey = "thread.poll_closed"
+    ThreadDeletedQueue = "thread_deleted_notif_queue"
+    ThreadDeletedRoutingKey = "thread.deleted"
+    ThreadRepliedQueue = "thread_replied_notif_queue"
+    ThreadRepliedRoutingKey = "thread.replied"
+    ThreadQuotedQueue = "thread_quoted_notif_queue"
+    ThreadQuotedRoutingKey = "thread.quoted"
 
     SocialEventsExchange = "social_events"
     NewFollowerQueue = "new_follower_notif_queue"
@@ -67,6 +118,7 @@ type Consumer struct {
 	repo         *postgres.NotificationRepository
 	userClient   userpb.UserServiceClient
 	webSocketHub *websocket.Hub
+	notifyReplyParticipants bool // also tell authors further up a reply chain, not just the parent's author
 }
 
 func NewConsumer(repo *postgres.NotificationRepository, uc userpb.UserServiceClient, wsHub *websocket.Hub) (*Consumer, error) {
@@ -94,9 +146,15 @@ func NewConsumer(repo *postgres.NotificationRepository, uc userpb.UserServiceCli
     declareAndBind(ch, ThreadLikedQueue, ThreadEventsExchange, ThreadLikedRoutingKey)
     declareAndBind(ch, NewFollowerQueue, SocialEventsExchange, NewFollowerRoutingKey)
     declareAndBind(ch, MentionQueue, ThreadEventsExchange, MentionRoutingKey)
+    declareAndBind(ch, ThreadRepostedQueue, ThreadEventsExchange, ThreadRepostedRoutingKey)
+    declareAndBind(ch, ThreadPollClosedQueue, ThreadEventsExchange, ThreadPollClosedRoutingKey)
+    declareAndBind(ch, ThreadDeletedQueue, ThreadEventsExchange, ThreadDeletedRoutingKey)
+    declareAndBind(ch, ThreadRepliedQueue, ThreadEventsExchange, ThreadRepliedRoutingKey)
+    declareAndBind(ch, ThreadQuotedQueue, ThreadEventsExchange, ThreadQuotedRoutingKey)
 
+	notifyReplyParticipants := os.Getenv("NOTIFY_REPLY_PARTICIPANTS") != "false"
 
-	return &Consumer{conn: conn, channel: ch, repo: repo, userClient: uc, webSocketHub: wsHub}, nil
+	return &Consumer{conn: conn, channel: ch, repo: repo, userClient: uc, webSocketHub: wsHub, notifyReplyParticipants: notifyReplyParticipants}, nil
 }
 
 func declareAndBind(ch *amqp.Channel, queueName, exchangeName, routingKey string) {
@@ -115,6 +173,11 @@ func (c *Consumer) StartConsuming() {
     go c.consume(ThreadLikedQueue, c.handleThreadLiked)
     go c.consume(NewFollowerQueue, c.handleNewFollower)
     go c.consume(MentionQueue, c.handleMention)
+    go c.consume(ThreadRepostedQueue, c.handleThreadReposted)
+    go c.consume(ThreadPollClosedQueue, c.handleThreadPollClosed)
+    go c.consume(ThreadDeletedQueue, c.handleThreadDeleted)
+    go c.consume(ThreadRepliedQueue, c.handleThreadReplied)
+    go c.consume(ThreadQuotedQueue, c.handleThreadQuoted)
 }
 
 func (c *Consumer) consume(queueName string, handlerFunc func(d amqp.Delivery)) {
@@ -166,6 +229,7 @@ func (c *Consumer) handleThreadLiked(d amqp.Delivery) {
 		event.ThreadID, event.LikedByUserID, event.LikedByUsername, event.ThreadAuthorID)
 
     if event.ThreadAuthorID == event.LikedByUserID { return } // Don't notify for own like
+    if c.isMuted(event.ThreadAuthorID, event.LikedByUserID, "") { return }
 
 	notificationMsg := fmt.Sprintf("@%s liked your thread.", event.LikedByUsername)
 	notif := &postgres.Notification{
@@ -188,6 +252,160 @@ func (c *Consumer) handleThreadLiked(d amqp.Delivery) {
 	go c.sendEmailForNotification(notif.UserID, "Someone liked your thread!", notificationMsg)
 }
 
+func (c *Consumer) handleThreadReposted(d amqp.Delivery) {
+	var event ThreadRepostedEvent
+	if err := json.Unmarshal(d.Body, &event); err != nil {
+		log.Printf("Error unmarshalling ThreadRepostedEvent: %v", err)
+		return
+	}
+	log.Printf("Handling ThreadRepostedEvent: ThreadID %d, RepostedBy %d (%s), Author %d",
+		event.ThreadID, event.RepostedByUserID, event.RepostedByUsername, event.ThreadAuthorID)
+
+	if event.ThreadAuthorID == event.RepostedByUserID { return } // Don't notify for own repost
+	if c.isMuted(event.ThreadAuthorID, event.RepostedByUserID, "") { return }
+
+	notificationMsg := fmt.Sprintf("@%s reposted your thread.", event.RepostedByUsername)
+	notif := &postgres.Notification{
+		UserID:   event.ThreadAuthorID,
+		Type:     "thread_repost",
+		Message:  notificationMsg,
+		EntityID: fmt.Sprintf("%d", event.ThreadID),
+		ActorID:  &event.RepostedByUserID,
+	}
+	if err := c.repo.CreateNotification(context.Background(), notif); err != nil {
+		log.Printf("Failed to save 'thread_repost' notification: %v", err)
+		return
+	}
+	log.Printf("Saved 'thread_repost' notification for user %d", notif.UserID)
+	c.webSocketHub.BroadcastToUser(notif.UserID, notif)
+	go c.sendEmailForNotification(notif.UserID, "Someone reposted your thread!", notificationMsg)
+}
+
+func (c *Consumer) handleThreadPollClosed(d amqp.Delivery) {
+	var event ThreadPollClosedEvent
+	if err := json.Unmarshal(d.Body, &event); err != nil {
+		log.Printf("Error unmarshalling ThreadPollClosedEvent: %v", err)
+		return
+	}
+	log.Printf("Handling ThreadPollClosedEvent: ThreadID %d, PollID %d, Author %d, Votes %d",
+		event.ThreadID, event.PollID, event.ThreadAuthorID, event.TotalVotes)
+
+	var notificationMsg string
+	switch {
+	case event.TotalVotes == 0:
+		notificationMsg = "Your poll has ended with no votes."
+	case event.WinningOption == "":
+		notificationMsg = fmt.Sprintf("Your poll has ended with %d votes. It's a tie!", event.TotalVotes)
+	default:
+		notificationMsg = fmt.Sprintf("Your poll has ended with %d votes. \"%s\" won.", event.TotalVotes, html.EscapeString(event.WinningOption)) // Frontend renders messages as HTML
+	}
+	notif := &postgres.Notification{
+		UserID:   event.ThreadAuthorID,
+		Type:     "poll_closed",
+		Message:  notificationMsg,
+		EntityID: fmt.Sprintf("%d", event.ThreadID),
+	}
+	if err := c.repo.CreateNotification(context.Background(), notif); err != nil {
+		log.Printf("Failed to save 'poll_closed' notification: %v", err)
+		return
+	}
+	log.Printf("Saved 'poll_closed' notification for user %d", notif.UserID)
+	c.webSocketHub.BroadcastToUser(notif.UserID, notif)
+	go c.sendEmailForNotification(notif.UserID, "Your poll has ended", notificationMsg)
+}
+
+func (c *Consumer) handleThreadReplied(d amqp.Delivery) {
+	var event ThreadRepliedEvent
+	if err := json.Unmarshal(d.Body, &event); err != nil {
+		log.Printf("Error unmarshalling ThreadRepliedEvent: %v", err)
+		return
+	}
+	log.Printf("Handling ThreadRepliedEvent: Reply %d to Thread %d by %d (%s), Parent author %d, Participants %v",
+		event.ThreadID, event.ParentThreadID, event.ReplyAuthorID, event.ReplyAuthorUsername, event.ParentAuthorID, event.ParticipantIDs)
+
+	snippet := html.EscapeString(truncate(event.ThreadContentSnippet, 50)) // Frontend renders messages as HTML
+	c.notifyReply(event, event.ParentAuthorID, fmt.Sprintf("@%s replied to your thread: \"%s\"", event.ReplyAuthorUsername, snippet))
+
+	if !c.notifyReplyParticipants { return }
+	notified := map[uint]bool{event.ReplyAuthorID: true, event.ParentAuthorID: true}
+	for _, participantID := range event.ParticipantIDs {
+		if notified[participantID] { continue }
+		notified[participantID] = true
+		c.notifyReply(event, participantID, fmt.Sprintf("@%s replied in a conversation you're part of: \"%s\"", event.ReplyAuthorUsername, snippet))
+	}
+}
+
+// notifyReply stores and pushes a "reply" notification, unless recipientID wrote the reply, muted the replier or
+// a phrase in the reply, or either of them blocked the other. EntityID is the reply so deleting it removes these.
+func (c *Consumer) notifyReply(event ThreadRepliedEvent, recipientID uint, notificationMsg string) {
+	if recipientID == 0 || recipientID == event.ReplyAuthorID { return } // No notification for replying to yourself
+	if c.isBlockedEitherWay(recipientID, event.ReplyAuthorID) { return }
+	if c.isMuted(recipientID, event.ReplyAuthorID, event.ThreadContentSnippet) { return }
+
+	notif := &postgres.Notification{
+		UserID:   recipientID,
+		Type:     "reply",
+		Message:  notificationMsg,
+		EntityID: fmt.Sprintf("%d", event.ThreadID),
+		ActorID:  &event.ReplyAuthorID,
+	}
+	if err := c.repo.CreateNotification(context.Background(), notif); err != nil {
+		log.Printf("Failed to save 'reply' notification: %v", err)
+		return
+	}
+	log.Printf("Saved 'reply' notification for user %d", notif.UserID)
+	c.webSocketHub.BroadcastToUser(notif.UserID, notif)
+	go c.sendEmailForNotification(notif.UserID, "Someone replied to you!", notificationMsg)
+}
+
+func (c *Consumer) handleThreadQuoted(d amqp.Delivery) {
+	var event ThreadQuotedEvent
+	if err := json.Unmarshal(d.Body, &event); err != nil {
+		log.Printf("Error unmarshalling ThreadQuotedEvent: %v", err)
+		return
+	}
+	log.Printf("Handling ThreadQuotedEvent: Quote %d of Thread %d by %d (%s), Author %d",
+		event.ThreadID, event.QuotedThreadID, event.QuotingUserID, event.QuotingUsername, event.QuotedAuthorID)
+
+	if event.QuotedAuthorID == event.QuotingUserID { return } // Don't notify for quoting yourself
+	if c.isBlockedEitherWay(event.QuotedAuthorID, event.QuotingUserID) { return }
+	if c.isMuted(event.QuotedAuthorID, event.QuotingUserID, event.ThreadContentSnippet) { return }
+
+	notificationMsg := fmt.Sprintf("@%s quoted your thread: \"%s\"", event.QuotingUsername, html.EscapeString(truncate(event.ThreadContentSnippet, 50)))
+	notif := &postgres.Notification{
+		UserID:   event.QuotedAuthorID,
+		Type:     "quote",
+		Message:  notificationMsg,
+		EntityID: fmt.Sprintf("%d", event.ThreadID), // the quote, so deleting it removes the notification
+		ActorID:  &event.QuotingUserID,
+	}
+	if err := c.repo.CreateNotification(context.Background(), notif); err != nil {
+		log.Printf("Failed to save 'quote' notification: %v", err)
+		return
+	}
+	log.Printf("Saved 'quote' notification for user %d", notif.UserID)
+	c.webSocketHub.BroadcastToUser(notif.UserID, notif)
+	go c.sendEmailForNotification(notif.UserID, "Someone quoted your thread!", notificationMsg)
+}
+
+// threadNotificationTypes are the notification types whose EntityID is a thread ID.
+var threadNotificationTypes = []string{"thread_like", "thread_repost", "mention", "reply", "quote", "poll_closed"}
+
+func (c *Consumer) handleThreadDeleted(d amqp.Delivery) {
+	var event ThreadDeletedEvent
+	if err := json.Unmarshal(d.Body, &event); err != nil {
+		log.Printf("Error unmarshalling ThreadDeletedEvent: %v", err)
+		return
+	}
+	// Deleting is idempotent, so redelivered events are harmless
+	removed, err := c.repo.DeleteNotificationsForEntity(context.Background(), threadNotificationTypes, fmt.Sprintf("%d", event.ThreadID))
+	if err != nil {
+		log.Printf("Failed to remove notifications for deleted thread %d: %v", event.ThreadID, err)
+		return
+	}
+	log.Printf("Removed %d notifications for deleted thread %d", removed, event.ThreadID)
+}
+
 func (c *Consumer) handleNewFollower(d amqp.Delivery) {
     var event NewFollowerEvent
 	if err := json.Unmarshal(d.Body, &event); err != nil {
@@ -196,6 +414,8 @@ func (c *Consumer) handleNewFollower(d amqp.Delivery) {
 	}
     log.Printf("Handling NewFollowerEvent: Followed %d, Follower %d (%s)", event.FollowedUserID, event.FollowerUserID, event.FollowerUsername)
 
+    if c.isMuted(event.FollowedUserID, event.FollowerUserID, "") { return }
+
     notificationMsg := fmt.Sprintf("@%s started following you.", event.FollowerUsername)
     notif := &postgres.Notification{
         UserID: event.FollowedUserID, Type: "new_follower", Message: notificationMsg,
@@ -220,6 +440,7 @@ func (c *Consumer) handleMention(d amqp.Delivery) {
         event.ThreadID, event.MentionedUserID, event.MentioningUserID, event.MentioningUsername)
 
     if event.MentionedUserID == event.MentioningUserID { return } // No self-mention notification
+    if c.isMuted(event.MentionedUserID, event.MentioningUserID, event.ThreadContentSnippet) { return }
 
     notificationMsg := fmt.Sprintf("@%s mentioned you in a thread: \"%s\"", event.MentioningUsername, truncate(event.ThreadContentSnippet, 50))
     notif := &postgres.Notification{
@@ -236,6 +457,46 @@ func (c *Consumer) handleMention(d amqp.Delivery) {
 }
 
 
+// isMuted reports whether recipientID muted actorID, or muted a phrase in text for notifications.
+// Muted notifications are dropped rather than stored, so they never reach any client.
+func (c *Consumer) isMuted(recipientID, actorID uint, text string) bool {
+    if c.userClient == nil { return false }
+    filter, err := c.userClient.GetMuteFilter(context.Background(), &userpb.GetMuteFilterRequest{UserId: uint32(recipientID), Scope: mute.ScopeNotifications})
+    if err != nil {
+        log.Printf("Failed to get mutes of user %d, notifying anyway: %v", recipientID, err)
+        return false
+    }
+    for _, id := range filter.GetMutedUserIds() {
+        if uint(id) == actorID {
+            log.Printf("Skipping notification for user %d: actor %d is muted", recipientID, actorID)
+            return true
+        }
+    }
+    if mute.NewFilter(filter.GetPhrases()).Matches(text) {
+        log.Printf("Skipping notification for user %d: contains a muted phrase", recipientID)
+        return true
+    }
+    return false
+}
+
+// isBlockedEitherWay reports whether recipientID and actorID have blocked one another in either direction.
+// Errors count as blocked, so a failed check never notifies someone about a user they blocked.
+func (c *Consumer) isBlockedEitherWay(recipientID, actorID uint) bool {
+    if c.userClient == nil { return false }
+    for _, pair := range [][2]uint{{recipientID, actorID}, {actorID, recipientID}} {
+        resp, err := c.userClient.HasBlocked(context.Background(), &userpb.BlockCheckRequest{ActorId: uint32(pair[0]), SubjectId: uint32(pair[1])})
+        if err != nil {
+            log.Printf("Failed to check whether user %d blocked %d, skipping notification: %v", pair[0], pair[1], err)
+            return true
+        }
+        if resp.GetIsTrue() {
+            log.Printf("Skipping notification for user %d: block between %d and %d", recipientID, pair[0], pair[1])
+            return true
+        }
+    }
+    return false
+}
+
 func (c *Consumer) sendEmailForNotification(userID uint, subject, body string) {
     if c.userClient == nil { log.Println("Cannot send email: userClient not configured in consumer"); return }
 
@@ -14,7 +14,7 @@ import (
 type Notification struct {
 	ID        uint      `gorm:"primaryKey"`
 	UserID    uint      `gorm:"not null;index"`
-	Type      string    `gorm:"type:varchar(50);not null"` // "new_follower", "like", "mention", "reply"
+	Type      string    `gorm:"type:varchar(50);not null"` // "new_follower", "thread_like", "thread_repost", "mention", "reply", "quote", "poll_closed"
 	Message   string    `gorm:"type:text;not null"`
 	IsRead    bool      `gorm:"default:false;not null"`
 	EntityID  string    `gorm:"type:varchar(100);index"` // ID of the related entity: thread ID, user ID of follower
@@ -69,6 +69,13 @@ func (r *NotificationRepository) GetUnreadNotificationCount(ctx context.Context,
 }
 
 
+// DeleteNotificationsForEntity removes notifications of the given types that point at entityID.
+func (r *NotificationRepository) DeleteNotificationsForEntity(ctx context.Context, types []string, entityID string) (int64, error) {
+    res := r.db.WithContext(ctx).Where("type IN ? AND entity_id = ?", types, entityID).Delete(&Notification{})
+    return res.RowsAffected, res.Error
+}
+
+
 func (r *NotificationRepository) CheckHealth(ctx context.Context) error {
 	sqlDB, _ := r.db.DB(); return sqlDB.PingContext(ctx)
 }
\ No newline at end of file
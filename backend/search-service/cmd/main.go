@@ -6,6 +6,7 @@ import (
 	"net"
 	"os"
 
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/search-service/event"
 	searchpb "github.com/Acad600-TPA/WEB-MJ-242/backend/search-service/genproto/proto"
 	searchhandler "github.com/Acad600-TPA/WEB-MJ-242/backend/search-service/handler/grpc"
 	"github.com/Acad600-TPA/WEB-MJ-242/backend/search-service/repository"
@@ -21,6 +22,15 @@ func main() {
 	repo, err := repository.NewSearchRepository()
 	if err != nil { log.Fatalf("failed to initialize search repository: %v", err) }
 
+	// Keeps trending hashtags in step with thread deletions; search still works without it
+	consumer, err := event.NewConsumer(repo)
+	if err != nil {
+		log.Printf("Warning: thread event consumer disabled: %v", err)
+	} else {
+		defer consumer.Close()
+		go consumer.StartConsuming()
+	}
+
 	port := os.Getenv("PORT")
 	if port == "" { port = "50054" }
 	lis, err := net.Listen("tcp", ":"+port)
@@ -0,0 +1,114 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/search-service/repository"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+type ThreadDeletedEvent struct {
+	ThreadID     uint      `json:"thread_id"`
+	WasPublished bool      `json:"was_published"`
+	Hashtags     []string  `json:"hashtags"`
+	DeletedAt    time.Time `json:"deleted_at"`
+}
+
+const (
+	ThreadEventsExchange    = "thread_events"
+	ThreadDeletedQueue      = "thread_deleted_search_queue"
+	ThreadDeletedRoutingKey = "thread.deleted"
+)
+
+type Consumer struct {
+	conn    *amqp.Connection
+	channel *amqp.Channel
+	repo    *repository.SearchRepository
+}
+
+func NewConsumer(repo *repository.SearchRepository) (*Consumer, error) {
+	amqpURL := os.Getenv("RABBITMQ_URL")
+	if amqpURL == "" {
+		return nil, errors.New("RABBITMQ_URL not set")
+	}
+	conn, err := amqp.Dial(amqpURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to RabbitMQ: %w", err)
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to open RabbitMQ channel: %w", err)
+	}
+
+	if err := ch.ExchangeDeclare(ThreadEventsExchange, "topic", true, false, false, false, nil); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to declare exchange %s: %w", ThreadEventsExchange, err)
+	}
+	if _, err := ch.QueueDeclare(ThreadDeletedQueue, true, false, false, false, nil); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to declare queue %s: %w", ThreadDeletedQueue, err)
+	}
+	if err := ch.QueueBind(ThreadDeletedQueue, ThreadDeletedRoutingKey, ThreadEventsExchange, false, nil); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to bind queue %s: %w", ThreadDeletedQueue, err)
+	}
+
+	return &Consumer{conn: conn, channel: ch, repo: repo}, nil
+}
+
+// StartConsuming blocks, handling thread.deleted events until the channel closes.
+func (c *Consumer) StartConsuming() {
+	msgs, err := c.channel.Consume(ThreadDeletedQueue, "", false, false, false, false, nil)
+	if err != nil {
+		log.Printf("Failed to register a consumer for queue %s: %v", ThreadDeletedQueue, err)
+		return
+	}
+	log.Printf(" [*] Waiting for messages on %s", ThreadDeletedQueue)
+	for d := range msgs {
+		c.handleThreadDeleted(d)
+		if err := d.Ack(false); err != nil {
+			log.Printf("Error acknowledging message from %s: %v", ThreadDeletedQueue, err)
+		}
+	}
+}
+
+func (c *Consumer) handleThreadDeleted(d amqp.Delivery) {
+	var event ThreadDeletedEvent
+	if err := json.Unmarshal(d.Body, &event); err != nil {
+		log.Printf("Error unmarshalling ThreadDeletedEvent: %v", err)
+		return
+	}
+	if !event.WasPublished || len(event.Hashtags) == 0 {
+		return
+	}
+
+	// Redelivered events must not decrement twice
+	firstTime, err := c.repo.MarkThreadDeletionHandled(context.Background(), event.ThreadID, event.DeletedAt)
+	if err != nil {
+		log.Printf("Error recording deletion of thread %d: %v", event.ThreadID, err)
+		return
+	}
+	if !firstTime {
+		log.Printf("Deletion of thread %d already handled, skipping", event.ThreadID)
+		return
+	}
+	c.repo.DecrementHashtagCounts(context.Background(), event.Hashtags)
+	log.Printf("Removed hashtags %v of deleted thread %d from trending", event.Hashtags, event.ThreadID)
+}
+
+func (c *Consumer) Close() {
+	if c.channel != nil {
+		c.channel.Close()
+	}
+	if c.conn != nil {
+		c.conn.Close()
+	}
+}
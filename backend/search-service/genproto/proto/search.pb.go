@@ -562,6 +562,95 @@ func (x *IncrementHashtagCountsResponse) GetSuccess() bool {
 	return false
 }
 
+// Used when hashtags are removed from a thread (edit or delete)
+type DecrementHashtagCountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hashtags      []string               `protobuf:"bytes,1,rep,name=hashtags,proto3" json:"hashtags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecrementHashtagCountsRequest) Reset() {
+	*x = DecrementHashtagCountsRequest{}
+	mi := &file_proto_search_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecrementHashtagCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecrementHashtagCountsRequest) ProtoMessage() {}
+
+func (x *DecrementHashtagCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_search_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecrementHashtagCountsRequest.ProtoReflect.Descriptor instead.
+func (*DecrementHashtagCountsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_search_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DecrementHashtagCountsRequest) GetHashtags() []string {
+	if x != nil {
+		return x.Hashtags
+	}
+	return nil
+}
+
+type DecrementHashtagCountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecrementHashtagCountsResponse) Reset() {
+	*x = DecrementHashtagCountsResponse{}
+	mi := &file_proto_search_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecrementHashtagCountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecrementHashtagCountsResponse) ProtoMessage() {}
+
+func (x *DecrementHashtagCountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_search_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecrementHashtagCountsResponse.ProtoReflect.Descriptor instead.
+func (*DecrementHashtagCountsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_search_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DecrementHashtagCountsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
 type GetTopUsersToFollowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -572,7 +661,7 @@ type GetTopUsersToFollowRequest struct {
 
 func (x *GetTopUsersToFollowRequest) Reset() {
 	*x = GetTopUsersToFollowRequest{}
-	mi := &file_proto_search_proto_msgTypes[11]
+	mi := &file_proto_search_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -584,7 +673,7 @@ func (x *GetTopUsersToFollowRequest) String() string {
 func (*GetTopUsersToFollowRequest) ProtoMessage() {}
 
 func (x *GetTopUsersToFollowRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_search_proto_msgTypes[11]
+	mi := &file_proto_search_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,7 +686,7 @@ func (x *GetTopUsersToFollowRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTopUsersToFollowRequest.ProtoReflect.Descriptor instead.
 func (*GetTopUsersToFollowRequest) Descriptor() ([]byte, []int) {
-	return file_proto_search_proto_rawDescGZIP(), []int{11}
+	return file_proto_search_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetTopUsersToFollowRequest) GetLimit() int32 {
@@ -647,17 +736,22 @@ const file_proto_search_proto_rawDesc = "" +
 	"\x1dIncrementHashtagCountsRequest\x12\x1a\n" +
 	"\bhashtags\x18\x01 \x03(\tR\bhashtags\":\n" +
 	"\x1eIncrementHashtagCountsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\";\n" +
+	"\x1dDecrementHashtagCountsRequest\x12\x1a\n" +
+	"\bhashtags\x18\x01 \x03(\tR\bhashtags\":\n" +
+	"\x1eDecrementHashtagCountsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"s\n" +
 	"\x1aGetTopUsersToFollowRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12+\n" +
 	"\x0fexclude_user_id\x18\x02 \x01(\rH\x00R\rexcludeUserId\x88\x01\x01B\x12\n" +
-	"\x10_exclude_user_id2\xff\x03\n" +
+	"\x10_exclude_user_id2\xe8\x04\n" +
 	"\rSearchService\x12=\n" +
 	"\vHealthCheck\x12\x16.google.protobuf.Empty\x1a\x16.search.HealthResponse\x12C\n" +
 	"\vSearchUsers\x12\x15.search.SearchRequest\x1a\x1d.search.SearchUserIDsResponse\x12G\n" +
 	"\rSearchThreads\x12\x15.search.SearchRequest\x1a\x1f.search.SearchThreadIDsResponse\x12^\n" +
 	"\x13GetTrendingHashtags\x12\".search.GetTrendingHashtagsRequest\x1a#.search.GetTrendingHashtagsResponse\x12g\n" +
-	"\x16IncrementHashtagCounts\x12%.search.IncrementHashtagCountsRequest\x1a&.search.IncrementHashtagCountsResponse\x12X\n" +
+	"\x16IncrementHashtagCounts\x12%.search.IncrementHashtagCountsRequest\x1a&.search.IncrementHashtagCountsResponse\x12g\n" +
+	"\x16DecrementHashtagCounts\x12%.search.DecrementHashtagCountsRequest\x1a&.search.DecrementHashtagCountsResponse\x12X\n" +
 	"\x13GetTopUsersToFollow\x12\".search.GetTopUsersToFollowRequest\x1a\x1d.search.SearchUserIDsResponseBSZQgithub.com/Acad600-TPA/WEB-MJ-242/backend/search-service/genproto/search;searchpbb\x06proto3"
 
 var (
@@ -672,7 +766,7 @@ func file_proto_search_proto_rawDescGZIP() []byte {
 	return file_proto_search_proto_rawDescData
 }
 
-var file_proto_search_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_search_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_proto_search_proto_goTypes = []any{
 	(*HealthResponse)(nil),                 // 0: search.HealthResponse
 	(*SearchRequest)(nil),                  // 1: search.SearchRequest
@@ -685,27 +779,31 @@ var file_proto_search_proto_goTypes = []any{
 	(*GetTrendingHashtagsResponse)(nil),    // 8: search.GetTrendingHashtagsResponse
 	(*IncrementHashtagCountsRequest)(nil),  // 9: search.IncrementHashtagCountsRequest
 	(*IncrementHashtagCountsResponse)(nil), // 10: search.IncrementHashtagCountsResponse
-	(*GetTopUsersToFollowRequest)(nil),     // 11: search.GetTopUsersToFollowRequest
-	(*emptypb.Empty)(nil),                  // 12: google.protobuf.Empty
+	(*DecrementHashtagCountsRequest)(nil),  // 11: search.DecrementHashtagCountsRequest
+	(*DecrementHashtagCountsResponse)(nil), // 12: search.DecrementHashtagCountsResponse
+	(*GetTopUsersToFollowRequest)(nil),     // 13: search.GetTopUsersToFollowRequest
+	(*emptypb.Empty)(nil),                  // 14: google.protobuf.Empty
 }
 var file_proto_search_proto_depIdxs = []int32{
 	2,  // 0: search.SearchUserIDsResponse.user_results:type_name -> search.UserIDResult
 	3,  // 1: search.SearchThreadIDsResponse.thread_results:type_name -> search.ThreadIDResult
 	6,  // 2: search.GetTrendingHashtagsResponse.trending_hashtags:type_name -> search.TrendingHashtag
-	12, // 3: search.SearchService.HealthCheck:input_type -> google.protobuf.Empty
+	14, // 3: search.SearchService.HealthCheck:input_type -> google.protobuf.Empty
 	1,  // 4: search.SearchService.SearchUsers:input_type -> search.SearchRequest
 	1,  // 5: search.SearchService.SearchThreads:input_type -> search.SearchRequest
 	7,  // 6: search.SearchService.GetTrendingHashtags:input_type -> search.GetTrendingHashtagsRequest
 	9,  // 7: search.SearchService.IncrementHashtagCounts:input_type -> search.IncrementHashtagCountsRequest
-	11, // 8: search.SearchService.GetTopUsersToFollow:input_type -> search.GetTopUsersToFollowRequest
-	0,  // 9: search.SearchService.HealthCheck:output_type -> search.HealthResponse
-	4,  // 10: search.SearchService.SearchUsers:output_type -> search.SearchUserIDsResponse
-	5,  // 11: search.SearchService.SearchThreads:output_type -> search.SearchThreadIDsResponse
-	8,  // 12: search.SearchService.GetTrendingHashtags:output_type -> search.GetTrendingHashtagsResponse
-	10, // 13: search.SearchService.IncrementHashtagCounts:output_type -> search.IncrementHashtagCountsResponse
-	4,  // 14: search.SearchService.GetTopUsersToFollow:output_type -> search.SearchUserIDsResponse
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
+	11, // 8: search.SearchService.DecrementHashtagCounts:input_type -> search.DecrementHashtagCountsRequest
+	13, // 9: search.SearchService.GetTopUsersToFollow:input_type -> search.GetTopUsersToFollowRequest
+	0,  // 10: search.SearchService.HealthCheck:output_type -> search.HealthResponse
+	4,  // 11: search.SearchService.SearchUsers:output_type -> search.SearchUserIDsResponse
+	5,  // 12: search.SearchService.SearchThreads:output_type -> search.SearchThreadIDsResponse
+	8,  // 13: search.SearchService.GetTrendingHashtags:output_type -> search.GetTrendingHashtagsResponse
+	10, // 14: search.SearchService.IncrementHashtagCounts:output_type -> search.IncrementHashtagCountsResponse
+	12, // 15: search.SearchService.DecrementHashtagCounts:output_type -> search.DecrementHashtagCountsResponse
+	4,  // 16: search.SearchService.GetTopUsersToFollow:output_type -> search.SearchUserIDsResponse
+	10, // [10:17] is the sub-list for method output_type
+	3,  // [3:10] is the sub-list for method input_type
 	3,  // [3:3] is the sub-list for extension type_name
 	3,  // [3:3] is the sub-list for extension extendee
 	0,  // [0:3] is the sub-list for field type_name
@@ -716,14 +814,14 @@ func file_proto_search_proto_init() {
 	if File_proto_search_proto != nil {
 		return
 	}
-	file_proto_search_proto_msgTypes[11].OneofWrappers = []any{}
+	file_proto_search_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_search_proto_rawDesc), len(file_proto_search_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
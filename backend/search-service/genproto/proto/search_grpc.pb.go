@@ -25,6 +25,7 @@ const (
 	SearchService_SearchThreads_FullMethodName          = "/search.SearchService/SearchThreads"
 	SearchService_GetTrendingHashtags_FullMethodName    = "/search.SearchService/GetTrendingHashtags"
 	SearchService_IncrementHashtagCounts_FullMethodName = "/search.SearchService/IncrementHashtagCounts"
+	SearchService_DecrementHashtagCounts_FullMethodName = "/search.SearchService/DecrementHashtagCounts"
 	SearchService_GetTopUsersToFollow_FullMethodName    = "/search.SearchService/GetTopUsersToFollow"
 )
 
@@ -37,6 +38,7 @@ type SearchServiceClient interface {
 	SearchThreads(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchThreadIDsResponse, error)
 	GetTrendingHashtags(ctx context.Context, in *GetTrendingHashtagsRequest, opts ...grpc.CallOption) (*GetTrendingHashtagsResponse, error)
 	IncrementHashtagCounts(ctx context.Context, in *IncrementHashtagCountsRequest, opts ...grpc.CallOption) (*IncrementHashtagCountsResponse, error)
+	DecrementHashtagCounts(ctx context.Context, in *DecrementHashtagCountsRequest, opts ...grpc.CallOption) (*DecrementHashtagCountsResponse, error)
 	GetTopUsersToFollow(ctx context.Context, in *GetTopUsersToFollowRequest, opts ...grpc.CallOption) (*SearchUserIDsResponse, error)
 }
 
@@ -98,6 +100,16 @@ func (c *searchServiceClient) IncrementHashtagCounts(ctx context.Context, in *In
 	return out, nil
 }
 
+func (c *searchServiceClient) DecrementHashtagCounts(ctx context.Context, in *DecrementHashtagCountsRequest, opts ...grpc.CallOption) (*DecrementHashtagCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecrementHashtagCountsResponse)
+	err := c.cc.Invoke(ctx, SearchService_DecrementHashtagCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *searchServiceClient) GetTopUsersToFollow(ctx context.Context, in *GetTopUsersToFollowRequest, opts ...grpc.CallOption) (*SearchUserIDsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SearchUserIDsResponse)
@@ -117,6 +129,7 @@ type SearchServiceServer interface {
 	SearchThreads(context.Context, *SearchRequest) (*SearchThreadIDsResponse, error)
 	GetTrendingHashtags(context.Context, *GetTrendingHashtagsRequest) (*GetTrendingHashtagsResponse, error)
 	IncrementHashtagCounts(context.Context, *IncrementHashtagCountsRequest) (*IncrementHashtagCountsResponse, error)
+	DecrementHashtagCounts(context.Context, *DecrementHashtagCountsRequest) (*DecrementHashtagCountsResponse, error)
 	GetTopUsersToFollow(context.Context, *GetTopUsersToFollowRequest) (*SearchUserIDsResponse, error)
 	mustEmbedUnimplementedSearchServiceServer()
 }
@@ -143,6 +156,9 @@ func (UnimplementedSearchServiceServer) GetTrendingHashtags(context.Context, *Ge
 func (UnimplementedSearchServiceServer) IncrementHashtagCounts(context.Context, *IncrementHashtagCountsRequest) (*IncrementHashtagCountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IncrementHashtagCounts not implemented")
 }
+func (UnimplementedSearchServiceServer) DecrementHashtagCounts(context.Context, *DecrementHashtagCountsRequest) (*DecrementHashtagCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecrementHashtagCounts not implemented")
+}
 func (UnimplementedSearchServiceServer) GetTopUsersToFollow(context.Context, *GetTopUsersToFollowRequest) (*SearchUserIDsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTopUsersToFollow not implemented")
 }
@@ -257,6 +273,24 @@ func _SearchService_IncrementHashtagCounts_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SearchService_DecrementHashtagCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecrementHashtagCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).DecrementHashtagCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_DecrementHashtagCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).DecrementHashtagCounts(ctx, req.(*DecrementHashtagCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SearchService_GetTopUsersToFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTopUsersToFollowRequest)
 	if err := dec(in); err != nil {
@@ -302,6 +336,10 @@ var SearchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IncrementHashtagCounts",
 			Handler:    _SearchService_IncrementHashtagCounts_Handler,
 		},
+		{
+			MethodName: "DecrementHashtagCounts",
+			Handler:    _SearchService_DecrementHashtagCounts_Handler,
+		},
 		{
 			MethodName: "GetTopUsersToFollow",
 			Handler:    _SearchService_GetTopUsersToFollow_Handler,
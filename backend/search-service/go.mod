@@ -5,6 +5,7 @@ go 1.23.3
 require (
 	github.com/go-redis/redis/v8 v8.11.5
 	github.com/joho/godotenv v1.5.1
+	github.com/rabbitmq/amqp091-go v1.10.0
 	github.com/stretchr/testify v1.8.1
 	google.golang.org/grpc v1.72.2
 	google.golang.org/protobuf v1.36.6
@@ -220,6 +220,14 @@ func (h *SearchHandler) IncrementHashtagCounts(ctx context.Context, req *searchp
 	return &searchpb.IncrementHashtagCountsResponse{Success: true}, nil
 }
 
+func (h *SearchHandler) DecrementHashtagCounts(ctx context.Context, req *searchpb.DecrementHashtagCountsRequest) (*searchpb.DecrementHashtagCountsResponse, error) {
+	if len(req.Hashtags) == 0 {
+		return &searchpb.DecrementHashtagCountsResponse{Success: false}, nil
+	}
+	h.repo.DecrementHashtagCounts(ctx, req.Hashtags)
+	return &searchpb.DecrementHashtagCountsResponse{Success: true}, nil
+}
+
 func (h *SearchHandler) GetTopUsersToFollow(ctx context.Context, req *searchpb.GetTopUsersToFollowRequest) (*searchpb.SearchUserIDsResponse, error) {
 	log.Printf("GetTopUsersToFollow request: Limit=%d, ExcludeUserID=%d", req.Limit, req.GetExcludeUserId())
 	limit := int(req.Limit)
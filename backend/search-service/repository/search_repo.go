@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 	"gorm.io/driver/postgres"
@@ -125,7 +126,7 @@ func (r *SearchRepository) SearchThreads(ctx context.Context, query string, limi
 	normalizedQuery := strings.ToLower(query)
 
 	err := r.threadDB.WithContext(ctx).
-		Where("word_similarity(content, ?) > 0.3 AND deleted_at IS NULL", normalizedQuery).
+		Where("word_similarity(content, ?) > 0.3 AND deleted_at IS NULL AND status = ?", normalizedQuery, "published").
 		Order(fmt.Sprintf("word_similarity(content, '%s') DESC", normalizedQuery)).
 		Limit(limit).
 		Offset(offset).Find(&threads).Error
@@ -159,6 +160,36 @@ func (r *SearchRepository) IncrementHashtagCounts(ctx context.Context, hashtags
 	}
 }
 
+func (r *SearchRepository) DecrementHashtagCounts(ctx context.Context, hashtags []string) {
+    if r.redisClient == nil { log.Println("DecrementHashtagCounts: Redis client not available."); return }
+
+	if len(hashtags) == 0 { return }
+
+	pipe := r.redisClient.Pipeline()
+	for _, tag := range hashtags {
+		if tag != "" {
+			pipe.ZIncrBy(ctx, trendingHashtagsKey, -1, strings.ToLower(strings.TrimSpace(tag)))
+		}
+	}
+	// Drop tags that no longer appear anywhere so they don't linger in the set
+	pipe.ZRemRangeByScore(ctx, trendingHashtagsKey, "-inf", "0")
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		log.Printf("Error decrementing hashtag counts in Redis: %v", err)
+	}
+}
+
+// handledDeletionTTL only needs to outlast RabbitMQ redelivery of the same event.
+const handledDeletionTTL = 7 * 24 * time.Hour
+
+// MarkThreadDeletionHandled records that a thread's deletion at deletedAt has been applied to trending.
+// It returns false if it had already been recorded. Keying on deletedAt lets a restored thread be deleted again.
+func (r *SearchRepository) MarkThreadDeletionHandled(ctx context.Context, threadID uint, deletedAt time.Time) (bool, error) {
+	if r.redisClient == nil { return false, errors.New("MarkThreadDeletionHandled: Redis client not available") }
+	key := fmt.Sprintf("thread_deleted_handled:%d:%d", threadID, deletedAt.UnixNano())
+	return r.redisClient.SetNX(ctx, key, 1, handledDeletionTTL).Result()
+}
+
 func (r *SearchRepository) GetTrendingHashtags(ctx context.Context, topN int64) ([]TrendingHashtagWithScore, error) {
 	if r.redisClient == nil { return nil, errors.New("GetTrendingHashtags: Redis client not available") }
 
@@ -0,0 +1,159 @@
+// Package ads decides which promoted threads a viewer sees and where they go in a feed page.
+// Like ranking, it has no database or network dependencies.
+package ads
+
+import (
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Config struct {
+	Cadence                int           // one ad follows every Cadence organic threads
+	CostPerImpressionCents int64         // charged against a campaign's budget each time it is served
+	AdminUserIDs           map[uint]bool // may run campaigns without being verified
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Cadence:                5,
+		CostPerImpressionCents: 1,
+		AdminUserIDs:           map[uint]bool{},
+	}
+}
+
+// ConfigFromEnv starts from DefaultConfig and applies ADS_* overrides and ADMIN_USER_IDS.
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig()
+	if v, err := strconv.Atoi(os.Getenv("ADS_FEED_CADENCE")); err == nil && v > 0 {
+		cfg.Cadence = v
+	}
+	if v, err := strconv.ParseInt(os.Getenv("ADS_COST_PER_IMPRESSION_CENTS"), 10, 64); err == nil && v > 0 {
+		cfg.CostPerImpressionCents = v
+	}
+	for _, s := range strings.Split(os.Getenv("ADMIN_USER_IDS"), ",") {
+		if id, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32); err == nil && id > 0 {
+			cfg.AdminUserIDs[uint(id)] = true
+		}
+	}
+	return cfg
+}
+
+// Campaign is the subset of an ad campaign needed to decide whether to serve it.
+type Campaign struct {
+	ID               uint
+	ThreadID         uint
+	AdvertiserID     uint
+	BudgetCents      int64
+	SpentCents       int64
+	StartsAt         time.Time
+	EndsAt           time.Time
+	TargetCategories []string // viewer must have liked threads in one of these
+	TargetFollowerOf []uint   // viewer must follow one of these accounts
+}
+
+// Targeted reports whether serving c depends on who the viewer follows or likes.
+func (c Campaign) Targeted() bool {
+	return len(c.TargetCategories) > 0 || len(c.TargetFollowerOf) > 0
+}
+
+// Viewer describes who an ad would be shown to. Following and CategoryAffinity are only
+// needed for targeted campaigns.
+type Viewer struct {
+	UserID           uint
+	Excluded         map[uint]bool // blocked or muted accounts, in either direction
+	Seen             map[uint]bool // campaigns already served in this session
+	Following        map[uint]bool
+	CategoryAffinity map[string]float64
+}
+
+// Eligible reports whether c may be served to v at now and still afford one more impression.
+func Eligible(c Campaign, v Viewer, now time.Time, cfg Config) bool {
+	if now.Before(c.StartsAt) || !now.Before(c.EndsAt) {
+		return false
+	}
+	if c.SpentCents+cfg.CostPerImpressionCents > c.BudgetCents {
+		return false
+	}
+	if c.AdvertiserID == v.UserID || v.Excluded[c.AdvertiserID] || v.Seen[c.ID] {
+		return false
+	}
+	if len(c.TargetCategories) > 0 && !anyCategory(c.TargetCategories, v.CategoryAffinity) {
+		return false
+	}
+	if len(c.TargetFollowerOf) > 0 && !anyFollowed(c.TargetFollowerOf, v.Following) {
+		return false
+	}
+	return true
+}
+
+func anyCategory(categories []string, affinity map[string]float64) bool {
+	for _, category := range categories {
+		if affinity[category] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func anyFollowed(userIDs []uint, following map[uint]bool) bool {
+	for _, id := range userIDs {
+		if following[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// Select returns up to n eligible campaigns, the least spent (relative to budget) first so
+// budgets are used up evenly. Ties go to the older campaign.
+func Select(campaigns []Campaign, v Viewer, now time.Time, cfg Config, n int) []Campaign {
+	var eligible []Campaign
+	for _, c := range campaigns {
+		if Eligible(c, v, now, cfg) {
+			eligible = append(eligible, c)
+		}
+	}
+	sort.SliceStable(eligible, func(i, j int) bool {
+		ri, rj := spentRatio(eligible[i]), spentRatio(eligible[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return eligible[i].ID < eligible[j].ID
+	})
+	if len(eligible) > n {
+		eligible = eligible[:n]
+	}
+	return eligible
+}
+
+func spentRatio(c Campaign) float64 {
+	return float64(c.SpentCents) / float64(c.BudgetCents)
+}
+
+// Slots is how many ads a page of organicCount threads has room for.
+func Slots(organicCount, cadence int) int {
+	if cadence <= 0 {
+		return 0
+	}
+	return organicCount / cadence
+}
+
+// Insert places one ad after every cadence organic items until the ads run out.
+func Insert[T any](organic, ads []T, cadence int) []T {
+	if len(ads) == 0 || cadence <= 0 {
+		return organic
+	}
+	out := make([]T, 0, len(organic)+len(ads))
+	next := 0
+	for i, item := range organic {
+		out = append(out, item)
+		if (i+1)%cadence == 0 && next < len(ads) {
+			out = append(out, ads[next])
+			next++
+		}
+	}
+	return out
+}
@@ -0,0 +1,84 @@
+package ads
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func testCampaign(id uint) Campaign {
+	return Campaign{
+		ID: id, ThreadID: 100 + id, AdvertiserID: 50,
+		BudgetCents: 100, StartsAt: testNow.Add(-time.Hour), EndsAt: testNow.Add(time.Hour),
+	}
+}
+
+func TestEligible(t *testing.T) {
+	cfg := DefaultConfig()
+	viewer := Viewer{UserID: 1}
+	assert.True(t, Eligible(testCampaign(1), viewer, testNow, cfg))
+
+	notStarted := testCampaign(1)
+	notStarted.StartsAt = testNow.Add(time.Minute)
+	assert.False(t, Eligible(notStarted, viewer, testNow, cfg))
+
+	ended := testCampaign(1)
+	ended.EndsAt = testNow
+	assert.False(t, Eligible(ended, viewer, testNow, cfg), "end is exclusive")
+
+	spent := testCampaign(1)
+	spent.SpentCents = spent.BudgetCents
+	assert.False(t, Eligible(spent, viewer, testNow, cfg), "cannot afford another impression")
+
+	assert.False(t, Eligible(testCampaign(1), Viewer{UserID: 50}, testNow, cfg), "advertiser's own ad")
+	assert.False(t, Eligible(testCampaign(1), Viewer{UserID: 1, Excluded: map[uint]bool{50: true}}, testNow, cfg), "blocked or muted advertiser")
+	assert.False(t, Eligible(testCampaign(1), Viewer{UserID: 1, Seen: map[uint]bool{1: true}}, testNow, cfg), "already served this session")
+}
+
+func TestEligibleTargeting(t *testing.T) {
+	cfg := DefaultConfig()
+	c := testCampaign(1)
+	c.TargetCategories = []string{"sports"}
+	c.TargetFollowerOf = []uint{7, 8}
+	assert.True(t, c.Targeted())
+
+	assert.False(t, Eligible(c, Viewer{UserID: 1}, testNow, cfg))
+	assert.False(t, Eligible(c, Viewer{UserID: 1, CategoryAffinity: map[string]float64{"sports": 1}}, testNow, cfg), "must also follow a target account")
+	assert.True(t, Eligible(c, Viewer{
+		UserID:           1,
+		CategoryAffinity: map[string]float64{"sports": 0.5},
+		Following:        map[uint]bool{8: true},
+	}, testNow, cfg))
+}
+
+func TestSelectPacesBySpend(t *testing.T) {
+	a, b, c := testCampaign(1), testCampaign(2), testCampaign(3)
+	a.SpentCents = 50
+	b.SpentCents = 10
+	c.SpentCents = 10
+	selected := Select([]Campaign{a, b, c}, Viewer{UserID: 1}, testNow, DefaultConfig(), 2)
+	assert.Equal(t, []uint{2, 3}, []uint{selected[0].ID, selected[1].ID})
+	assert.Empty(t, Select([]Campaign{a}, Viewer{UserID: 1}, testNow, DefaultConfig(), 0))
+}
+
+func TestInsert(t *testing.T) {
+	organic := []string{"t1", "t2", "t3", "t4", "t5"}
+	assert.Equal(t, 2, Slots(len(organic), 2))
+	assert.Equal(t, []string{"t1", "t2", "ad1", "t3", "t4", "ad2", "t5"}, Insert(organic, []string{"ad1", "ad2", "ad3"}, 2))
+	assert.Equal(t, []string{"t1", "t2", "ad1", "t3", "t4", "t5"}, Insert(organic, []string{"ad1"}, 2))
+	assert.Equal(t, organic, Insert(organic, nil, 2))
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("ADS_FEED_CADENCE", "8")
+	t.Setenv("ADS_COST_PER_IMPRESSION_CENTS", "0")
+	t.Setenv("ADMIN_USER_IDS", "3, 9,x")
+
+	cfg := ConfigFromEnv()
+	assert.Equal(t, 8, cfg.Cadence)
+	assert.Equal(t, DefaultConfig().CostPerImpressionCents, cfg.CostPerImpressionCents)
+	assert.Equal(t, map[uint]bool{3: true, 9: true}, cfg.AdminUserIDs)
+}
@@ -0,0 +1,70 @@
+package analytics
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Store reads raw events and holds the hourly buckets built from them.
+type Store interface {
+	// ThreadEventsBetween returns the events that occurred in [from, to).
+	ThreadEventsBetween(ctx context.Context, from, to time.Time) ([]Event, error)
+	// ReplaceAnalyticsBuckets swaps all buckets with an hour in [from, to) for buckets.
+	ReplaceAnalyticsBuckets(ctx context.Context, from, to time.Time, buckets []Bucket) error
+}
+
+// Aggregator periodically rebuilds the buckets of recent hours from the raw events. Every run
+// recomputes whole hours rather than adding to them, so a run that is repeated, retried or
+// overlaps the previous one never double-counts.
+type Aggregator struct {
+	store Store
+	cfg   Config
+	now   func() time.Time
+}
+
+func NewAggregator(store Store, cfg Config) *Aggregator {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultConfig().Interval
+	}
+	if cfg.Lateness < MaxEventAge {
+		cfg.Lateness = MaxEventAge
+	}
+	return &Aggregator{store: store, cfg: cfg, now: time.Now}
+}
+
+// Window returns the hours the next run rebuilds: from the hour Lateness ago up to and
+// including the current hour.
+func (a *Aggregator) Window() (from, to time.Time) {
+	now := a.now()
+	return HourOf(now.Add(-a.cfg.Lateness)), HourOf(now).Add(time.Hour)
+}
+
+// RunOnce rebuilds the buckets in the current window.
+func (a *Aggregator) RunOnce(ctx context.Context) error {
+	from, to := a.Window()
+	events, err := a.store.ThreadEventsBetween(ctx, from, to)
+	if err != nil {
+		return err
+	}
+	return a.store.ReplaceAnalyticsBuckets(ctx, from, to, Aggregate(events))
+}
+
+// Start blocks until ctx is cancelled, rebuilding recent buckets once per interval.
+func (a *Aggregator) Start(ctx context.Context) {
+	log.Printf("Thread analytics aggregator started (interval: %v, lateness: %v)", a.cfg.Interval, a.cfg.Lateness)
+	ticker := time.NewTicker(a.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.RunOnce(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Analytics aggregator: Failed to rebuild buckets: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			log.Println("Thread analytics aggregator stopped.")
+			return
+		case <-ticker.C:
+		}
+	}
+}
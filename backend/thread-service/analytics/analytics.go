@@ -0,0 +1,157 @@
+// Package analytics turns raw view events (impressions, detail views, profile clicks) into hourly
+// per-thread buckets. Like ranking, it has no database or network dependencies; the Aggregator reads
+// and writes through a Store.
+package analytics
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Event kinds recorded by the gateway.
+const (
+	KindImpression   = "impression"    // the thread was shown in a feed, search result or profile
+	KindDetailView   = "detail_view"   // the thread was opened
+	KindProfileClick = "profile_click" // the author's profile was opened from the thread
+)
+
+// MaxEventAge is how old an event may be when it is recorded. Older events would land in an hour
+// the aggregator has already closed, so they are dropped instead.
+const MaxEventAge = time.Hour
+
+func ValidKind(kind string) bool {
+	return kind == KindImpression || kind == KindDetailView || kind == KindProfileClick
+}
+
+// Event is one deduplicated view of a thread. ViewerKey identifies the viewer ("u:<id>" for
+// signed-in users, a hashed client key otherwise).
+type Event struct {
+	ThreadID     uint
+	ViewerKey    string
+	Kind         string
+	FromFollower bool // the viewer followed the author when the event was recorded
+	OccurredAt   time.Time
+}
+
+// Bucket holds the counts of one thread for the hour starting at Hour.
+type Bucket struct {
+	ThreadID               uint
+	Hour                   time.Time
+	Impressions            int64
+	FollowerImpressions    int64
+	NonFollowerImpressions int64
+	DetailViews            int64
+	ProfileClicks          int64
+}
+
+// HourOf returns the start of the UTC hour t falls in.
+func HourOf(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Hour)
+}
+
+// DedupeKey is the same for every event of one viewer, thread and kind within an hour, so each
+// viewer counts at most once per hour however often the thread is shown to them.
+func DedupeKey(e Event) string {
+	return fmt.Sprintf("%d:%s:%s:%d", e.ThreadID, e.ViewerKey, e.Kind, HourOf(e.OccurredAt).Unix())
+}
+
+// NormalizeTime returns the time an event is recorded at: occurredAt, or now when it is unset or in
+// the future. ok is false when the event is older than MaxEventAge.
+func NormalizeTime(occurredAt, now time.Time) (t time.Time, ok bool) {
+	if occurredAt.IsZero() || occurredAt.After(now) {
+		return now.UTC(), true
+	}
+	if now.Sub(occurredAt) > MaxEventAge {
+		return time.Time{}, false
+	}
+	return occurredAt.UTC(), true
+}
+
+// Aggregate counts events into hourly buckets, ordered by thread and hour. Duplicate events
+// (same DedupeKey) are counted once, so aggregating the same events twice gives the same buckets.
+func Aggregate(events []Event) []Bucket {
+	type bucketKey struct {
+		threadID uint
+		hour     int64
+	}
+	seen := make(map[string]bool, len(events))
+	byKey := make(map[bucketKey]*Bucket)
+	for _, e := range events {
+		if !ValidKind(e.Kind) {
+			continue
+		}
+		dedupeKey := DedupeKey(e)
+		if seen[dedupeKey] {
+			continue
+		}
+		seen[dedupeKey] = true
+
+		hour := HourOf(e.OccurredAt)
+		key := bucketKey{threadID: e.ThreadID, hour: hour.Unix()}
+		b, ok := byKey[key]
+		if !ok {
+			b = &Bucket{ThreadID: e.ThreadID, Hour: hour}
+			byKey[key] = b
+		}
+		switch e.Kind {
+		case KindImpression:
+			b.Impressions++
+			if e.FromFollower {
+				b.FollowerImpressions++
+			} else {
+				b.NonFollowerImpressions++
+			}
+		case KindDetailView:
+			b.DetailViews++
+		case KindProfileClick:
+			b.ProfileClicks++
+		}
+	}
+
+	buckets := make([]Bucket, 0, len(byKey))
+	for _, b := range byKey {
+		buckets = append(buckets, *b)
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if buckets[i].ThreadID != buckets[j].ThreadID {
+			return buckets[i].ThreadID < buckets[j].ThreadID
+		}
+		return buckets[i].Hour.Before(buckets[j].Hour)
+	})
+	return buckets
+}
+
+// EngagementRate is engagements per impression, or 0 before the thread has been seen.
+func EngagementRate(engagements, impressions int64) float64 {
+	if impressions <= 0 {
+		return 0
+	}
+	return float64(engagements) / float64(impressions)
+}
+
+type Config struct {
+	Interval time.Duration // how often buckets are rebuilt
+	Lateness time.Duration // how far back each run rebuilds, to pick up events that arrived late
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Interval: time.Minute,
+		Lateness: 2 * time.Hour,
+	}
+}
+
+// ConfigFromEnv starts from DefaultConfig and applies ANALYTICS_* overrides.
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig()
+	if v, err := strconv.Atoi(os.Getenv("ANALYTICS_AGGREGATE_INTERVAL_SECONDS")); err == nil && v > 0 {
+		cfg.Interval = time.Duration(v) * time.Second
+	}
+	if v, err := strconv.Atoi(os.Getenv("ANALYTICS_LATENESS_MINUTES")); err == nil && v > 0 {
+		cfg.Lateness = time.Duration(v) * time.Minute
+	}
+	return cfg
+}
@@ -0,0 +1,149 @@
+package analytics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func impression(threadID uint, viewer string, at time.Time, fromFollower bool) Event {
+	return Event{ThreadID: threadID, ViewerKey: viewer, Kind: KindImpression, FromFollower: fromFollower, OccurredAt: at}
+}
+
+func TestAggregate(t *testing.T) {
+	events := []Event{
+		impression(1, "u:1", testNow.Add(5*time.Minute), true),
+		impression(1, "u:1", testNow.Add(40*time.Minute), true), // same viewer and hour
+		impression(1, "u:2", testNow.Add(10*time.Minute), false),
+		impression(1, "u:1", testNow.Add(65*time.Minute), true), // next hour
+		{ThreadID: 1, ViewerKey: "u:2", Kind: KindDetailView, OccurredAt: testNow.Add(11 * time.Minute)},
+		{ThreadID: 1, ViewerKey: "u:2", Kind: KindProfileClick, OccurredAt: testNow.Add(12 * time.Minute)},
+		impression(2, "u:1", testNow, false),
+		{ThreadID: 2, ViewerKey: "u:1", Kind: "like", OccurredAt: testNow},
+	}
+
+	buckets := Aggregate(events)
+	require.Len(t, buckets, 3)
+	assert.Equal(t, Bucket{
+		ThreadID: 1, Hour: testNow,
+		Impressions: 2, FollowerImpressions: 1, NonFollowerImpressions: 1, DetailViews: 1, ProfileClicks: 1,
+	}, buckets[0])
+	assert.Equal(t, Bucket{ThreadID: 1, Hour: testNow.Add(time.Hour), Impressions: 1, FollowerImpressions: 1}, buckets[1])
+	assert.Equal(t, Bucket{ThreadID: 2, Hour: testNow, Impressions: 1, NonFollowerImpressions: 1}, buckets[2], "unknown kinds are ignored")
+
+	assert.Equal(t, buckets, Aggregate(append(events, events...)), "duplicates are counted once")
+}
+
+func TestDedupeKey(t *testing.T) {
+	a := impression(1, "u:1", testNow.Add(time.Minute), false)
+	b := impression(1, "u:1", testNow.Add(59*time.Minute), true)
+	assert.Equal(t, DedupeKey(a), DedupeKey(b))
+
+	b.OccurredAt = testNow.Add(time.Hour)
+	assert.NotEqual(t, DedupeKey(a), DedupeKey(b))
+	b.OccurredAt, b.Kind = a.OccurredAt, KindDetailView
+	assert.NotEqual(t, DedupeKey(a), DedupeKey(b))
+}
+
+func TestNormalizeTime(t *testing.T) {
+	at, ok := NormalizeTime(time.Time{}, testNow)
+	assert.True(t, ok)
+	assert.Equal(t, testNow, at)
+
+	at, ok = NormalizeTime(testNow.Add(time.Minute), testNow)
+	assert.True(t, ok)
+	assert.Equal(t, testNow, at, "clock skew can't push events into the future")
+
+	at, ok = NormalizeTime(testNow.Add(-30*time.Minute), testNow)
+	assert.True(t, ok)
+	assert.Equal(t, testNow.Add(-30*time.Minute), at)
+
+	_, ok = NormalizeTime(testNow.Add(-MaxEventAge-time.Second), testNow)
+	assert.False(t, ok)
+}
+
+func TestEngagementRate(t *testing.T) {
+	assert.Equal(t, 0.0, EngagementRate(5, 0))
+	assert.InDelta(t, 0.25, EngagementRate(5, 20), 1e-9)
+}
+
+type memStore struct {
+	events  []Event
+	buckets map[uint]map[int64]Bucket
+	replays int
+}
+
+func (s *memStore) ThreadEventsBetween(ctx context.Context, from, to time.Time) ([]Event, error) {
+	var events []Event
+	for _, e := range s.events {
+		if !e.OccurredAt.Before(from) && e.OccurredAt.Before(to) {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (s *memStore) ReplaceAnalyticsBuckets(ctx context.Context, from, to time.Time, buckets []Bucket) error {
+	s.replays++
+	for _, byHour := range s.buckets {
+		for hour := range byHour {
+			if h := time.Unix(hour, 0); !h.Before(from) && h.Before(to) {
+				delete(byHour, hour)
+			}
+		}
+	}
+	for _, b := range buckets {
+		if s.buckets[b.ThreadID] == nil {
+			s.buckets[b.ThreadID] = map[int64]Bucket{}
+		}
+		s.buckets[b.ThreadID][b.Hour.Unix()] = b
+	}
+	return nil
+}
+
+func TestAggregator_Window(t *testing.T) {
+	agg := NewAggregator(&memStore{}, Config{Interval: time.Minute, Lateness: 2 * time.Hour})
+	agg.now = func() time.Time { return testNow.Add(30 * time.Minute) }
+
+	from, to := agg.Window()
+	assert.Equal(t, testNow.Add(-2*time.Hour), from)
+	assert.Equal(t, testNow.Add(time.Hour), to, "the current hour is included")
+}
+
+func TestAggregator_RunOnceIsIdempotent(t *testing.T) {
+	store := &memStore{buckets: map[uint]map[int64]Bucket{}}
+	agg := NewAggregator(store, DefaultConfig())
+	now := testNow.Add(10 * time.Minute)
+	agg.now = func() time.Time { return now }
+
+	store.events = []Event{
+		impression(1, "u:1", testNow.Add(time.Minute), true),
+		impression(1, "u:2", testNow.Add(2*time.Minute), false),
+	}
+	require.NoError(t, agg.RunOnce(context.Background()))
+	require.NoError(t, agg.RunOnce(context.Background()))
+	assert.Equal(t, int64(2), store.buckets[1][testNow.Unix()].Impressions, "running twice doesn't double-count")
+
+	// An event arriving late for the previous hour is picked up by the next run.
+	now = testNow.Add(70 * time.Minute)
+	store.events = append(store.events,
+		impression(1, "u:3", testNow.Add(50*time.Minute), false),
+		impression(1, "u:3", testNow.Add(65*time.Minute), false),
+	)
+	require.NoError(t, agg.RunOnce(context.Background()))
+	assert.Equal(t, int64(3), store.buckets[1][testNow.Unix()].Impressions)
+	assert.Equal(t, int64(2), store.buckets[1][testNow.Unix()].NonFollowerImpressions)
+	assert.Equal(t, int64(1), store.buckets[1][testNow.Add(time.Hour).Unix()].Impressions)
+
+	// Hours older than the window are left alone.
+	now = testNow.Add(DefaultConfig().Lateness + 2*time.Hour)
+	store.events = nil
+	require.NoError(t, agg.RunOnce(context.Background()))
+	assert.Equal(t, int64(3), store.buckets[1][testNow.Unix()].Impressions)
+	assert.Equal(t, 4, store.replays)
+}
@@ -1,16 +1,24 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/client"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/event"
 	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
 	threadhandler "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/handler/grpc"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/linkpreview"
 	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/scheduler"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/timeline"
 	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/utils"
+	"github.com/go-redis/redis/v8"
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -45,6 +53,21 @@ func main() {
 	}
 	defer searchClient.Close()
 
+	// Timelines for the following feed; without Redis the feed is built in Postgres on every request
+	var timelines *timeline.Store
+	if redisAddr := os.Getenv("REDIS_ADDR"); redisAddr != "" {
+		rdb := redis.NewClient(&redis.Options{Addr: redisAddr})
+		if _, err := rdb.Ping(context.Background()).Result(); err != nil {
+			log.Printf("Warning: Could not connect to Redis at %s: %v. Timelines are disabled.", redisAddr, err)
+			rdb.Close()
+		} else {
+			defer rdb.Close()
+			timelines = timeline.NewStore(rdb, timeline.ConfigFromEnv())
+		}
+	} else {
+		log.Println("Warning: REDIS_ADDR not set, timelines are disabled.")
+	}
+
 	port := os.Getenv("PORT")
 	if port == "" { port = "50052" } // Default thread service port
 	lis, err := net.Listen("tcp", ":"+port)
@@ -54,10 +77,55 @@ func main() {
 	defer utils.CloseRabbitMQPublisher()
 
 	s := grpc.NewServer()
-	threadServer := threadhandler.NewThreadHandler(repo, userClient.GetClient(), searchClient.GetClient())
+	var previewFetcher linkpreview.Fetcher
+	if os.Getenv("LINK_PREVIEWS_DISABLED") != "true" {
+		previewFetcher = linkpreview.NewHTTPFetcher(linkpreview.ConfigFromEnv())
+	}
+	threadServer := threadhandler.NewThreadHandler(repo, userClient.GetClient(), searchClient.GetClient(), timelines, previewFetcher)
 	threadpb.RegisterThreadServiceServer(s, threadServer)
 	reflection.Register(s)
 
+	if timelines != nil {
+		consumer, err := event.NewConsumer(threadServer.ApplyFollow, threadServer.ApplyUnfollow)
+		if err != nil {
+			log.Printf("Warning: social event consumer disabled, timelines will miss follows until they expire: %v", err)
+		} else {
+			defer consumer.Close()
+			go consumer.StartConsuming()
+		}
+	}
+
+	// Background publisher for scheduled threads
+	schedulerInterval := 30 * time.Second
+	if v, err := strconv.Atoi(os.Getenv("SCHEDULER_INTERVAL_SECONDS")); err == nil && v > 0 {
+		schedulerInterval = time.Duration(v) * time.Second
+	}
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	defer stopScheduler()
+	publisher := scheduler.NewPublisher(repo, threadServer.PublishScheduledThread, schedulerInterval, 100)
+	go publisher.Start(schedulerCtx)
+	pollCloser := scheduler.NewPollCloser(repo, threadServer.PublishPollClosed, schedulerInterval, 100)
+	go pollCloser.Start(schedulerCtx)
+
+	// Background repair of denormalized thread counters
+	reconcileInterval := time.Hour
+	if v, err := strconv.Atoi(os.Getenv("STATS_RECONCILE_INTERVAL_MINUTES")); err == nil && v > 0 {
+		reconcileInterval = time.Duration(v) * time.Minute
+	}
+	reconciler := scheduler.NewStatsReconciler(repo, reconcileInterval, 500)
+	go reconciler.Start(schedulerCtx)
+
+	// Hard delete threads once they can no longer be restored
+	purgeInterval := time.Hour
+	if v, err := strconv.Atoi(os.Getenv("PURGE_INTERVAL_MINUTES")); err == nil && v > 0 {
+		purgeInterval = time.Duration(v) * time.Minute
+	}
+	purger := scheduler.NewPurger(repo, threadServer.RestoreWindow(), purgeInterval, 100)
+	go purger.Start(schedulerCtx)
+
+	go threadServer.RunLinkPreviewWorker(schedulerCtx)
+	go threadServer.RunAnalyticsAggregator(schedulerCtx)
+
 	fmt.Printf("Thread gRPC server listening on :%s\n", port)
 	if err := s.Serve(lis); err != nil { log.Fatalf("failed to serve gRPC: %v", err) }
 }
\ No newline at end of file
@@ -0,0 +1,117 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// SocialEvent matches the follow and unfollow payloads published by user-service.
+type SocialEvent struct {
+	FollowedUserID uint `json:"followed_user_id"`
+	FollowerUserID uint `json:"follower_user_id"`
+}
+
+const (
+	SocialEventsExchange     = "social_events"
+	TimelineSocialQueue      = "timeline_social_queue"
+	NewFollowerRoutingKey    = "social.new_follower"
+	UnfollowedRoutingKey     = "social.unfollowed"
+	socialEventHandleTimeout = 30 * time.Second
+)
+
+// FollowFunc applies a follow or unfollow of followedID by followerID.
+type FollowFunc func(ctx context.Context, followerID, followedID uint) error
+
+// Consumer keeps timelines in step with the social graph. Follows and unfollows share one queue
+// so a quick follow-unfollow is applied in order.
+type Consumer struct {
+	conn       *amqp.Connection
+	channel    *amqp.Channel
+	onFollow   FollowFunc
+	onUnfollow FollowFunc
+}
+
+func NewConsumer(onFollow, onUnfollow FollowFunc) (*Consumer, error) {
+	amqpURL := os.Getenv("RABBITMQ_URL")
+	if amqpURL == "" {
+		return nil, errors.New("RABBITMQ_URL not set")
+	}
+	conn, err := amqp.Dial(amqpURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to RabbitMQ: %w", err)
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to open RabbitMQ channel: %w", err)
+	}
+
+	if err := ch.ExchangeDeclare(SocialEventsExchange, "topic", true, false, false, false, nil); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to declare exchange %s: %w", SocialEventsExchange, err)
+	}
+	if _, err := ch.QueueDeclare(TimelineSocialQueue, true, false, false, false, nil); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to declare queue %s: %w", TimelineSocialQueue, err)
+	}
+	for _, key := range []string{NewFollowerRoutingKey, UnfollowedRoutingKey} {
+		if err := ch.QueueBind(TimelineSocialQueue, key, SocialEventsExchange, false, nil); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to bind queue %s to %s: %w", TimelineSocialQueue, key, err)
+		}
+	}
+
+	return &Consumer{conn: conn, channel: ch, onFollow: onFollow, onUnfollow: onUnfollow}, nil
+}
+
+// StartConsuming blocks, handling follow and unfollow events until the channel closes.
+func (c *Consumer) StartConsuming() {
+	msgs, err := c.channel.Consume(TimelineSocialQueue, "", false, false, false, false, nil)
+	if err != nil {
+		log.Printf("Failed to register a consumer for queue %s: %v", TimelineSocialQueue, err)
+		return
+	}
+	log.Printf(" [*] Waiting for messages on %s", TimelineSocialQueue)
+	for d := range msgs {
+		c.handleSocialEvent(d)
+		if err := d.Ack(false); err != nil {
+			log.Printf("Error acknowledging message from %s: %v", TimelineSocialQueue, err)
+		}
+	}
+}
+
+func (c *Consumer) handleSocialEvent(d amqp.Delivery) {
+	var event SocialEvent
+	if err := json.Unmarshal(d.Body, &event); err != nil {
+		log.Printf("Error unmarshalling social event %s: %v", d.RoutingKey, err)
+		return
+	}
+	apply := c.onFollow
+	if d.RoutingKey == UnfollowedRoutingKey {
+		apply = c.onUnfollow
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), socialEventHandleTimeout)
+	defer cancel()
+	if err := apply(ctx, event.FollowerUserID, event.FollowedUserID); err != nil {
+		// The timeline is rebuilt from Postgres once it expires, so the event is not retried
+		log.Printf("Error applying %s of user %d by user %d to timeline: %v", d.RoutingKey, event.FollowedUserID, event.FollowerUserID, err)
+	}
+}
+
+func (c *Consumer) Close() {
+	if c.channel != nil {
+		c.channel.Close()
+	}
+	if c.conn != nil {
+		c.conn.Close()
+	}
+}
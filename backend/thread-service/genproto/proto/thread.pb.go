@@ -139,6 +139,23 @@ type Thread struct {
 	IsLikedByCurrentUser      bool                   `protobuf:"varint,16,opt,name=is_liked_by_current_user,json=isLikedByCurrentUser,proto3" json:"is_liked_by_current_user,omitempty"`
 	IsBookmarkedByCurrentUser bool                   `protobuf:"varint,17,opt,name=is_bookmarked_by_current_user,json=isBookmarkedByCurrentUser,proto3" json:"is_bookmarked_by_current_user,omitempty"`
 	Categories                []string               `protobuf:"bytes,18,rep,name=categories,proto3" json:"categories,omitempty"`
+	CanReply                  bool                   `protobuf:"varint,19,opt,name=can_reply,json=canReply,proto3" json:"can_reply,omitempty"` // computed for the requester from reply_restriction
+	IsRepostedByCurrentUser   bool                   `protobuf:"varint,20,opt,name=is_reposted_by_current_user,json=isRepostedByCurrentUser,proto3" json:"is_reposted_by_current_user,omitempty"`
+	QuotedThreadId            *uint32                `protobuf:"varint,21,opt,name=quoted_thread_id,json=quotedThreadId,proto3,oneof" json:"quoted_thread_id,omitempty"`
+	QuotedThread              *Thread                `protobuf:"bytes,22,opt,name=quoted_thread,json=quotedThread,proto3" json:"quoted_thread,omitempty"` // embedded one level deep, unset if the quoted thread is gone
+	QuoteCount                int32                  `protobuf:"varint,23,opt,name=quote_count,json=quoteCount,proto3" json:"quote_count,omitempty"`
+	RepostedByUserId          *uint32                `protobuf:"varint,24,opt,name=reposted_by_user_id,json=repostedByUserId,proto3,oneof" json:"reposted_by_user_id,omitempty"` // set when a feed item was surfaced by a repost
+	RepostedAt                *timestamppb.Timestamp `protobuf:"bytes,25,opt,name=reposted_at,json=repostedAt,proto3" json:"reposted_at,omitempty"`
+	EditedAt                  *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`                                   // unset if the thread was never edited
+	Poll                      *Poll                  `protobuf:"bytes,27,opt,name=poll,proto3" json:"poll,omitempty"`                                                           // unset if the thread has no poll
+	ParentDeleted             bool                   `protobuf:"varint,28,opt,name=parent_deleted,json=parentDeleted,proto3" json:"parent_deleted,omitempty"`                   // a reply whose parent thread has been deleted
+	MentionedUserIds          []uint32               `protobuf:"varint,29,rep,packed,name=mentioned_user_ids,json=mentionedUserIds,proto3" json:"mentioned_user_ids,omitempty"` // @mentions that were allowed; other @handles are plain text
+	AdCampaignId              *uint32                `protobuf:"varint,30,opt,name=ad_campaign_id,json=adCampaignId,proto3,oneof" json:"ad_campaign_id,omitempty"`              // set when the thread was served as an ad
+	LinkPreview               *LinkPreview           `protobuf:"bytes,31,opt,name=link_preview,json=linkPreview,proto3" json:"link_preview,omitempty"`                          // card for the first link in content; unset until it has been fetched
+	IsHidden                  bool                   `protobuf:"varint,32,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`                                  // reply hidden by the parent thread's author
+	ContentWarning            string                 `protobuf:"bytes,33,opt,name=content_warning,json=contentWarning,proto3" json:"content_warning,omitempty"`                 // e.g. "spoilers"; the content is collapsed behind it
+	IsSensitive               bool                   `protobuf:"varint,34,opt,name=is_sensitive,json=isSensitive,proto3" json:"is_sensitive,omitempty"`                         // media may be graphic or otherwise sensitive
+	IsMasked                  bool                   `protobuf:"varint,35,opt,name=is_masked,json=isMasked,proto3" json:"is_masked,omitempty"`                                  // media, link preview and, with a content warning, the content were withheld from this viewer
 	unknownFields             protoimpl.UnknownFields
 	sizeCache                 protoimpl.SizeCache
 }
@@ -299,6 +316,201 @@ func (x *Thread) GetCategories() []string {
 	return nil
 }
 
+func (x *Thread) GetCanReply() bool {
+	if x != nil {
+		return x.CanReply
+	}
+	return false
+}
+
+func (x *Thread) GetIsRepostedByCurrentUser() bool {
+	if x != nil {
+		return x.IsRepostedByCurrentUser
+	}
+	return false
+}
+
+func (x *Thread) GetQuotedThreadId() uint32 {
+	if x != nil && x.QuotedThreadId != nil {
+		return *x.QuotedThreadId
+	}
+	return 0
+}
+
+func (x *Thread) GetQuotedThread() *Thread {
+	if x != nil {
+		return x.QuotedThread
+	}
+	return nil
+}
+
+func (x *Thread) GetQuoteCount() int32 {
+	if x != nil {
+		return x.QuoteCount
+	}
+	return 0
+}
+
+func (x *Thread) GetRepostedByUserId() uint32 {
+	if x != nil && x.RepostedByUserId != nil {
+		return *x.RepostedByUserId
+	}
+	return 0
+}
+
+func (x *Thread) GetRepostedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RepostedAt
+	}
+	return nil
+}
+
+func (x *Thread) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *Thread) GetPoll() *Poll {
+	if x != nil {
+		return x.Poll
+	}
+	return nil
+}
+
+func (x *Thread) GetParentDeleted() bool {
+	if x != nil {
+		return x.ParentDeleted
+	}
+	return false
+}
+
+func (x *Thread) GetMentionedUserIds() []uint32 {
+	if x != nil {
+		return x.MentionedUserIds
+	}
+	return nil
+}
+
+func (x *Thread) GetAdCampaignId() uint32 {
+	if x != nil && x.AdCampaignId != nil {
+		return *x.AdCampaignId
+	}
+	return 0
+}
+
+func (x *Thread) GetLinkPreview() *LinkPreview {
+	if x != nil {
+		return x.LinkPreview
+	}
+	return nil
+}
+
+func (x *Thread) GetIsHidden() bool {
+	if x != nil {
+		return x.IsHidden
+	}
+	return false
+}
+
+func (x *Thread) GetContentWarning() string {
+	if x != nil {
+		return x.ContentWarning
+	}
+	return ""
+}
+
+func (x *Thread) GetIsSensitive() bool {
+	if x != nil {
+		return x.IsSensitive
+	}
+	return false
+}
+
+func (x *Thread) GetIsMasked() bool {
+	if x != nil {
+		return x.IsMasked
+	}
+	return false
+}
+
+type LinkPreview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // normalized
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	SiteName      string                 `protobuf:"bytes,5,opt,name=site_name,json=siteName,proto3" json:"site_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkPreview) Reset() {
+	*x = LinkPreview{}
+	mi := &file_proto_thread_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkPreview) ProtoMessage() {}
+
+func (x *LinkPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkPreview.ProtoReflect.Descriptor instead.
+func (*LinkPreview) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LinkPreview) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *LinkPreview) GetSiteName() string {
+	if x != nil {
+		return x.SiteName
+	}
+	return ""
+}
+
 type CreateThreadRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	UserId           uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -308,14 +520,19 @@ type CreateThreadRequest struct {
 	ScheduledAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
 	CommunityId      *uint32                `protobuf:"varint,6,opt,name=community_id,json=communityId,proto3,oneof" json:"community_id,omitempty"`
 	MediaIds         []uint32               `protobuf:"varint,7,rep,packed,name=media_ids,json=mediaIds,proto3" json:"media_ids,omitempty"`
-	Categories       []string               `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty"` // is_advertisement
+	Categories       []string               `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories,omitempty"`
+	QuotedThreadId   *uint32                `protobuf:"varint,9,opt,name=quoted_thread_id,json=quotedThreadId,proto3,oneof" json:"quoted_thread_id,omitempty"`
+	PollOptions      []string               `protobuf:"bytes,10,rep,name=poll_options,json=pollOptions,proto3" json:"poll_options,omitempty"`          // 2-4 options; empty for no poll
+	PollClosesAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=poll_closes_at,json=pollClosesAt,proto3" json:"poll_closes_at,omitempty"`     // defaults to 24 hours after posting
+	ContentWarning   string                 `protobuf:"bytes,12,opt,name=content_warning,json=contentWarning,proto3" json:"content_warning,omitempty"` // at most 100 characters
+	IsSensitive      bool                   `protobuf:"varint,13,opt,name=is_sensitive,json=isSensitive,proto3" json:"is_sensitive,omitempty"`         // is_advertisement
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CreateThreadRequest) Reset() {
 	*x = CreateThreadRequest{}
-	mi := &file_proto_thread_proto_msgTypes[2]
+	mi := &file_proto_thread_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -327,7 +544,7 @@ func (x *CreateThreadRequest) String() string {
 func (*CreateThreadRequest) ProtoMessage() {}
 
 func (x *CreateThreadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thread_proto_msgTypes[2]
+	mi := &file_proto_thread_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,7 +557,7 @@ func (x *CreateThreadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateThreadRequest.ProtoReflect.Descriptor instead.
 func (*CreateThreadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thread_proto_rawDescGZIP(), []int{2}
+	return file_proto_thread_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateThreadRequest) GetUserId() uint32 {
@@ -399,29 +616,65 @@ func (x *CreateThreadRequest) GetCategories() []string {
 	return nil
 }
 
-type GetThreadRequest struct {
+func (x *CreateThreadRequest) GetQuotedThreadId() uint32 {
+	if x != nil && x.QuotedThreadId != nil {
+		return *x.QuotedThreadId
+	}
+	return 0
+}
+
+func (x *CreateThreadRequest) GetPollOptions() []string {
+	if x != nil {
+		return x.PollOptions
+	}
+	return nil
+}
+
+func (x *CreateThreadRequest) GetPollClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PollClosesAt
+	}
+	return nil
+}
+
+func (x *CreateThreadRequest) GetContentWarning() string {
+	if x != nil {
+		return x.ContentWarning
+	}
+	return ""
+}
+
+func (x *CreateThreadRequest) GetIsSensitive() bool {
+	if x != nil {
+		return x.IsSensitive
+	}
+	return false
+}
+
+type ChainPost struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
-	CurrentUserId *uint32                `protobuf:"varint,2,opt,name=current_user_id,json=currentUserId,proto3,oneof" json:"current_user_id,omitempty"`
+	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	MediaIds      []uint32               `protobuf:"varint,2,rep,packed,name=media_ids,json=mediaIds,proto3" json:"media_ids,omitempty"`
+	Categories    []string               `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetThreadRequest) Reset() {
-	*x = GetThreadRequest{}
-	mi := &file_proto_thread_proto_msgTypes[3]
+func (x *ChainPost) Reset() {
+	*x = ChainPost{}
+	mi := &file_proto_thread_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetThreadRequest) String() string {
+func (x *ChainPost) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetThreadRequest) ProtoMessage() {}
+func (*ChainPost) ProtoMessage() {}
 
-func (x *GetThreadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thread_proto_msgTypes[3]
+func (x *ChainPost) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,48 +685,59 @@ func (x *GetThreadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetThreadRequest.ProtoReflect.Descriptor instead.
-func (*GetThreadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thread_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ChainPost.ProtoReflect.Descriptor instead.
+func (*ChainPost) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetThreadRequest) GetThreadId() uint32 {
+func (x *ChainPost) GetContent() string {
 	if x != nil {
-		return x.ThreadId
+		return x.Content
 	}
-	return 0
+	return ""
 }
 
-func (x *GetThreadRequest) GetCurrentUserId() uint32 {
-	if x != nil && x.CurrentUserId != nil {
-		return *x.CurrentUserId
+func (x *ChainPost) GetMediaIds() []uint32 {
+	if x != nil {
+		return x.MediaIds
 	}
-	return 0
+	return nil
 }
 
-type DeleteThreadRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
-	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ChainPost) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
 }
 
-func (x *DeleteThreadRequest) Reset() {
-	*x = DeleteThreadRequest{}
-	mi := &file_proto_thread_proto_msgTypes[4]
+// Each post after the first replies to the one before it. Reply restriction, schedule and community apply to every post.
+type CreateThreadChainRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Posts            []*ChainPost           `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty"` // in order, 2-25 posts
+	ReplyRestriction ReplyRestriction       `protobuf:"varint,3,opt,name=reply_restriction,json=replyRestriction,proto3,enum=thread.ReplyRestriction" json:"reply_restriction,omitempty"`
+	ScheduledAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	CommunityId      *uint32                `protobuf:"varint,5,opt,name=community_id,json=communityId,proto3,oneof" json:"community_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateThreadChainRequest) Reset() {
+	*x = CreateThreadChainRequest{}
+	mi := &file_proto_thread_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteThreadRequest) String() string {
+func (x *CreateThreadChainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteThreadRequest) ProtoMessage() {}
+func (*CreateThreadChainRequest) ProtoMessage() {}
 
-func (x *DeleteThreadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thread_proto_msgTypes[4]
+func (x *CreateThreadChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,104 +748,4393 @@ func (x *DeleteThreadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteThreadRequest.ProtoReflect.Descriptor instead.
-func (*DeleteThreadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thread_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreateThreadChainRequest.ProtoReflect.Descriptor instead.
+func (*CreateThreadChainRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateThreadChainRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateThreadChainRequest) GetPosts() []*ChainPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *CreateThreadChainRequest) GetReplyRestriction() ReplyRestriction {
+	if x != nil {
+		return x.ReplyRestriction
+	}
+	return ReplyRestriction_REPLY_RESTRICTION_UNSPECIFIED
+}
+
+func (x *CreateThreadChainRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *CreateThreadChainRequest) GetCommunityId() uint32 {
+	if x != nil && x.CommunityId != nil {
+		return *x.CommunityId
+	}
+	return 0
+}
+
+type CreateThreadChainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"` // in chain order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateThreadChainResponse) Reset() {
+	*x = CreateThreadChainResponse{}
+	mi := &file_proto_thread_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateThreadChainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateThreadChainResponse) ProtoMessage() {}
+
+func (x *CreateThreadChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateThreadChainResponse.ProtoReflect.Descriptor instead.
+func (*CreateThreadChainResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateThreadChainResponse) GetThreads() []*Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+type GetThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	CurrentUserId *uint32                `protobuf:"varint,2,opt,name=current_user_id,json=currentUserId,proto3,oneof" json:"current_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadRequest) Reset() {
+	*x = GetThreadRequest{}
+	mi := &file_proto_thread_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadRequest) ProtoMessage() {}
+
+func (x *GetThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadRequest.ProtoReflect.Descriptor instead.
+func (*GetThreadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetThreadRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *GetThreadRequest) GetCurrentUserId() uint32 {
+	if x != nil && x.CurrentUserId != nil {
+		return *x.CurrentUserId
+	}
+	return 0
+}
+
+type DeleteThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteThreadRequest) Reset() {
+	*x = DeleteThreadRequest{}
+	mi := &file_proto_thread_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteThreadRequest) ProtoMessage() {}
+
+func (x *DeleteThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteThreadRequest.ProtoReflect.Descriptor instead.
+func (*DeleteThreadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteThreadRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *DeleteThreadRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type InteractThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InteractThreadRequest) Reset() {
+	*x = InteractThreadRequest{}
+	mi := &file_proto_thread_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InteractThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InteractThreadRequest) ProtoMessage() {}
+
+func (x *InteractThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InteractThreadRequest.ProtoReflect.Descriptor instead.
+func (*InteractThreadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InteractThreadRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *InteractThreadRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetFeedThreadsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CurrentUserId      *uint32                `protobuf:"varint,1,opt,name=current_user_id,json=currentUserId,proto3,oneof" json:"current_user_id,omitempty"`
+	Page               int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit              int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	FeedType           string                 `protobuf:"bytes,4,opt,name=feed_type,json=feedType,proto3" json:"feed_type,omitempty"`                                           // "foryou", "following"
+	ExcludeUserIds     []uint32               `protobuf:"varint,5,rep,packed,name=exclude_user_ids,json=excludeUserIds,proto3" json:"exclude_user_ids,omitempty"`               // for blocked or blocking
+	IncludeOnlyUserIds []uint32               `protobuf:"varint,6,rep,packed,name=include_only_user_ids,json=includeOnlyUserIds,proto3" json:"include_only_user_ids,omitempty"` // for "following"
+	Cursor             string                 `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`                                                               // next_cursor from the previous page; takes precedence over page
+	SessionId          string                 `protobuf:"bytes,8,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`                                        // the viewer's browsing session; ads are only served when set
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetFeedThreadsRequest) Reset() {
+	*x = GetFeedThreadsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedThreadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedThreadsRequest) ProtoMessage() {}
+
+func (x *GetFeedThreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedThreadsRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedThreadsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetFeedThreadsRequest) GetCurrentUserId() uint32 {
+	if x != nil && x.CurrentUserId != nil {
+		return *x.CurrentUserId
+	}
+	return 0
+}
+
+func (x *GetFeedThreadsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetFeedThreadsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetFeedThreadsRequest) GetFeedType() string {
+	if x != nil {
+		return x.FeedType
+	}
+	return ""
+}
+
+func (x *GetFeedThreadsRequest) GetExcludeUserIds() []uint32 {
+	if x != nil {
+		return x.ExcludeUserIds
+	}
+	return nil
+}
+
+func (x *GetFeedThreadsRequest) GetIncludeOnlyUserIds() []uint32 {
+	if x != nil {
+		return x.IncludeOnlyUserIds
+	}
+	return nil
+}
+
+func (x *GetFeedThreadsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetFeedThreadsRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetFeedThreadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty when there are no more results
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFeedThreadsResponse) Reset() {
+	*x = GetFeedThreadsResponse{}
+	mi := &file_proto_thread_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFeedThreadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFeedThreadsResponse) ProtoMessage() {}
+
+func (x *GetFeedThreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFeedThreadsResponse.ProtoReflect.Descriptor instead.
+func (*GetFeedThreadsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetFeedThreadsResponse) GetThreads() []*Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *GetFeedThreadsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetFeedThreadsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetUserThreadsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TargetUserId    uint32                 `protobuf:"varint,1,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
+	ThreadType      string                 `protobuf:"bytes,3,opt,name=thread_type,json=threadType,proto3" json:"thread_type,omitempty"` // "posts", "replies", "likes", "media"
+	Page            int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	ExcludeUserIds  []uint32               `protobuf:"varint,6,rep,packed,name=exclude_user_ids,json=excludeUserIds,proto3" json:"exclude_user_ids,omitempty"`
+	Cursor          string                 `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetUserThreadsRequest) Reset() {
+	*x = GetUserThreadsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserThreadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserThreadsRequest) ProtoMessage() {}
+
+func (x *GetUserThreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserThreadsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserThreadsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetUserThreadsRequest) GetTargetUserId() uint32 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+func (x *GetUserThreadsRequest) GetRequesterUserId() uint32 {
+	if x != nil && x.RequesterUserId != nil {
+		return *x.RequesterUserId
+	}
+	return 0
+}
+
+func (x *GetUserThreadsRequest) GetThreadType() string {
+	if x != nil {
+		return x.ThreadType
+	}
+	return ""
+}
+
+func (x *GetUserThreadsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUserThreadsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetUserThreadsRequest) GetExcludeUserIds() []uint32 {
+	if x != nil {
+		return x.ExcludeUserIds
+	}
+	return nil
+}
+
+func (x *GetUserThreadsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetUserThreadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserThreadsResponse) Reset() {
+	*x = GetUserThreadsResponse{}
+	mi := &file_proto_thread_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserThreadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserThreadsResponse) ProtoMessage() {}
+
+func (x *GetUserThreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserThreadsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserThreadsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUserThreadsResponse) GetThreads() []*Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *GetUserThreadsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetUserThreadsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetCommunityThreadsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CommunityId     uint32                 `protobuf:"varint,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
+	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
+	SortType        string                 `protobuf:"bytes,3,opt,name=sort_type,json=sortType,proto3" json:"sort_type,omitempty"` // "latest", "top" (for posts within community)
+	Page            int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	ExcludeUserIds  []uint32               `protobuf:"varint,6,rep,packed,name=exclude_user_ids,json=excludeUserIds,proto3" json:"exclude_user_ids,omitempty"`
+	Cursor          string                 `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCommunityThreadsRequest) Reset() {
+	*x = GetCommunityThreadsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommunityThreadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunityThreadsRequest) ProtoMessage() {}
+
+func (x *GetCommunityThreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunityThreadsRequest.ProtoReflect.Descriptor instead.
+func (*GetCommunityThreadsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetCommunityThreadsRequest) GetCommunityId() uint32 {
+	if x != nil {
+		return x.CommunityId
+	}
+	return 0
+}
+
+func (x *GetCommunityThreadsRequest) GetRequesterUserId() uint32 {
+	if x != nil && x.RequesterUserId != nil {
+		return *x.RequesterUserId
+	}
+	return 0
+}
+
+func (x *GetCommunityThreadsRequest) GetSortType() string {
+	if x != nil {
+		return x.SortType
+	}
+	return ""
+}
+
+func (x *GetCommunityThreadsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCommunityThreadsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCommunityThreadsRequest) GetExcludeUserIds() []uint32 {
+	if x != nil {
+		return x.ExcludeUserIds
+	}
+	return nil
+}
+
+func (x *GetCommunityThreadsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetCommunityThreadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCommunityThreadsResponse) Reset() {
+	*x = GetCommunityThreadsResponse{}
+	mi := &file_proto_thread_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommunityThreadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommunityThreadsResponse) ProtoMessage() {}
+
+func (x *GetCommunityThreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommunityThreadsResponse.ProtoReflect.Descriptor instead.
+func (*GetCommunityThreadsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCommunityThreadsResponse) GetThreads() []*Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *GetCommunityThreadsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetCommunityThreadsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetBookmarkedThreadsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
+	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor          string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetBookmarkedThreadsRequest) Reset() {
+	*x = GetBookmarkedThreadsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookmarkedThreadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookmarkedThreadsRequest) ProtoMessage() {}
+
+func (x *GetBookmarkedThreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookmarkedThreadsRequest.ProtoReflect.Descriptor instead.
+func (*GetBookmarkedThreadsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetBookmarkedThreadsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetBookmarkedThreadsRequest) GetRequesterUserId() uint32 {
+	if x != nil && x.RequesterUserId != nil {
+		return *x.RequesterUserId
+	}
+	return 0
+}
+
+func (x *GetBookmarkedThreadsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetBookmarkedThreadsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetBookmarkedThreadsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetBookmarkedThreadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookmarkedThreadsResponse) Reset() {
+	*x = GetBookmarkedThreadsResponse{}
+	mi := &file_proto_thread_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookmarkedThreadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookmarkedThreadsResponse) ProtoMessage() {}
+
+func (x *GetBookmarkedThreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookmarkedThreadsResponse.ProtoReflect.Descriptor instead.
+func (*GetBookmarkedThreadsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetBookmarkedThreadsResponse) GetThreads() []*Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *GetBookmarkedThreadsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetBookmarkedThreadsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetRepliesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ParentThreadId  uint32                 `protobuf:"varint,1,opt,name=parent_thread_id,json=parentThreadId,proto3" json:"parent_thread_id,omitempty"`
+	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
+	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	ExcludeUserIds  []uint32               `protobuf:"varint,5,rep,packed,name=exclude_user_ids,json=excludeUserIds,proto3" json:"exclude_user_ids,omitempty"`
+	Cursor          string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRepliesRequest) Reset() {
+	*x = GetRepliesRequest{}
+	mi := &file_proto_thread_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepliesRequest) ProtoMessage() {}
+
+func (x *GetRepliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepliesRequest.ProtoReflect.Descriptor instead.
+func (*GetRepliesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetRepliesRequest) GetParentThreadId() uint32 {
+	if x != nil {
+		return x.ParentThreadId
+	}
+	return 0
+}
+
+func (x *GetRepliesRequest) GetRequesterUserId() uint32 {
+	if x != nil && x.RequesterUserId != nil {
+		return *x.RequesterUserId
+	}
+	return 0
+}
+
+func (x *GetRepliesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetRepliesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetRepliesRequest) GetExcludeUserIds() []uint32 {
+	if x != nil {
+		return x.ExcludeUserIds
+	}
+	return nil
+}
+
+func (x *GetRepliesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetRepliesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepliesResponse) Reset() {
+	*x = GetRepliesResponse{}
+	mi := &file_proto_thread_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepliesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepliesResponse) ProtoMessage() {}
+
+func (x *GetRepliesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepliesResponse.ProtoReflect.Descriptor instead.
+func (*GetRepliesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetRepliesResponse) GetThreads() []*Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *GetRepliesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetRepliesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Scheduled threads are only visible to their author until they are published
+type GetScheduledThreadsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduledThreadsRequest) Reset() {
+	*x = GetScheduledThreadsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduledThreadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledThreadsRequest) ProtoMessage() {}
+
+func (x *GetScheduledThreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledThreadsRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduledThreadsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetScheduledThreadsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetScheduledThreadsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetScheduledThreadsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetScheduledThreadsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetScheduledThreadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduledThreadsResponse) Reset() {
+	*x = GetScheduledThreadsResponse{}
+	mi := &file_proto_thread_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduledThreadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduledThreadsResponse) ProtoMessage() {}
+
+func (x *GetScheduledThreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduledThreadsResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduledThreadsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetScheduledThreadsResponse) GetThreads() []*Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *GetScheduledThreadsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetScheduledThreadsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type RescheduleThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleThreadRequest) Reset() {
+	*x = RescheduleThreadRequest{}
+	mi := &file_proto_thread_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleThreadRequest) ProtoMessage() {}
+
+func (x *RescheduleThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleThreadRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleThreadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RescheduleThreadRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *RescheduleThreadRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RescheduleThreadRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+type CancelScheduledThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledThreadRequest) Reset() {
+	*x = CancelScheduledThreadRequest{}
+	mi := &file_proto_thread_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledThreadRequest) ProtoMessage() {}
+
+func (x *CancelScheduledThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledThreadRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledThreadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CancelScheduledThreadRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *CancelScheduledThreadRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetQuotesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId        uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
+	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	ExcludeUserIds  []uint32               `protobuf:"varint,5,rep,packed,name=exclude_user_ids,json=excludeUserIds,proto3" json:"exclude_user_ids,omitempty"`
+	Cursor          string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetQuotesRequest) Reset() {
+	*x = GetQuotesRequest{}
+	mi := &file_proto_thread_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotesRequest) ProtoMessage() {}
+
+func (x *GetQuotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotesRequest.ProtoReflect.Descriptor instead.
+func (*GetQuotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetQuotesRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *GetQuotesRequest) GetRequesterUserId() uint32 {
+	if x != nil && x.RequesterUserId != nil {
+		return *x.RequesterUserId
+	}
+	return 0
+}
+
+func (x *GetQuotesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetQuotesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetQuotesRequest) GetExcludeUserIds() []uint32 {
+	if x != nil {
+		return x.ExcludeUserIds
+	}
+	return nil
+}
+
+func (x *GetQuotesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetQuotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotesResponse) Reset() {
+	*x = GetQuotesResponse{}
+	mi := &file_proto_thread_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotesResponse) ProtoMessage() {}
+
+func (x *GetQuotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotesResponse.ProtoReflect.Descriptor instead.
+func (*GetQuotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetQuotesResponse) GetThreads() []*Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *GetQuotesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetQuotesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type EditThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	MediaIds      []uint32               `protobuf:"varint,4,rep,packed,name=media_ids,json=mediaIds,proto3" json:"media_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditThreadRequest) Reset() {
+	*x = EditThreadRequest{}
+	mi := &file_proto_thread_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditThreadRequest) ProtoMessage() {}
+
+func (x *EditThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditThreadRequest.ProtoReflect.Descriptor instead.
+func (*EditThreadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EditThreadRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *EditThreadRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EditThreadRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *EditThreadRequest) GetMediaIds() []uint32 {
+	if x != nil {
+		return x.MediaIds
+	}
+	return nil
+}
+
+type ThreadRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ThreadId      uint32                 `protobuf:"varint,2,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	MediaIds      []uint32               `protobuf:"varint,4,rep,packed,name=media_ids,json=mediaIds,proto3" json:"media_ids,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // when this version was replaced
+	IsMasked      bool                   `protobuf:"varint,6,opt,name=is_masked,json=isMasked,proto3" json:"is_masked,omitempty"`   // withheld like the thread's own is_masked; the viewer hasn't opted in
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThreadRevision) Reset() {
+	*x = ThreadRevision{}
+	mi := &file_proto_thread_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThreadRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadRevision) ProtoMessage() {}
+
+func (x *ThreadRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadRevision.ProtoReflect.Descriptor instead.
+func (*ThreadRevision) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ThreadRevision) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ThreadRevision) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *ThreadRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ThreadRevision) GetMediaIds() []uint32 {
+	if x != nil {
+		return x.MediaIds
+	}
+	return nil
+}
+
+func (x *ThreadRevision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ThreadRevision) GetIsMasked() bool {
+	if x != nil {
+		return x.IsMasked
+	}
+	return false
+}
+
+type GetThreadRevisionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId        uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetThreadRevisionsRequest) Reset() {
+	*x = GetThreadRevisionsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadRevisionsRequest) ProtoMessage() {}
+
+func (x *GetThreadRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*GetThreadRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetThreadRevisionsRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *GetThreadRevisionsRequest) GetRequesterUserId() uint32 {
+	if x != nil && x.RequesterUserId != nil {
+		return *x.RequesterUserId
+	}
+	return 0
+}
+
+type GetThreadRevisionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*ThreadRevision      `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadRevisionsResponse) Reset() {
+	*x = GetThreadRevisionsResponse{}
+	mi := &file_proto_thread_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadRevisionsResponse) ProtoMessage() {}
+
+func (x *GetThreadRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*GetThreadRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetThreadRevisionsResponse) GetRevisions() []*ThreadRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type GetConversationRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId        uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
+	MaxDepth        int32                  `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"` // levels of replies below thread_id; defaulted and capped by the server
+	FanOut          int32                  `protobuf:"varint,4,opt,name=fan_out,json=fanOut,proto3" json:"fan_out,omitempty"`       // replies returned per parent; defaulted and capped by the server
+	ExcludeUserIds  []uint32               `protobuf:"varint,5,rep,packed,name=exclude_user_ids,json=excludeUserIds,proto3" json:"exclude_user_ids,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetConversationRequest) Reset() {
+	*x = GetConversationRequest{}
+	mi := &file_proto_thread_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationRequest) ProtoMessage() {}
+
+func (x *GetConversationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationRequest.ProtoReflect.Descriptor instead.
+func (*GetConversationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetConversationRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *GetConversationRequest) GetRequesterUserId() uint32 {
+	if x != nil && x.RequesterUserId != nil {
+		return *x.RequesterUserId
+	}
+	return 0
+}
+
+func (x *GetConversationRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *GetConversationRequest) GetFanOut() int32 {
+	if x != nil {
+		return x.FanOut
+	}
+	return 0
+}
+
+func (x *GetConversationRequest) GetExcludeUserIds() []uint32 {
+	if x != nil {
+		return x.ExcludeUserIds
+	}
+	return nil
+}
+
+type ConversationNode struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Thread            *Thread                `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Replies           []*ConversationNode    `protobuf:"bytes,2,rep,name=replies,proto3" json:"replies,omitempty"`                                                // oldest first
+	MoreRepliesCursor string                 `protobuf:"bytes,3,opt,name=more_replies_cursor,json=moreRepliesCursor,proto3" json:"more_replies_cursor,omitempty"` // set when thread has more direct replies; pass to GetReplies as cursor
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ConversationNode) Reset() {
+	*x = ConversationNode{}
+	mi := &file_proto_thread_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConversationNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversationNode) ProtoMessage() {}
+
+func (x *ConversationNode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConversationNode.ProtoReflect.Descriptor instead.
+func (*ConversationNode) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ConversationNode) GetThread() *Thread {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+func (x *ConversationNode) GetReplies() []*ConversationNode {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *ConversationNode) GetMoreRepliesCursor() string {
+	if x != nil {
+		return x.MoreRepliesCursor
+	}
+	return ""
+}
+
+type GetConversationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ancestors     []*Thread              `protobuf:"bytes,1,rep,name=ancestors,proto3" json:"ancestors,omitempty"` // root first, ending with the parent of thread_id
+	Focus         *ConversationNode      `protobuf:"bytes,2,opt,name=focus,proto3" json:"focus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConversationResponse) Reset() {
+	*x = GetConversationResponse{}
+	mi := &file_proto_thread_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConversationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationResponse) ProtoMessage() {}
+
+func (x *GetConversationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationResponse.ProtoReflect.Descriptor instead.
+func (*GetConversationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetConversationResponse) GetAncestors() []*Thread {
+	if x != nil {
+		return x.Ancestors
+	}
+	return nil
+}
+
+func (x *GetConversationResponse) GetFocus() *ConversationNode {
+	if x != nil {
+		return x.Focus
+	}
+	return nil
+}
+
+type PollOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	VoteCount     int32                  `protobuf:"varint,3,opt,name=vote_count,json=voteCount,proto3" json:"vote_count,omitempty"` // 0 until results_visible
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PollOption) Reset() {
+	*x = PollOption{}
+	mi := &file_proto_thread_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PollOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollOption) ProtoMessage() {}
+
+func (x *PollOption) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollOption.ProtoReflect.Descriptor instead.
+func (*PollOption) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PollOption) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PollOption) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PollOption) GetVoteCount() int32 {
+	if x != nil {
+		return x.VoteCount
+	}
+	return 0
+}
+
+type Poll struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Options        []*PollOption          `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	ClosesAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`
+	IsClosed       bool                   `protobuf:"varint,4,opt,name=is_closed,json=isClosed,proto3" json:"is_closed,omitempty"`
+	ResultsVisible bool                   `protobuf:"varint,5,opt,name=results_visible,json=resultsVisible,proto3" json:"results_visible,omitempty"` // true once the viewer has voted or the poll has closed
+	VotedOptionId  *uint32                `protobuf:"varint,6,opt,name=voted_option_id,json=votedOptionId,proto3,oneof" json:"voted_option_id,omitempty"`
+	TotalVotes     int32                  `protobuf:"varint,7,opt,name=total_votes,json=totalVotes,proto3" json:"total_votes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Poll) Reset() {
+	*x = Poll{}
+	mi := &file_proto_thread_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Poll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Poll) ProtoMessage() {}
+
+func (x *Poll) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Poll.ProtoReflect.Descriptor instead.
+func (*Poll) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Poll) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Poll) GetOptions() []*PollOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Poll) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+func (x *Poll) GetIsClosed() bool {
+	if x != nil {
+		return x.IsClosed
+	}
+	return false
+}
+
+func (x *Poll) GetResultsVisible() bool {
+	if x != nil {
+		return x.ResultsVisible
+	}
+	return false
+}
+
+func (x *Poll) GetVotedOptionId() uint32 {
+	if x != nil && x.VotedOptionId != nil {
+		return *x.VotedOptionId
+	}
+	return 0
+}
+
+func (x *Poll) GetTotalVotes() int32 {
+	if x != nil {
+		return x.TotalVotes
+	}
+	return 0
+}
+
+type VotePollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OptionId      uint32                 `protobuf:"varint,3,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VotePollRequest) Reset() {
+	*x = VotePollRequest{}
+	mi := &file_proto_thread_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VotePollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotePollRequest) ProtoMessage() {}
+
+func (x *VotePollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VotePollRequest.ProtoReflect.Descriptor instead.
+func (*VotePollRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VotePollRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *VotePollRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VotePollRequest) GetOptionId() uint32 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+type GetPollResultsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId        uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPollResultsRequest) Reset() {
+	*x = GetPollResultsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPollResultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPollResultsRequest) ProtoMessage() {}
+
+func (x *GetPollResultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPollResultsRequest.ProtoReflect.Descriptor instead.
+func (*GetPollResultsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetPollResultsRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *GetPollResultsRequest) GetRequesterUserId() uint32 {
+	if x != nil && x.RequesterUserId != nil {
+		return *x.RequesterUserId
+	}
+	return 0
+}
+
+type GetThreadsByHashtagRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Hashtag         string                 `protobuf:"bytes,1,opt,name=hashtag,proto3" json:"hashtag,omitempty"` // with or without the leading '#'
+	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
+	SortType        string                 `protobuf:"bytes,3,opt,name=sort_type,json=sortType,proto3" json:"sort_type,omitempty"` // "latest" (default) or "top"
+	Page            int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	ExcludeUserIds  []uint32               `protobuf:"varint,6,rep,packed,name=exclude_user_ids,json=excludeUserIds,proto3" json:"exclude_user_ids,omitempty"`
+	Cursor          string                 `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetThreadsByHashtagRequest) Reset() {
+	*x = GetThreadsByHashtagRequest{}
+	mi := &file_proto_thread_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadsByHashtagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadsByHashtagRequest) ProtoMessage() {}
+
+func (x *GetThreadsByHashtagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadsByHashtagRequest.ProtoReflect.Descriptor instead.
+func (*GetThreadsByHashtagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetThreadsByHashtagRequest) GetHashtag() string {
+	if x != nil {
+		return x.Hashtag
+	}
+	return ""
+}
+
+func (x *GetThreadsByHashtagRequest) GetRequesterUserId() uint32 {
+	if x != nil && x.RequesterUserId != nil {
+		return *x.RequesterUserId
+	}
+	return 0
+}
+
+func (x *GetThreadsByHashtagRequest) GetSortType() string {
+	if x != nil {
+		return x.SortType
+	}
+	return ""
+}
+
+func (x *GetThreadsByHashtagRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetThreadsByHashtagRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetThreadsByHashtagRequest) GetExcludeUserIds() []uint32 {
+	if x != nil {
+		return x.ExcludeUserIds
+	}
+	return nil
+}
+
+func (x *GetThreadsByHashtagRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetThreadsByHashtagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadsByHashtagResponse) Reset() {
+	*x = GetThreadsByHashtagResponse{}
+	mi := &file_proto_thread_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadsByHashtagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadsByHashtagResponse) ProtoMessage() {}
+
+func (x *GetThreadsByHashtagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadsByHashtagResponse.ProtoReflect.Descriptor instead.
+func (*GetThreadsByHashtagResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetThreadsByHashtagResponse) GetThreads() []*Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *GetThreadsByHashtagResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetThreadsByHashtagResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetHashtagStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hashtag       string                 `protobuf:"bytes,1,opt,name=hashtag,proto3" json:"hashtag,omitempty"`
+	RelatedLimit  int32                  `protobuf:"varint,2,opt,name=related_limit,json=relatedLimit,proto3" json:"related_limit,omitempty"` // default 10, max 30
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHashtagStatsRequest) Reset() {
+	*x = GetHashtagStatsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHashtagStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHashtagStatsRequest) ProtoMessage() {}
+
+func (x *GetHashtagStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHashtagStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetHashtagStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetHashtagStatsRequest) GetHashtag() string {
+	if x != nil {
+		return x.Hashtag
+	}
+	return ""
+}
+
+func (x *GetHashtagStatsRequest) GetRelatedLimit() int32 {
+	if x != nil {
+		return x.RelatedLimit
+	}
+	return 0
+}
+
+type RelatedHashtag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	CoOccurrences int64                  `protobuf:"varint,2,opt,name=co_occurrences,json=coOccurrences,proto3" json:"co_occurrences,omitempty"` // published threads carrying both tags
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelatedHashtag) Reset() {
+	*x = RelatedHashtag{}
+	mi := &file_proto_thread_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedHashtag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedHashtag) ProtoMessage() {}
+
+func (x *RelatedHashtag) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedHashtag.ProtoReflect.Descriptor instead.
+func (*RelatedHashtag) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RelatedHashtag) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *RelatedHashtag) GetCoOccurrences() int64 {
+	if x != nil {
+		return x.CoOccurrences
+	}
+	return 0
+}
+
+type GetHashtagStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hashtag       string                 `protobuf:"bytes,1,opt,name=hashtag,proto3" json:"hashtag,omitempty"` // normalized, without '#'
+	ThreadCount   int64                  `protobuf:"varint,2,opt,name=thread_count,json=threadCount,proto3" json:"thread_count,omitempty"`
+	Related       []*RelatedHashtag      `protobuf:"bytes,3,rep,name=related,proto3" json:"related,omitempty"` // most frequent first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHashtagStatsResponse) Reset() {
+	*x = GetHashtagStatsResponse{}
+	mi := &file_proto_thread_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHashtagStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHashtagStatsResponse) ProtoMessage() {}
+
+func (x *GetHashtagStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHashtagStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetHashtagStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetHashtagStatsResponse) GetHashtag() string {
+	if x != nil {
+		return x.Hashtag
+	}
+	return ""
+}
+
+func (x *GetHashtagStatsResponse) GetThreadCount() int64 {
+	if x != nil {
+		return x.ThreadCount
+	}
+	return 0
+}
+
+func (x *GetHashtagStatsResponse) GetRelated() []*RelatedHashtag {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+type GetMentionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // the mentioned user
+	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
+	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	ExcludeUserIds  []uint32               `protobuf:"varint,5,rep,packed,name=exclude_user_ids,json=excludeUserIds,proto3" json:"exclude_user_ids,omitempty"`
+	Cursor          string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetMentionsRequest) Reset() {
+	*x = GetMentionsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMentionsRequest) ProtoMessage() {}
+
+func (x *GetMentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMentionsRequest.ProtoReflect.Descriptor instead.
+func (*GetMentionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetMentionsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetMentionsRequest) GetRequesterUserId() uint32 {
+	if x != nil && x.RequesterUserId != nil {
+		return *x.RequesterUserId
+	}
+	return 0
+}
+
+func (x *GetMentionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetMentionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetMentionsRequest) GetExcludeUserIds() []uint32 {
+	if x != nil {
+		return x.ExcludeUserIds
+	}
+	return nil
+}
+
+func (x *GetMentionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetMentionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMentionsResponse) Reset() {
+	*x = GetMentionsResponse{}
+	mi := &file_proto_thread_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMentionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMentionsResponse) ProtoMessage() {}
+
+func (x *GetMentionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMentionsResponse.ProtoReflect.Descriptor instead.
+func (*GetMentionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetMentionsResponse) GetThreads() []*Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *GetMentionsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetMentionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetThreadsByCategoryRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Category        string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // world, sports, business or sci_tech
+	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
+	SortType        string                 `protobuf:"bytes,3,opt,name=sort_type,json=sortType,proto3" json:"sort_type,omitempty"` // "latest" (default) or "top"
+	Page            int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	ExcludeUserIds  []uint32               `protobuf:"varint,6,rep,packed,name=exclude_user_ids,json=excludeUserIds,proto3" json:"exclude_user_ids,omitempty"`
+	Cursor          string                 `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	WindowHours     int32                  `protobuf:"varint,8,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"` // "top" only: how far back to look, default 168, max 720
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetThreadsByCategoryRequest) Reset() {
+	*x = GetThreadsByCategoryRequest{}
+	mi := &file_proto_thread_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadsByCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadsByCategoryRequest) ProtoMessage() {}
+
+func (x *GetThreadsByCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadsByCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetThreadsByCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetThreadsByCategoryRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *GetThreadsByCategoryRequest) GetRequesterUserId() uint32 {
+	if x != nil && x.RequesterUserId != nil {
+		return *x.RequesterUserId
+	}
+	return 0
+}
+
+func (x *GetThreadsByCategoryRequest) GetSortType() string {
+	if x != nil {
+		return x.SortType
+	}
+	return ""
+}
+
+func (x *GetThreadsByCategoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetThreadsByCategoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetThreadsByCategoryRequest) GetExcludeUserIds() []uint32 {
+	if x != nil {
+		return x.ExcludeUserIds
+	}
+	return nil
+}
+
+func (x *GetThreadsByCategoryRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetThreadsByCategoryRequest) GetWindowHours() int32 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+type GetThreadsByCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadsByCategoryResponse) Reset() {
+	*x = GetThreadsByCategoryResponse{}
+	mi := &file_proto_thread_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadsByCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadsByCategoryResponse) ProtoMessage() {}
+
+func (x *GetThreadsByCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadsByCategoryResponse.ProtoReflect.Descriptor instead.
+func (*GetThreadsByCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetThreadsByCategoryResponse) GetThreads() []*Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *GetThreadsByCategoryResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetThreadsByCategoryResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetCategoryStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WindowHours   int32                  `protobuf:"varint,1,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"` // default 24, max 720
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryStatsRequest) Reset() {
+	*x = GetCategoryStatsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryStatsRequest) ProtoMessage() {}
+
+func (x *GetCategoryStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetCategoryStatsRequest) GetWindowHours() int32 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+type CategoryStat struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Category          string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	PostCount         int64                  `protobuf:"varint,2,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`                           // published in the window
+	PreviousPostCount int64                  `protobuf:"varint,3,opt,name=previous_post_count,json=previousPostCount,proto3" json:"previous_post_count,omitempty"` // published in the window before it
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CategoryStat) Reset() {
+	*x = CategoryStat{}
+	mi := &file_proto_thread_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryStat) ProtoMessage() {}
+
+func (x *CategoryStat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryStat.ProtoReflect.Descriptor instead.
+func (*CategoryStat) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CategoryStat) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategoryStat) GetPostCount() int64 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+func (x *CategoryStat) GetPreviousPostCount() int64 {
+	if x != nil {
+		return x.PreviousPostCount
+	}
+	return 0
+}
+
+type GetCategoryStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*CategoryStat        `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"` // every category, busiest first
+	WindowHours   int32                  `protobuf:"varint,2,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryStatsResponse) Reset() {
+	*x = GetCategoryStatsResponse{}
+	mi := &file_proto_thread_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryStatsResponse) ProtoMessage() {}
+
+func (x *GetCategoryStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetCategoryStatsResponse) GetStats() []*CategoryStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *GetCategoryStatsResponse) GetWindowHours() int32 {
+	if x != nil {
+		return x.WindowHours
+	}
+	return 0
+}
+
+type CreatePromotedThreadRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Thread                  *CreateThreadRequest   `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"` // published immediately; no replies, community threads or scheduling
+	BudgetCents             int64                  `protobuf:"varint,2,opt,name=budget_cents,json=budgetCents,proto3" json:"budget_cents,omitempty"`
+	StartsAt                *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"` // defaults to now
+	EndsAt                  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	TargetCategories        []string               `protobuf:"bytes,5,rep,name=target_categories,json=targetCategories,proto3" json:"target_categories,omitempty"`                                    // viewers who liked threads in one of these
+	TargetFollowerOfUserIds []uint32               `protobuf:"varint,6,rep,packed,name=target_follower_of_user_ids,json=targetFollowerOfUserIds,proto3" json:"target_follower_of_user_ids,omitempty"` // viewers who follow one of these accounts
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *CreatePromotedThreadRequest) Reset() {
+	*x = CreatePromotedThreadRequest{}
+	mi := &file_proto_thread_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromotedThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotedThreadRequest) ProtoMessage() {}
+
+func (x *CreatePromotedThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotedThreadRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromotedThreadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreatePromotedThreadRequest) GetThread() *CreateThreadRequest {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+func (x *CreatePromotedThreadRequest) GetBudgetCents() int64 {
+	if x != nil {
+		return x.BudgetCents
+	}
+	return 0
+}
+
+func (x *CreatePromotedThreadRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreatePromotedThreadRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CreatePromotedThreadRequest) GetTargetCategories() []string {
+	if x != nil {
+		return x.TargetCategories
+	}
+	return nil
+}
+
+func (x *CreatePromotedThreadRequest) GetTargetFollowerOfUserIds() []uint32 {
+	if x != nil {
+		return x.TargetFollowerOfUserIds
+	}
+	return nil
+}
+
+type AdCampaign struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ThreadId                uint32                 `protobuf:"varint,2,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	AdvertiserId            uint32                 `protobuf:"varint,3,opt,name=advertiser_id,json=advertiserId,proto3" json:"advertiser_id,omitempty"`
+	BudgetCents             int64                  `protobuf:"varint,4,opt,name=budget_cents,json=budgetCents,proto3" json:"budget_cents,omitempty"`
+	SpentCents              int64                  `protobuf:"varint,5,opt,name=spent_cents,json=spentCents,proto3" json:"spent_cents,omitempty"`
+	StartsAt                *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt                  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	TargetCategories        []string               `protobuf:"bytes,8,rep,name=target_categories,json=targetCategories,proto3" json:"target_categories,omitempty"`
+	TargetFollowerOfUserIds []uint32               `protobuf:"varint,9,rep,packed,name=target_follower_of_user_ids,json=targetFollowerOfUserIds,proto3" json:"target_follower_of_user_ids,omitempty"`
+	Impressions             int64                  `protobuf:"varint,10,opt,name=impressions,proto3" json:"impressions,omitempty"`
+	Clicks                  int64                  `protobuf:"varint,11,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	Thread                  *Thread                `protobuf:"bytes,12,opt,name=thread,proto3" json:"thread,omitempty"` // missing if the thread was deleted
+	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *AdCampaign) Reset() {
+	*x = AdCampaign{}
+	mi := &file_proto_thread_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdCampaign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdCampaign) ProtoMessage() {}
+
+func (x *AdCampaign) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdCampaign.ProtoReflect.Descriptor instead.
+func (*AdCampaign) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AdCampaign) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdCampaign) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *AdCampaign) GetAdvertiserId() uint32 {
+	if x != nil {
+		return x.AdvertiserId
+	}
+	return 0
+}
+
+func (x *AdCampaign) GetBudgetCents() int64 {
+	if x != nil {
+		return x.BudgetCents
+	}
+	return 0
+}
+
+func (x *AdCampaign) GetSpentCents() int64 {
+	if x != nil {
+		return x.SpentCents
+	}
+	return 0
+}
+
+func (x *AdCampaign) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *AdCampaign) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *AdCampaign) GetTargetCategories() []string {
+	if x != nil {
+		return x.TargetCategories
+	}
+	return nil
+}
+
+func (x *AdCampaign) GetTargetFollowerOfUserIds() []uint32 {
+	if x != nil {
+		return x.TargetFollowerOfUserIds
+	}
+	return nil
+}
+
+func (x *AdCampaign) GetImpressions() int64 {
+	if x != nil {
+		return x.Impressions
+	}
+	return 0
+}
+
+func (x *AdCampaign) GetClicks() int64 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+func (x *AdCampaign) GetThread() *Thread {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+func (x *AdCampaign) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetAdCampaignsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdvertiserId  uint32                 `protobuf:"varint,1,opt,name=advertiser_id,json=advertiserId,proto3" json:"advertiser_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdCampaignsRequest) Reset() {
+	*x = GetAdCampaignsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdCampaignsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdCampaignsRequest) ProtoMessage() {}
+
+func (x *GetAdCampaignsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdCampaignsRequest.ProtoReflect.Descriptor instead.
+func (*GetAdCampaignsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetAdCampaignsRequest) GetAdvertiserId() uint32 {
+	if x != nil {
+		return x.AdvertiserId
+	}
+	return 0
+}
+
+type GetAdCampaignsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaigns     []*AdCampaign          `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdCampaignsResponse) Reset() {
+	*x = GetAdCampaignsResponse{}
+	mi := &file_proto_thread_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdCampaignsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdCampaignsResponse) ProtoMessage() {}
+
+func (x *GetAdCampaignsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdCampaignsResponse.ProtoReflect.Descriptor instead.
+func (*GetAdCampaignsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetAdCampaignsResponse) GetCampaigns() []*AdCampaign {
+	if x != nil {
+		return x.Campaigns
+	}
+	return nil
+}
+
+type RecordAdClickRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    uint32                 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // the session the ad was served in
+	UserId        *uint32                `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordAdClickRequest) Reset() {
+	*x = RecordAdClickRequest{}
+	mi := &file_proto_thread_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordAdClickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAdClickRequest) ProtoMessage() {}
+
+func (x *RecordAdClickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAdClickRequest.ProtoReflect.Descriptor instead.
+func (*RecordAdClickRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RecordAdClickRequest) GetCampaignId() uint32 {
+	if x != nil {
+		return x.CampaignId
+	}
+	return 0
+}
+
+func (x *RecordAdClickRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RecordAdClickRequest) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+// FilterMutedThreads drops threads the viewer muted in a scope, for lists built outside thread-service such as search results.
+type FilterMutedThreadsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ViewerId      uint32                 `protobuf:"varint,1,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	Scope         string                 `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"` // a mute scope, e.g. "search"
+	ThreadIds     []uint32               `protobuf:"varint,3,rep,packed,name=thread_ids,json=threadIds,proto3" json:"thread_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterMutedThreadsRequest) Reset() {
+	*x = FilterMutedThreadsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterMutedThreadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterMutedThreadsRequest) ProtoMessage() {}
+
+func (x *FilterMutedThreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterMutedThreadsRequest.ProtoReflect.Descriptor instead.
+func (*FilterMutedThreadsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *FilterMutedThreadsRequest) GetViewerId() uint32 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *FilterMutedThreadsRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *FilterMutedThreadsRequest) GetThreadIds() []uint32 {
+	if x != nil {
+		return x.ThreadIds
+	}
+	return nil
+}
+
+type FilterMutedThreadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadIds     []uint32               `protobuf:"varint,1,rep,packed,name=thread_ids,json=threadIds,proto3" json:"thread_ids,omitempty"` // kept threads, in request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterMutedThreadsResponse) Reset() {
+	*x = FilterMutedThreadsResponse{}
+	mi := &file_proto_thread_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterMutedThreadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterMutedThreadsResponse) ProtoMessage() {}
+
+func (x *FilterMutedThreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterMutedThreadsResponse.ProtoReflect.Descriptor instead.
+func (*FilterMutedThreadsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *FilterMutedThreadsResponse) GetThreadIds() []uint32 {
+	if x != nil {
+		return x.ThreadIds
+	}
+	return nil
+}
+
+// The list's members come from user-service, which hides other people's private lists.
+type GetListThreadsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ListId          uint32                 `protobuf:"varint,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
+	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	ExcludeUserIds  []uint32               `protobuf:"varint,5,rep,packed,name=exclude_user_ids,json=excludeUserIds,proto3" json:"exclude_user_ids,omitempty"`
+	Cursor          string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetListThreadsRequest) Reset() {
+	*x = GetListThreadsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListThreadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListThreadsRequest) ProtoMessage() {}
+
+func (x *GetListThreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListThreadsRequest.ProtoReflect.Descriptor instead.
+func (*GetListThreadsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetListThreadsRequest) GetListId() uint32 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *GetListThreadsRequest) GetRequesterUserId() uint32 {
+	if x != nil && x.RequesterUserId != nil {
+		return *x.RequesterUserId
+	}
+	return 0
+}
+
+func (x *GetListThreadsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetListThreadsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetListThreadsRequest) GetExcludeUserIds() []uint32 {
+	if x != nil {
+		return x.ExcludeUserIds
+	}
+	return nil
+}
+
+func (x *GetListThreadsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetListThreadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListThreadsResponse) Reset() {
+	*x = GetListThreadsResponse{}
+	mi := &file_proto_thread_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListThreadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListThreadsResponse) ProtoMessage() {}
+
+func (x *GetListThreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListThreadsResponse.ProtoReflect.Descriptor instead.
+func (*GetListThreadsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetListThreadsResponse) GetThreads() []*Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *GetListThreadsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *GetListThreadsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ReplyVisibilityRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReplyThreadId   uint32                 `protobuf:"varint,1,opt,name=reply_thread_id,json=replyThreadId,proto3" json:"reply_thread_id,omitempty"`
+	RequesterUserId uint32                 `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3" json:"requester_user_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReplyVisibilityRequest) Reset() {
+	*x = ReplyVisibilityRequest{}
+	mi := &file_proto_thread_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyVisibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyVisibilityRequest) ProtoMessage() {}
+
+func (x *ReplyVisibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyVisibilityRequest.ProtoReflect.Descriptor instead.
+func (*ReplyVisibilityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReplyVisibilityRequest) GetReplyThreadId() uint32 {
+	if x != nil {
+		return x.ReplyThreadId
+	}
+	return 0
+}
+
+func (x *ReplyVisibilityRequest) GetRequesterUserId() uint32 {
+	if x != nil {
+		return x.RequesterUserId
+	}
+	return 0
+}
+
+type RemoveMentionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // the mentioned user removing themselves
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMentionRequest) Reset() {
+	*x = RemoveMentionRequest{}
+	mi := &file_proto_thread_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMentionRequest) ProtoMessage() {}
+
+func (x *RemoveMentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMentionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMentionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RemoveMentionRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *RemoveMentionRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UpdateReplyRestrictionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId         uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	UserId           uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReplyRestriction ReplyRestriction       `protobuf:"varint,3,opt,name=reply_restriction,json=replyRestriction,proto3,enum=thread.ReplyRestriction" json:"reply_restriction,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateReplyRestrictionRequest) Reset() {
+	*x = UpdateReplyRestrictionRequest{}
+	mi := &file_proto_thread_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReplyRestrictionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReplyRestrictionRequest) ProtoMessage() {}
+
+func (x *UpdateReplyRestrictionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReplyRestrictionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReplyRestrictionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateReplyRestrictionRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *UpdateReplyRestrictionRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateReplyRestrictionRequest) GetReplyRestriction() ReplyRestriction {
+	if x != nil {
+		return x.ReplyRestriction
+	}
+	return ReplyRestriction_REPLY_RESTRICTION_UNSPECIFIED
+}
+
+type ModerationAction struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ThreadId       uint32                 `protobuf:"varint,2,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	ActorId        uint32                 `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Action         string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"` // hide_reply, unhide_reply, remove_mention, change_reply_restriction, mark_sensitive, unmark_sensitive
+	TargetThreadId *uint32                `protobuf:"varint,5,opt,name=target_thread_id,json=targetThreadId,proto3,oneof" json:"target_thread_id,omitempty"`
+	TargetUserId   *uint32                `protobuf:"varint,6,opt,name=target_user_id,json=targetUserId,proto3,oneof" json:"target_user_id,omitempty"`
+	Detail         string                 `protobuf:"bytes,7,opt,name=detail,proto3" json:"detail,omitempty"` // e.g. "everyone -> following"
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ModerationAction) Reset() {
+	*x = ModerationAction{}
+	mi := &file_proto_thread_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationAction) ProtoMessage() {}
+
+func (x *ModerationAction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationAction.ProtoReflect.Descriptor instead.
+func (*ModerationAction) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ModerationAction) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerationAction) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *ModerationAction) GetActorId() uint32 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *ModerationAction) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetTargetThreadId() uint32 {
+	if x != nil && x.TargetThreadId != nil {
+		return *x.TargetThreadId
+	}
+	return 0
+}
+
+func (x *ModerationAction) GetTargetUserId() uint32 {
+	if x != nil && x.TargetUserId != nil {
+		return *x.TargetUserId
+	}
+	return 0
+}
+
+func (x *ModerationAction) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *ModerationAction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetModerationLogRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId        uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	RequesterUserId uint32                 `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3" json:"requester_user_id,omitempty"`
+	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetModerationLogRequest) Reset() {
+	*x = GetModerationLogRequest{}
+	mi := &file_proto_thread_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModerationLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationLogRequest) ProtoMessage() {}
+
+func (x *GetModerationLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationLogRequest.ProtoReflect.Descriptor instead.
+func (*GetModerationLogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetModerationLogRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *GetModerationLogRequest) GetRequesterUserId() uint32 {
+	if x != nil {
+		return x.RequesterUserId
+	}
+	return 0
+}
+
+func (x *GetModerationLogRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetModerationLogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetModerationLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actions       []*ModerationAction    `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModerationLogResponse) Reset() {
+	*x = GetModerationLogResponse{}
+	mi := &file_proto_thread_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModerationLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationLogResponse) ProtoMessage() {}
+
+func (x *GetModerationLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationLogResponse.ProtoReflect.Descriptor instead.
+func (*GetModerationLogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetModerationLogResponse) GetActions() []*ModerationAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *GetModerationLogResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type ThreadViewEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	ViewerId      uint32                 `protobuf:"varint,2,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`   // 0 for signed-out viewers
+	ViewerKey     string                 `protobuf:"bytes,3,opt,name=viewer_key,json=viewerKey,proto3" json:"viewer_key,omitempty"` // "u:<id>" or a hashed client key; one viewer counts once per thread, kind and hour
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`                            // impression, detail_view, profile_click
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThreadViewEvent) Reset() {
+	*x = ThreadViewEvent{}
+	mi := &file_proto_thread_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThreadViewEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadViewEvent) ProtoMessage() {}
+
+func (x *ThreadViewEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadViewEvent.ProtoReflect.Descriptor instead.
+func (*ThreadViewEvent) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ThreadViewEvent) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *ThreadViewEvent) GetViewerId() uint32 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+func (x *ThreadViewEvent) GetViewerKey() string {
+	if x != nil {
+		return x.ViewerKey
+	}
+	return ""
+}
+
+func (x *ThreadViewEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ThreadViewEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type RecordThreadEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*ThreadViewEvent     `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordThreadEventsRequest) Reset() {
+	*x = RecordThreadEventsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordThreadEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordThreadEventsRequest) ProtoMessage() {}
+
+func (x *RecordThreadEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordThreadEventsRequest.ProtoReflect.Descriptor instead.
+func (*RecordThreadEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RecordThreadEventsRequest) GetEvents() []*ThreadViewEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GetThreadAnalyticsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId        uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	RequesterUserId uint32                 `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3" json:"requester_user_id,omitempty"`
+	Hours           int32                  `protobuf:"varint,3,opt,name=hours,proto3" json:"hours,omitempty"` // length of the hourly series, default 168
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetThreadAnalyticsRequest) Reset() {
+	*x = GetThreadAnalyticsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetThreadAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetThreadAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetThreadAnalyticsRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *GetThreadAnalyticsRequest) GetRequesterUserId() uint32 {
+	if x != nil {
+		return x.RequesterUserId
+	}
+	return 0
+}
+
+func (x *GetThreadAnalyticsRequest) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+type ThreadAnalyticsBucket struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Hour                   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=hour,proto3" json:"hour,omitempty"`
+	Impressions            int64                  `protobuf:"varint,2,opt,name=impressions,proto3" json:"impressions,omitempty"`
+	FollowerImpressions    int64                  `protobuf:"varint,3,opt,name=follower_impressions,json=followerImpressions,proto3" json:"follower_impressions,omitempty"`
+	NonFollowerImpressions int64                  `protobuf:"varint,4,opt,name=non_follower_impressions,json=nonFollowerImpressions,proto3" json:"non_follower_impressions,omitempty"`
+	DetailViews            int64                  `protobuf:"varint,5,opt,name=detail_views,json=detailViews,proto3" json:"detail_views,omitempty"`
+	ProfileClicks          int64                  `protobuf:"varint,6,opt,name=profile_clicks,json=profileClicks,proto3" json:"profile_clicks,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ThreadAnalyticsBucket) Reset() {
+	*x = ThreadAnalyticsBucket{}
+	mi := &file_proto_thread_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThreadAnalyticsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadAnalyticsBucket) ProtoMessage() {}
+
+func (x *ThreadAnalyticsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadAnalyticsBucket.ProtoReflect.Descriptor instead.
+func (*ThreadAnalyticsBucket) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ThreadAnalyticsBucket) GetHour() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Hour
+	}
+	return nil
+}
+
+func (x *ThreadAnalyticsBucket) GetImpressions() int64 {
+	if x != nil {
+		return x.Impressions
+	}
+	return 0
+}
+
+func (x *ThreadAnalyticsBucket) GetFollowerImpressions() int64 {
+	if x != nil {
+		return x.FollowerImpressions
+	}
+	return 0
+}
+
+func (x *ThreadAnalyticsBucket) GetNonFollowerImpressions() int64 {
+	if x != nil {
+		return x.NonFollowerImpressions
+	}
+	return 0
+}
+
+func (x *ThreadAnalyticsBucket) GetDetailViews() int64 {
+	if x != nil {
+		return x.DetailViews
+	}
+	return 0
+}
+
+func (x *ThreadAnalyticsBucket) GetProfileClicks() int64 {
+	if x != nil {
+		return x.ProfileClicks
+	}
+	return 0
+}
+
+type ThreadAnalytics struct {
+	state            protoimpl.MessageState   `protogen:"open.v1"`
+	ThreadId         uint32                   `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	Impressions      int64                    `protobuf:"varint,2,opt,name=impressions,proto3" json:"impressions,omitempty"`                          // lifetime totals
+	FollowerReach    int64                    `protobuf:"varint,3,opt,name=follower_reach,json=followerReach,proto3" json:"follower_reach,omitempty"` // distinct viewers who follow the author
+	NonFollowerReach int64                    `protobuf:"varint,4,opt,name=non_follower_reach,json=nonFollowerReach,proto3" json:"non_follower_reach,omitempty"`
+	DetailViews      int64                    `protobuf:"varint,5,opt,name=detail_views,json=detailViews,proto3" json:"detail_views,omitempty"`
+	ProfileClicks    int64                    `protobuf:"varint,6,opt,name=profile_clicks,json=profileClicks,proto3" json:"profile_clicks,omitempty"`
+	Engagements      int64                    `protobuf:"varint,7,opt,name=engagements,proto3" json:"engagements,omitempty"`                              // likes, replies, reposts, quotes, bookmarks, detail views and profile clicks
+	EngagementRate   float64                  `protobuf:"fixed64,8,opt,name=engagement_rate,json=engagementRate,proto3" json:"engagement_rate,omitempty"` // engagements per impression
+	Buckets          []*ThreadAnalyticsBucket `protobuf:"bytes,9,rep,name=buckets,proto3" json:"buckets,omitempty"`                                       // oldest first, only hours with activity
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ThreadAnalytics) Reset() {
+	*x = ThreadAnalytics{}
+	mi := &file_proto_thread_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThreadAnalytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadAnalytics) ProtoMessage() {}
+
+func (x *ThreadAnalytics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadAnalytics.ProtoReflect.Descriptor instead.
+func (*ThreadAnalytics) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ThreadAnalytics) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *ThreadAnalytics) GetImpressions() int64 {
+	if x != nil {
+		return x.Impressions
+	}
+	return 0
+}
+
+func (x *ThreadAnalytics) GetFollowerReach() int64 {
+	if x != nil {
+		return x.FollowerReach
+	}
+	return 0
+}
+
+func (x *ThreadAnalytics) GetNonFollowerReach() int64 {
+	if x != nil {
+		return x.NonFollowerReach
+	}
+	return 0
+}
+
+func (x *ThreadAnalytics) GetDetailViews() int64 {
+	if x != nil {
+		return x.DetailViews
+	}
+	return 0
+}
+
+func (x *ThreadAnalytics) GetProfileClicks() int64 {
+	if x != nil {
+		return x.ProfileClicks
+	}
+	return 0
+}
+
+func (x *ThreadAnalytics) GetEngagements() int64 {
+	if x != nil {
+		return x.Engagements
+	}
+	return 0
+}
+
+func (x *ThreadAnalytics) GetEngagementRate() float64 {
+	if x != nil {
+		return x.EngagementRate
+	}
+	return 0
+}
+
+func (x *ThreadAnalytics) GetBuckets() []*ThreadAnalyticsBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type ThreadDraft struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content          string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	MediaIds         []uint32               `protobuf:"varint,4,rep,packed,name=media_ids,json=mediaIds,proto3" json:"media_ids,omitempty"`
+	Categories       []string               `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	ParentThreadId   *uint32                `protobuf:"varint,6,opt,name=parent_thread_id,json=parentThreadId,proto3,oneof" json:"parent_thread_id,omitempty"` // set for reply drafts
+	CommunityId      *uint32                `protobuf:"varint,7,opt,name=community_id,json=communityId,proto3,oneof" json:"community_id,omitempty"`
+	ReplyRestriction ReplyRestriction       `protobuf:"varint,8,opt,name=reply_restriction,json=replyRestriction,proto3,enum=thread.ReplyRestriction" json:"reply_restriction,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ContentWarning   string                 `protobuf:"bytes,11,opt,name=content_warning,json=contentWarning,proto3" json:"content_warning,omitempty"`
+	IsSensitive      bool                   `protobuf:"varint,12,opt,name=is_sensitive,json=isSensitive,proto3" json:"is_sensitive,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ThreadDraft) Reset() {
+	*x = ThreadDraft{}
+	mi := &file_proto_thread_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThreadDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreadDraft) ProtoMessage() {}
+
+func (x *ThreadDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreadDraft.ProtoReflect.Descriptor instead.
+func (*ThreadDraft) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ThreadDraft) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ThreadDraft) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ThreadDraft) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
 }
 
-func (x *DeleteThreadRequest) GetThreadId() uint32 {
+func (x *ThreadDraft) GetMediaIds() []uint32 {
 	if x != nil {
-		return x.ThreadId
+		return x.MediaIds
 	}
-	return 0
+	return nil
 }
 
-func (x *DeleteThreadRequest) GetUserId() uint32 {
+func (x *ThreadDraft) GetCategories() []string {
 	if x != nil {
-		return x.UserId
+		return x.Categories
 	}
-	return 0
+	return nil
 }
 
-type InteractThreadRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
-	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ThreadDraft) GetParentThreadId() uint32 {
+	if x != nil && x.ParentThreadId != nil {
+		return *x.ParentThreadId
+	}
+	return 0
 }
 
-func (x *InteractThreadRequest) Reset() {
-	*x = InteractThreadRequest{}
-	mi := &file_proto_thread_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ThreadDraft) GetCommunityId() uint32 {
+	if x != nil && x.CommunityId != nil {
+		return *x.CommunityId
+	}
+	return 0
 }
 
-func (x *InteractThreadRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ThreadDraft) GetReplyRestriction() ReplyRestriction {
+	if x != nil {
+		return x.ReplyRestriction
+	}
+	return ReplyRestriction_REPLY_RESTRICTION_UNSPECIFIED
 }
 
-func (*InteractThreadRequest) ProtoMessage() {}
-
-func (x *InteractThreadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thread_proto_msgTypes[5]
+func (x *ThreadDraft) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use InteractThreadRequest.ProtoReflect.Descriptor instead.
-func (*InteractThreadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thread_proto_rawDescGZIP(), []int{5}
+func (x *ThreadDraft) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
 }
 
-func (x *InteractThreadRequest) GetThreadId() uint32 {
+func (x *ThreadDraft) GetContentWarning() string {
 	if x != nil {
-		return x.ThreadId
+		return x.ContentWarning
 	}
-	return 0
+	return ""
 }
 
-func (x *InteractThreadRequest) GetUserId() uint32 {
+func (x *ThreadDraft) GetIsSensitive() bool {
 	if x != nil {
-		return x.UserId
+		return x.IsSensitive
 	}
-	return 0
+	return false
 }
 
-type GetFeedThreadsRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	CurrentUserId      *uint32                `protobuf:"varint,1,opt,name=current_user_id,json=currentUserId,proto3,oneof" json:"current_user_id,omitempty"`
-	Page               int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit              int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	FeedType           string                 `protobuf:"bytes,4,opt,name=feed_type,json=feedType,proto3" json:"feed_type,omitempty"`                                           // "foryou", "following"
-	ExcludeUserIds     []uint32               `protobuf:"varint,5,rep,packed,name=exclude_user_ids,json=excludeUserIds,proto3" json:"exclude_user_ids,omitempty"`               // for blocked or blocking
-	IncludeOnlyUserIds []uint32               `protobuf:"varint,6,rep,packed,name=include_only_user_ids,json=includeOnlyUserIds,proto3" json:"include_only_user_ids,omitempty"` // for "following"
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// Saving replaces every field of the draft. draft_id is ignored by CreateDraft.
+type SaveDraftRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DraftId          uint32                 `protobuf:"varint,1,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
+	UserId           uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content          string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	MediaIds         []uint32               `protobuf:"varint,4,rep,packed,name=media_ids,json=mediaIds,proto3" json:"media_ids,omitempty"`
+	Categories       []string               `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	ParentThreadId   *uint32                `protobuf:"varint,6,opt,name=parent_thread_id,json=parentThreadId,proto3,oneof" json:"parent_thread_id,omitempty"`
+	CommunityId      *uint32                `protobuf:"varint,7,opt,name=community_id,json=communityId,proto3,oneof" json:"community_id,omitempty"`
+	ReplyRestriction ReplyRestriction       `protobuf:"varint,8,opt,name=reply_restriction,json=replyRestriction,proto3,enum=thread.ReplyRestriction" json:"reply_restriction,omitempty"`
+	ContentWarning   string                 `protobuf:"bytes,9,opt,name=content_warning,json=contentWarning,proto3" json:"content_warning,omitempty"`
+	IsSensitive      bool                   `protobuf:"varint,10,opt,name=is_sensitive,json=isSensitive,proto3" json:"is_sensitive,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetFeedThreadsRequest) Reset() {
-	*x = GetFeedThreadsRequest{}
-	mi := &file_proto_thread_proto_msgTypes[6]
+func (x *SaveDraftRequest) Reset() {
+	*x = SaveDraftRequest{}
+	mi := &file_proto_thread_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFeedThreadsRequest) String() string {
+func (x *SaveDraftRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFeedThreadsRequest) ProtoMessage() {}
+func (*SaveDraftRequest) ProtoMessage() {}
 
-func (x *GetFeedThreadsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thread_proto_msgTypes[6]
+func (x *SaveDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,132 +5145,105 @@ func (x *GetFeedThreadsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFeedThreadsRequest.ProtoReflect.Descriptor instead.
-func (*GetFeedThreadsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thread_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *GetFeedThreadsRequest) GetCurrentUserId() uint32 {
-	if x != nil && x.CurrentUserId != nil {
-		return *x.CurrentUserId
-	}
-	return 0
+// Deprecated: Use SaveDraftRequest.ProtoReflect.Descriptor instead.
+func (*SaveDraftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetFeedThreadsRequest) GetPage() int32 {
+func (x *SaveDraftRequest) GetDraftId() uint32 {
 	if x != nil {
-		return x.Page
+		return x.DraftId
 	}
 	return 0
 }
 
-func (x *GetFeedThreadsRequest) GetLimit() int32 {
+func (x *SaveDraftRequest) GetUserId() uint32 {
 	if x != nil {
-		return x.Limit
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetFeedThreadsRequest) GetFeedType() string {
+func (x *SaveDraftRequest) GetContent() string {
 	if x != nil {
-		return x.FeedType
+		return x.Content
 	}
 	return ""
 }
 
-func (x *GetFeedThreadsRequest) GetExcludeUserIds() []uint32 {
+func (x *SaveDraftRequest) GetMediaIds() []uint32 {
 	if x != nil {
-		return x.ExcludeUserIds
+		return x.MediaIds
 	}
 	return nil
 }
 
-func (x *GetFeedThreadsRequest) GetIncludeOnlyUserIds() []uint32 {
+func (x *SaveDraftRequest) GetCategories() []string {
 	if x != nil {
-		return x.IncludeOnlyUserIds
+		return x.Categories
 	}
 	return nil
 }
 
-type GetFeedThreadsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
-	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetFeedThreadsResponse) Reset() {
-	*x = GetFeedThreadsResponse{}
-	mi := &file_proto_thread_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SaveDraftRequest) GetParentThreadId() uint32 {
+	if x != nil && x.ParentThreadId != nil {
+		return *x.ParentThreadId
+	}
+	return 0
 }
 
-func (x *GetFeedThreadsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SaveDraftRequest) GetCommunityId() uint32 {
+	if x != nil && x.CommunityId != nil {
+		return *x.CommunityId
+	}
+	return 0
 }
 
-func (*GetFeedThreadsResponse) ProtoMessage() {}
-
-func (x *GetFeedThreadsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thread_proto_msgTypes[7]
+func (x *SaveDraftRequest) GetReplyRestriction() ReplyRestriction {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ReplyRestriction
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetFeedThreadsResponse.ProtoReflect.Descriptor instead.
-func (*GetFeedThreadsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thread_proto_rawDescGZIP(), []int{7}
+	return ReplyRestriction_REPLY_RESTRICTION_UNSPECIFIED
 }
 
-func (x *GetFeedThreadsResponse) GetThreads() []*Thread {
+func (x *SaveDraftRequest) GetContentWarning() string {
 	if x != nil {
-		return x.Threads
+		return x.ContentWarning
 	}
-	return nil
+	return ""
 }
 
-func (x *GetFeedThreadsResponse) GetHasMore() bool {
+func (x *SaveDraftRequest) GetIsSensitive() bool {
 	if x != nil {
-		return x.HasMore
+		return x.IsSensitive
 	}
 	return false
 }
 
-type GetUserThreadsRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	TargetUserId    uint32                 `protobuf:"varint,1,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
-	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
-	ThreadType      string                 `protobuf:"bytes,3,opt,name=thread_type,json=threadType,proto3" json:"thread_type,omitempty"` // "posts", "replies", "likes", "media"
-	Page            int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
-	Limit           int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	ExcludeUserIds  []uint32               `protobuf:"varint,6,rep,packed,name=exclude_user_ids,json=excludeUserIds,proto3" json:"exclude_user_ids,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetDraftsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserThreadsRequest) Reset() {
-	*x = GetUserThreadsRequest{}
-	mi := &file_proto_thread_proto_msgTypes[8]
+func (x *GetDraftsRequest) Reset() {
+	*x = GetDraftsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserThreadsRequest) String() string {
+func (x *GetDraftsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserThreadsRequest) ProtoMessage() {}
+func (*GetDraftsRequest) ProtoMessage() {}
 
-func (x *GetUserThreadsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thread_proto_msgTypes[8]
+func (x *GetDraftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,76 +5254,55 @@ func (x *GetUserThreadsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserThreadsRequest.ProtoReflect.Descriptor instead.
-func (*GetUserThreadsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thread_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetDraftsRequest.ProtoReflect.Descriptor instead.
+func (*GetDraftsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetUserThreadsRequest) GetTargetUserId() uint32 {
+func (x *GetDraftsRequest) GetUserId() uint32 {
 	if x != nil {
-		return x.TargetUserId
-	}
-	return 0
-}
-
-func (x *GetUserThreadsRequest) GetRequesterUserId() uint32 {
-	if x != nil && x.RequesterUserId != nil {
-		return *x.RequesterUserId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetUserThreadsRequest) GetThreadType() string {
-	if x != nil {
-		return x.ThreadType
-	}
-	return ""
-}
-
-func (x *GetUserThreadsRequest) GetPage() int32 {
+func (x *GetDraftsRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *GetUserThreadsRequest) GetLimit() int32 {
+func (x *GetDraftsRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-func (x *GetUserThreadsRequest) GetExcludeUserIds() []uint32 {
-	if x != nil {
-		return x.ExcludeUserIds
-	}
-	return nil
-}
-
-type GetUserThreadsResponse struct {
+type GetDraftsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	Drafts        []*ThreadDraft         `protobuf:"bytes,1,rep,name=drafts,proto3" json:"drafts,omitempty"`
 	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserThreadsResponse) Reset() {
-	*x = GetUserThreadsResponse{}
-	mi := &file_proto_thread_proto_msgTypes[9]
+func (x *GetDraftsResponse) Reset() {
+	*x = GetDraftsResponse{}
+	mi := &file_proto_thread_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserThreadsResponse) String() string {
+func (x *GetDraftsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserThreadsResponse) ProtoMessage() {}
+func (*GetDraftsResponse) ProtoMessage() {}
 
-func (x *GetUserThreadsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thread_proto_msgTypes[9]
+func (x *GetDraftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,52 +5313,48 @@ func (x *GetUserThreadsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserThreadsResponse.ProtoReflect.Descriptor instead.
-func (*GetUserThreadsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thread_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetDraftsResponse.ProtoReflect.Descriptor instead.
+func (*GetDraftsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *GetUserThreadsResponse) GetThreads() []*Thread {
+func (x *GetDraftsResponse) GetDrafts() []*ThreadDraft {
 	if x != nil {
-		return x.Threads
+		return x.Drafts
 	}
 	return nil
 }
 
-func (x *GetUserThreadsResponse) GetHasMore() bool {
+func (x *GetDraftsResponse) GetHasMore() bool {
 	if x != nil {
 		return x.HasMore
 	}
 	return false
 }
 
-type GetCommunityThreadsRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	CommunityId     uint32                 `protobuf:"varint,1,opt,name=community_id,json=communityId,proto3" json:"community_id,omitempty"`
-	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
-	SortType        string                 `protobuf:"bytes,3,opt,name=sort_type,json=sortType,proto3" json:"sort_type,omitempty"` // "latest", "top" (for posts within community)
-	Page            int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
-	Limit           int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	ExcludeUserIds  []uint32               `protobuf:"varint,6,rep,packed,name=exclude_user_ids,json=excludeUserIds,proto3" json:"exclude_user_ids,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type DraftActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DraftId       uint32                 `protobuf:"varint,1,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommunityThreadsRequest) Reset() {
-	*x = GetCommunityThreadsRequest{}
-	mi := &file_proto_thread_proto_msgTypes[10]
+func (x *DraftActionRequest) Reset() {
+	*x = DraftActionRequest{}
+	mi := &file_proto_thread_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommunityThreadsRequest) String() string {
+func (x *DraftActionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommunityThreadsRequest) ProtoMessage() {}
+func (*DraftActionRequest) ProtoMessage() {}
 
-func (x *GetCommunityThreadsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thread_proto_msgTypes[10]
+func (x *DraftActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,76 +5365,111 @@ func (x *GetCommunityThreadsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommunityThreadsRequest.ProtoReflect.Descriptor instead.
-func (*GetCommunityThreadsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thread_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DraftActionRequest.ProtoReflect.Descriptor instead.
+func (*DraftActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetCommunityThreadsRequest) GetCommunityId() uint32 {
+func (x *DraftActionRequest) GetDraftId() uint32 {
 	if x != nil {
-		return x.CommunityId
+		return x.DraftId
 	}
 	return 0
 }
 
-func (x *GetCommunityThreadsRequest) GetRequesterUserId() uint32 {
-	if x != nil && x.RequesterUserId != nil {
-		return *x.RequesterUserId
+func (x *DraftActionRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetCommunityThreadsRequest) GetSortType() string {
+type PublishDraftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DraftId       uint32                 `protobuf:"varint,1,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // publish later instead of now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishDraftRequest) Reset() {
+	*x = PublishDraftRequest{}
+	mi := &file_proto_thread_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishDraftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishDraftRequest) ProtoMessage() {}
+
+func (x *PublishDraftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[74]
 	if x != nil {
-		return x.SortType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetCommunityThreadsRequest) GetPage() int32 {
+// Deprecated: Use PublishDraftRequest.ProtoReflect.Descriptor instead.
+func (*PublishDraftRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *PublishDraftRequest) GetDraftId() uint32 {
 	if x != nil {
-		return x.Page
+		return x.DraftId
 	}
 	return 0
 }
 
-func (x *GetCommunityThreadsRequest) GetLimit() int32 {
+func (x *PublishDraftRequest) GetUserId() uint32 {
 	if x != nil {
-		return x.Limit
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetCommunityThreadsRequest) GetExcludeUserIds() []uint32 {
+func (x *PublishDraftRequest) GetScheduledAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ExcludeUserIds
+		return x.ScheduledAt
 	}
 	return nil
 }
 
-type GetCommunityThreadsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
-	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Replaces the thread's flags. Authors can change their own threads; moderators can change anyone's.
+type SetThreadSensitivityRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId       uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	UserId         uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsSensitive    bool                   `protobuf:"varint,3,opt,name=is_sensitive,json=isSensitive,proto3" json:"is_sensitive,omitempty"`
+	ContentWarning string                 `protobuf:"bytes,4,opt,name=content_warning,json=contentWarning,proto3" json:"content_warning,omitempty"` // empty removes the warning
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetCommunityThreadsResponse) Reset() {
-	*x = GetCommunityThreadsResponse{}
-	mi := &file_proto_thread_proto_msgTypes[11]
+func (x *SetThreadSensitivityRequest) Reset() {
+	*x = SetThreadSensitivityRequest{}
+	mi := &file_proto_thread_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommunityThreadsResponse) String() string {
+func (x *SetThreadSensitivityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommunityThreadsResponse) ProtoMessage() {}
+func (*SetThreadSensitivityRequest) ProtoMessage() {}
 
-func (x *GetCommunityThreadsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thread_proto_msgTypes[11]
+func (x *SetThreadSensitivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -944,50 +5480,65 @@ func (x *GetCommunityThreadsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommunityThreadsResponse.ProtoReflect.Descriptor instead.
-func (*GetCommunityThreadsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thread_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SetThreadSensitivityRequest.ProtoReflect.Descriptor instead.
+func (*SetThreadSensitivityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetCommunityThreadsResponse) GetThreads() []*Thread {
+func (x *SetThreadSensitivityRequest) GetThreadId() uint32 {
 	if x != nil {
-		return x.Threads
+		return x.ThreadId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetCommunityThreadsResponse) GetHasMore() bool {
+func (x *SetThreadSensitivityRequest) GetUserId() uint32 {
 	if x != nil {
-		return x.HasMore
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetThreadSensitivityRequest) GetIsSensitive() bool {
+	if x != nil {
+		return x.IsSensitive
 	}
 	return false
 }
 
-type GetBookmarkedThreadsRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	UserId          uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
-	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	Limit           int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *SetThreadSensitivityRequest) GetContentWarning() string {
+	if x != nil {
+		return x.ContentWarning
+	}
+	return ""
 }
 
-func (x *GetBookmarkedThreadsRequest) Reset() {
-	*x = GetBookmarkedThreadsRequest{}
-	mi := &file_proto_thread_proto_msgTypes[12]
+// Deleted threads stay restorable for the restore window, then they are purged for good
+type ListDeletedThreadsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedThreadsRequest) Reset() {
+	*x = ListDeletedThreadsRequest{}
+	mi := &file_proto_thread_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookmarkedThreadsRequest) String() string {
+func (x *ListDeletedThreadsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookmarkedThreadsRequest) ProtoMessage() {}
+func (*ListDeletedThreadsRequest) ProtoMessage() {}
 
-func (x *GetBookmarkedThreadsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thread_proto_msgTypes[12]
+func (x *ListDeletedThreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -998,62 +5549,63 @@ func (x *GetBookmarkedThreadsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookmarkedThreadsRequest.ProtoReflect.Descriptor instead.
-func (*GetBookmarkedThreadsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thread_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListDeletedThreadsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedThreadsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetBookmarkedThreadsRequest) GetUserId() uint32 {
+func (x *ListDeletedThreadsRequest) GetUserId() uint32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetBookmarkedThreadsRequest) GetRequesterUserId() uint32 {
-	if x != nil && x.RequesterUserId != nil {
-		return *x.RequesterUserId
+func (x *ListDeletedThreadsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-func (x *GetBookmarkedThreadsRequest) GetPage() int32 {
+func (x *ListDeletedThreadsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Page
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *GetBookmarkedThreadsRequest) GetLimit() int32 {
+func (x *ListDeletedThreadsRequest) GetCursor() string {
 	if x != nil {
-		return x.Limit
+		return x.Cursor
 	}
-	return 0
+	return ""
 }
 
-type GetBookmarkedThreadsResponse struct {
+type DeletedThread struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
-	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	Thread        *Thread                `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PurgeAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"` // can't be restored after this
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBookmarkedThreadsResponse) Reset() {
-	*x = GetBookmarkedThreadsResponse{}
-	mi := &file_proto_thread_proto_msgTypes[13]
+func (x *DeletedThread) Reset() {
+	*x = DeletedThread{}
+	mi := &file_proto_thread_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookmarkedThreadsResponse) String() string {
+func (x *DeletedThread) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookmarkedThreadsResponse) ProtoMessage() {}
+func (*DeletedThread) ProtoMessage() {}
 
-func (x *GetBookmarkedThreadsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thread_proto_msgTypes[13]
+func (x *DeletedThread) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1064,51 +5616,56 @@ func (x *GetBookmarkedThreadsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookmarkedThreadsResponse.ProtoReflect.Descriptor instead.
-func (*GetBookmarkedThreadsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thread_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DeletedThread.ProtoReflect.Descriptor instead.
+func (*DeletedThread) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetBookmarkedThreadsResponse) GetThreads() []*Thread {
+func (x *DeletedThread) GetThread() *Thread {
 	if x != nil {
-		return x.Threads
+		return x.Thread
 	}
 	return nil
 }
 
-func (x *GetBookmarkedThreadsResponse) GetHasMore() bool {
+func (x *DeletedThread) GetDeletedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.HasMore
+		return x.DeletedAt
 	}
-	return false
+	return nil
 }
 
-type GetRepliesRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ParentThreadId  uint32                 `protobuf:"varint,1,opt,name=parent_thread_id,json=parentThreadId,proto3" json:"parent_thread_id,omitempty"`
-	RequesterUserId *uint32                `protobuf:"varint,2,opt,name=requester_user_id,json=requesterUserId,proto3,oneof" json:"requester_user_id,omitempty"`
-	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	Limit           int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	ExcludeUserIds  []uint32               `protobuf:"varint,5,rep,packed,name=exclude_user_ids,json=excludeUserIds,proto3" json:"exclude_user_ids,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *DeletedThread) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
 }
 
-func (x *GetRepliesRequest) Reset() {
-	*x = GetRepliesRequest{}
-	mi := &file_proto_thread_proto_msgTypes[14]
+type ListDeletedThreadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Threads       []*DeletedThread       `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedThreadsResponse) Reset() {
+	*x = ListDeletedThreadsResponse{}
+	mi := &file_proto_thread_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRepliesRequest) String() string {
+func (x *ListDeletedThreadsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRepliesRequest) ProtoMessage() {}
+func (*ListDeletedThreadsResponse) ProtoMessage() {}
 
-func (x *GetRepliesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thread_proto_msgTypes[14]
+func (x *ListDeletedThreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,69 +5676,55 @@ func (x *GetRepliesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRepliesRequest.ProtoReflect.Descriptor instead.
-func (*GetRepliesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_thread_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetRepliesRequest) GetParentThreadId() uint32 {
-	if x != nil {
-		return x.ParentThreadId
-	}
-	return 0
-}
-
-func (x *GetRepliesRequest) GetRequesterUserId() uint32 {
-	if x != nil && x.RequesterUserId != nil {
-		return *x.RequesterUserId
-	}
-	return 0
+// Deprecated: Use ListDeletedThreadsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedThreadsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetRepliesRequest) GetPage() int32 {
+func (x *ListDeletedThreadsResponse) GetThreads() []*DeletedThread {
 	if x != nil {
-		return x.Page
+		return x.Threads
 	}
-	return 0
+	return nil
 }
 
-func (x *GetRepliesRequest) GetLimit() int32 {
+func (x *ListDeletedThreadsResponse) GetHasMore() bool {
 	if x != nil {
-		return x.Limit
+		return x.HasMore
 	}
-	return 0
+	return false
 }
 
-func (x *GetRepliesRequest) GetExcludeUserIds() []uint32 {
+func (x *ListDeletedThreadsResponse) GetNextCursor() string {
 	if x != nil {
-		return x.ExcludeUserIds
+		return x.NextCursor
 	}
-	return nil
+	return ""
 }
 
-type GetRepliesResponse struct {
+type RestoreThreadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Threads       []*Thread              `protobuf:"bytes,1,rep,name=threads,proto3" json:"threads,omitempty"`
-	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRepliesResponse) Reset() {
-	*x = GetRepliesResponse{}
-	mi := &file_proto_thread_proto_msgTypes[15]
+func (x *RestoreThreadRequest) Reset() {
+	*x = RestoreThreadRequest{}
+	mi := &file_proto_thread_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRepliesResponse) String() string {
+func (x *RestoreThreadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRepliesResponse) ProtoMessage() {}
+func (*RestoreThreadRequest) ProtoMessage() {}
 
-func (x *GetRepliesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_thread_proto_msgTypes[15]
+func (x *RestoreThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_thread_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,23 +5735,23 @@ func (x *GetRepliesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRepliesResponse.ProtoReflect.Descriptor instead.
-func (*GetRepliesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_thread_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RestoreThreadRequest.ProtoReflect.Descriptor instead.
+func (*RestoreThreadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_thread_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetRepliesResponse) GetThreads() []*Thread {
+func (x *RestoreThreadRequest) GetThreadId() uint32 {
 	if x != nil {
-		return x.Threads
+		return x.ThreadId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetRepliesResponse) GetHasMore() bool {
+func (x *RestoreThreadRequest) GetUserId() uint32 {
 	if x != nil {
-		return x.HasMore
+		return x.UserId
 	}
-	return false
+	return 0
 }
 
 var File_proto_thread_proto protoreflect.FileDescriptor
@@ -1217,7 +5760,7 @@ const file_proto_thread_proto_rawDesc = "" +
 	"\n" +
 	"\x12proto/thread.proto\x12\x06thread\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"(\n" +
 	"\x0eHealthResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"\xae\x06\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\xd8\f\n" +
 	"\x06Thread\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x18\n" +
@@ -1242,9 +5785,37 @@ const file_proto_thread_proto_rawDesc = "" +
 	"\x1dis_bookmarked_by_current_user\x18\x11 \x01(\bR\x19isBookmarkedByCurrentUser\x12\x1e\n" +
 	"\n" +
 	"categories\x18\x12 \x03(\tR\n" +
-	"categoriesB\x13\n" +
+	"categories\x12\x1b\n" +
+	"\tcan_reply\x18\x13 \x01(\bR\bcanReply\x12<\n" +
+	"\x1bis_reposted_by_current_user\x18\x14 \x01(\bR\x17isRepostedByCurrentUser\x12-\n" +
+	"\x10quoted_thread_id\x18\x15 \x01(\rH\x02R\x0equotedThreadId\x88\x01\x01\x123\n" +
+	"\rquoted_thread\x18\x16 \x01(\v2\x0e.thread.ThreadR\fquotedThread\x12\x1f\n" +
+	"\vquote_count\x18\x17 \x01(\x05R\n" +
+	"quoteCount\x122\n" +
+	"\x13reposted_by_user_id\x18\x18 \x01(\rH\x03R\x10repostedByUserId\x88\x01\x01\x12;\n" +
+	"\vreposted_at\x18\x19 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"repostedAt\x127\n" +
+	"\tedited_at\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampR\beditedAt\x12 \n" +
+	"\x04poll\x18\x1b \x01(\v2\f.thread.PollR\x04poll\x12%\n" +
+	"\x0eparent_deleted\x18\x1c \x01(\bR\rparentDeleted\x12,\n" +
+	"\x12mentioned_user_ids\x18\x1d \x03(\rR\x10mentionedUserIds\x12)\n" +
+	"\x0ead_campaign_id\x18\x1e \x01(\rH\x04R\fadCampaignId\x88\x01\x01\x126\n" +
+	"\flink_preview\x18\x1f \x01(\v2\x13.thread.LinkPreviewR\vlinkPreview\x12\x1b\n" +
+	"\tis_hidden\x18  \x01(\bR\bisHidden\x12'\n" +
+	"\x0fcontent_warning\x18! \x01(\tR\x0econtentWarning\x12!\n" +
+	"\fis_sensitive\x18\" \x01(\bR\visSensitive\x12\x1b\n" +
+	"\tis_masked\x18# \x01(\bR\bisMaskedB\x13\n" +
 	"\x11_parent_thread_idB\x0f\n" +
-	"\r_community_id\"\x88\x03\n" +
+	"\r_community_idB\x13\n" +
+	"\x11_quoted_thread_idB\x16\n" +
+	"\x14_reposted_by_user_idB\x11\n" +
+	"\x0f_ad_campaign_id\"\x91\x01\n" +
+	"\vLinkPreview\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12\x1b\n" +
+	"\tsite_name\x18\x05 \x01(\tR\bsiteName\"\xfd\x04\n" +
 	"\x13CreateThreadRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12-\n" +
@@ -1255,9 +5826,31 @@ const file_proto_thread_proto_rawDesc = "" +
 	"\tmedia_ids\x18\a \x03(\rR\bmediaIds\x12\x1e\n" +
 	"\n" +
 	"categories\x18\b \x03(\tR\n" +
-	"categoriesB\x13\n" +
+	"categories\x12-\n" +
+	"\x10quoted_thread_id\x18\t \x01(\rH\x02R\x0equotedThreadId\x88\x01\x01\x12!\n" +
+	"\fpoll_options\x18\n" +
+	" \x03(\tR\vpollOptions\x12@\n" +
+	"\x0epoll_closes_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\fpollClosesAt\x12'\n" +
+	"\x0fcontent_warning\x18\f \x01(\tR\x0econtentWarning\x12!\n" +
+	"\fis_sensitive\x18\r \x01(\bR\visSensitiveB\x13\n" +
 	"\x11_parent_thread_idB\x0f\n" +
-	"\r_community_id\"p\n" +
+	"\r_community_idB\x13\n" +
+	"\x11_quoted_thread_id\"b\n" +
+	"\tChainPost\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\tR\acontent\x12\x1b\n" +
+	"\tmedia_ids\x18\x02 \x03(\rR\bmediaIds\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\tR\n" +
+	"categories\"\x9b\x02\n" +
+	"\x18CreateThreadChainRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12'\n" +
+	"\x05posts\x18\x02 \x03(\v2\x11.thread.ChainPostR\x05posts\x12E\n" +
+	"\x11reply_restriction\x18\x03 \x01(\x0e2\x18.thread.ReplyRestrictionR\x10replyRestriction\x12=\n" +
+	"\fscheduled_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12&\n" +
+	"\fcommunity_id\x18\x05 \x01(\rH\x00R\vcommunityId\x88\x01\x01B\x0f\n" +
+	"\r_community_id\"E\n" +
+	"\x19CreateThreadChainResponse\x12(\n" +
+	"\athreads\x18\x01 \x03(\v2\x0e.thread.ThreadR\athreads\"p\n" +
 	"\x10GetThreadRequest\x12\x1b\n" +
 	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12+\n" +
 	"\x0fcurrent_user_id\x18\x02 \x01(\rH\x00R\rcurrentUserId\x88\x01\x01B\x12\n" +
@@ -1267,18 +5860,23 @@ const file_proto_thread_proto_rawDesc = "" +
 	"\auser_id\x18\x02 \x01(\rR\x06userId\"M\n" +
 	"\x15InteractThreadRequest\x12\x1b\n" +
 	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\rR\x06userId\"\xfc\x01\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"\xb3\x02\n" +
 	"\x15GetFeedThreadsRequest\x12+\n" +
 	"\x0fcurrent_user_id\x18\x01 \x01(\rH\x00R\rcurrentUserId\x88\x01\x01\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1b\n" +
 	"\tfeed_type\x18\x04 \x01(\tR\bfeedType\x12(\n" +
 	"\x10exclude_user_ids\x18\x05 \x03(\rR\x0eexcludeUserIds\x121\n" +
-	"\x15include_only_user_ids\x18\x06 \x03(\rR\x12includeOnlyUserIdsB\x12\n" +
-	"\x10_current_user_id\"]\n" +
+	"\x15include_only_user_ids\x18\x06 \x03(\rR\x12includeOnlyUserIds\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursor\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\b \x01(\tR\tsessionIdB\x12\n" +
+	"\x10_current_user_id\"~\n" +
 	"\x16GetFeedThreadsResponse\x12(\n" +
 	"\athreads\x18\x01 \x03(\v2\x0e.thread.ThreadR\athreads\x12\x19\n" +
-	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xf9\x01\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\x91\x02\n" +
 	"\x15GetUserThreadsRequest\x12$\n" +
 	"\x0etarget_user_id\x18\x01 \x01(\rR\ftargetUserId\x12/\n" +
 	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01\x12\x1f\n" +
@@ -1286,49 +5884,403 @@ const file_proto_thread_proto_rawDesc = "" +
 	"threadType\x12\x12\n" +
 	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12(\n" +
-	"\x10exclude_user_ids\x18\x06 \x03(\rR\x0eexcludeUserIdsB\x14\n" +
-	"\x12_requester_user_id\"]\n" +
+	"\x10exclude_user_ids\x18\x06 \x03(\rR\x0eexcludeUserIds\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursorB\x14\n" +
+	"\x12_requester_user_id\"~\n" +
 	"\x16GetUserThreadsResponse\x12(\n" +
 	"\athreads\x18\x01 \x03(\v2\x0e.thread.ThreadR\athreads\x12\x19\n" +
-	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xf7\x01\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\x8f\x02\n" +
 	"\x1aGetCommunityThreadsRequest\x12!\n" +
 	"\fcommunity_id\x18\x01 \x01(\rR\vcommunityId\x12/\n" +
 	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01\x12\x1b\n" +
 	"\tsort_type\x18\x03 \x01(\tR\bsortType\x12\x12\n" +
 	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12(\n" +
-	"\x10exclude_user_ids\x18\x06 \x03(\rR\x0eexcludeUserIdsB\x14\n" +
-	"\x12_requester_user_id\"b\n" +
+	"\x10exclude_user_ids\x18\x06 \x03(\rR\x0eexcludeUserIds\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursorB\x14\n" +
+	"\x12_requester_user_id\"\x83\x01\n" +
 	"\x1bGetCommunityThreadsResponse\x12(\n" +
 	"\athreads\x18\x01 \x03(\v2\x0e.thread.ThreadR\athreads\x12\x19\n" +
-	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xa7\x01\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\xbf\x01\n" +
 	"\x1bGetBookmarkedThreadsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\rR\x06userId\x12/\n" +
 	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limitB\x14\n" +
-	"\x12_requester_user_id\"c\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursorB\x14\n" +
+	"\x12_requester_user_id\"\x84\x01\n" +
 	"\x1cGetBookmarkedThreadsResponse\x12(\n" +
 	"\athreads\x18\x01 \x03(\v2\x0e.thread.ThreadR\athreads\x12\x19\n" +
-	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xd8\x01\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\xf0\x01\n" +
 	"\x11GetRepliesRequest\x12(\n" +
 	"\x10parent_thread_id\x18\x01 \x01(\rR\x0eparentThreadId\x12/\n" +
 	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12(\n" +
-	"\x10exclude_user_ids\x18\x05 \x03(\rR\x0eexcludeUserIdsB\x14\n" +
-	"\x12_requester_user_id\"Y\n" +
+	"\x10exclude_user_ids\x18\x05 \x03(\rR\x0eexcludeUserIds\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursorB\x14\n" +
+	"\x12_requester_user_id\"z\n" +
 	"\x12GetRepliesResponse\x12(\n" +
 	"\athreads\x18\x01 \x03(\v2\x0e.thread.ThreadR\athreads\x12\x19\n" +
-	"\bhas_more\x18\x02 \x01(\bR\ahasMore*`\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"w\n" +
+	"\x1aGetScheduledThreadsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"\x83\x01\n" +
+	"\x1bGetScheduledThreadsResponse\x12(\n" +
+	"\athreads\x18\x01 \x03(\v2\x0e.thread.ThreadR\athreads\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\x8e\x01\n" +
+	"\x17RescheduleThreadRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12=\n" +
+	"\fscheduled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\"T\n" +
+	"\x1cCancelScheduledThreadRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"\xe2\x01\n" +
+	"\x10GetQuotesRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12/\n" +
+	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12(\n" +
+	"\x10exclude_user_ids\x18\x05 \x03(\rR\x0eexcludeUserIds\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursorB\x14\n" +
+	"\x12_requester_user_id\"y\n" +
+	"\x11GetQuotesResponse\x12(\n" +
+	"\athreads\x18\x01 \x03(\v2\x0e.thread.ThreadR\athreads\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\x80\x01\n" +
+	"\x11EditThreadRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1b\n" +
+	"\tmedia_ids\x18\x04 \x03(\rR\bmediaIds\"\xcc\x01\n" +
+	"\x0eThreadRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tthread_id\x18\x02 \x01(\rR\bthreadId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1b\n" +
+	"\tmedia_ids\x18\x04 \x03(\rR\bmediaIds\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tis_masked\x18\x06 \x01(\bR\bisMasked\"\x7f\n" +
+	"\x19GetThreadRevisionsRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12/\n" +
+	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01B\x14\n" +
+	"\x12_requester_user_id\"R\n" +
+	"\x1aGetThreadRevisionsResponse\x124\n" +
+	"\trevisions\x18\x01 \x03(\v2\x16.thread.ThreadRevisionR\trevisions\"\xdc\x01\n" +
+	"\x16GetConversationRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12/\n" +
+	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01\x12\x1b\n" +
+	"\tmax_depth\x18\x03 \x01(\x05R\bmaxDepth\x12\x17\n" +
+	"\afan_out\x18\x04 \x01(\x05R\x06fanOut\x12(\n" +
+	"\x10exclude_user_ids\x18\x05 \x03(\rR\x0eexcludeUserIdsB\x14\n" +
+	"\x12_requester_user_id\"\x9e\x01\n" +
+	"\x10ConversationNode\x12&\n" +
+	"\x06thread\x18\x01 \x01(\v2\x0e.thread.ThreadR\x06thread\x122\n" +
+	"\areplies\x18\x02 \x03(\v2\x18.thread.ConversationNodeR\areplies\x12.\n" +
+	"\x13more_replies_cursor\x18\x03 \x01(\tR\x11moreRepliesCursor\"w\n" +
+	"\x17GetConversationResponse\x12,\n" +
+	"\tancestors\x18\x01 \x03(\v2\x0e.thread.ThreadR\tancestors\x12.\n" +
+	"\x05focus\x18\x02 \x01(\v2\x18.thread.ConversationNodeR\x05focus\"O\n" +
+	"\n" +
+	"PollOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1d\n" +
+	"\n" +
+	"vote_count\x18\x03 \x01(\x05R\tvoteCount\"\xa5\x02\n" +
+	"\x04Poll\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12,\n" +
+	"\aoptions\x18\x02 \x03(\v2\x12.thread.PollOptionR\aoptions\x127\n" +
+	"\tcloses_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bclosesAt\x12\x1b\n" +
+	"\tis_closed\x18\x04 \x01(\bR\bisClosed\x12'\n" +
+	"\x0fresults_visible\x18\x05 \x01(\bR\x0eresultsVisible\x12+\n" +
+	"\x0fvoted_option_id\x18\x06 \x01(\rH\x00R\rvotedOptionId\x88\x01\x01\x12\x1f\n" +
+	"\vtotal_votes\x18\a \x01(\x05R\n" +
+	"totalVotesB\x12\n" +
+	"\x10_voted_option_id\"d\n" +
+	"\x0fVotePollRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x1b\n" +
+	"\toption_id\x18\x03 \x01(\rR\boptionId\"{\n" +
+	"\x15GetPollResultsRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12/\n" +
+	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01B\x14\n" +
+	"\x12_requester_user_id\"\x86\x02\n" +
+	"\x1aGetThreadsByHashtagRequest\x12\x18\n" +
+	"\ahashtag\x18\x01 \x01(\tR\ahashtag\x12/\n" +
+	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01\x12\x1b\n" +
+	"\tsort_type\x18\x03 \x01(\tR\bsortType\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12(\n" +
+	"\x10exclude_user_ids\x18\x06 \x03(\rR\x0eexcludeUserIds\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursorB\x14\n" +
+	"\x12_requester_user_id\"\x83\x01\n" +
+	"\x1bGetThreadsByHashtagResponse\x12(\n" +
+	"\athreads\x18\x01 \x03(\v2\x0e.thread.ThreadR\athreads\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"W\n" +
+	"\x16GetHashtagStatsRequest\x12\x18\n" +
+	"\ahashtag\x18\x01 \x01(\tR\ahashtag\x12#\n" +
+	"\rrelated_limit\x18\x02 \x01(\x05R\frelatedLimit\"I\n" +
+	"\x0eRelatedHashtag\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12%\n" +
+	"\x0eco_occurrences\x18\x02 \x01(\x03R\rcoOccurrences\"\x88\x01\n" +
+	"\x17GetHashtagStatsResponse\x12\x18\n" +
+	"\ahashtag\x18\x01 \x01(\tR\ahashtag\x12!\n" +
+	"\fthread_count\x18\x02 \x01(\x03R\vthreadCount\x120\n" +
+	"\arelated\x18\x03 \x03(\v2\x16.thread.RelatedHashtagR\arelated\"\xe0\x01\n" +
+	"\x12GetMentionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12/\n" +
+	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12(\n" +
+	"\x10exclude_user_ids\x18\x05 \x03(\rR\x0eexcludeUserIds\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursorB\x14\n" +
+	"\x12_requester_user_id\"{\n" +
+	"\x13GetMentionsResponse\x12(\n" +
+	"\athreads\x18\x01 \x03(\v2\x0e.thread.ThreadR\athreads\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\xac\x02\n" +
+	"\x1bGetThreadsByCategoryRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12/\n" +
+	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01\x12\x1b\n" +
+	"\tsort_type\x18\x03 \x01(\tR\bsortType\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12(\n" +
+	"\x10exclude_user_ids\x18\x06 \x03(\rR\x0eexcludeUserIds\x12\x16\n" +
+	"\x06cursor\x18\a \x01(\tR\x06cursor\x12!\n" +
+	"\fwindow_hours\x18\b \x01(\x05R\vwindowHoursB\x14\n" +
+	"\x12_requester_user_id\"\x84\x01\n" +
+	"\x1cGetThreadsByCategoryResponse\x12(\n" +
+	"\athreads\x18\x01 \x03(\v2\x0e.thread.ThreadR\athreads\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"<\n" +
+	"\x17GetCategoryStatsRequest\x12!\n" +
+	"\fwindow_hours\x18\x01 \x01(\x05R\vwindowHours\"y\n" +
+	"\fCategoryStat\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x1d\n" +
+	"\n" +
+	"post_count\x18\x02 \x01(\x03R\tpostCount\x12.\n" +
+	"\x13previous_post_count\x18\x03 \x01(\x03R\x11previousPostCount\"i\n" +
+	"\x18GetCategoryStatsResponse\x12*\n" +
+	"\x05stats\x18\x01 \x03(\v2\x14.thread.CategoryStatR\x05stats\x12!\n" +
+	"\fwindow_hours\x18\x02 \x01(\x05R\vwindowHours\"\xce\x02\n" +
+	"\x1bCreatePromotedThreadRequest\x123\n" +
+	"\x06thread\x18\x01 \x01(\v2\x1b.thread.CreateThreadRequestR\x06thread\x12!\n" +
+	"\fbudget_cents\x18\x02 \x01(\x03R\vbudgetCents\x127\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12+\n" +
+	"\x11target_categories\x18\x05 \x03(\tR\x10targetCategories\x12<\n" +
+	"\x1btarget_follower_of_user_ids\x18\x06 \x03(\rR\x17targetFollowerOfUserIds\"\x98\x04\n" +
+	"\n" +
+	"AdCampaign\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tthread_id\x18\x02 \x01(\rR\bthreadId\x12#\n" +
+	"\radvertiser_id\x18\x03 \x01(\rR\fadvertiserId\x12!\n" +
+	"\fbudget_cents\x18\x04 \x01(\x03R\vbudgetCents\x12\x1f\n" +
+	"\vspent_cents\x18\x05 \x01(\x03R\n" +
+	"spentCents\x127\n" +
+	"\tstarts_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12+\n" +
+	"\x11target_categories\x18\b \x03(\tR\x10targetCategories\x12<\n" +
+	"\x1btarget_follower_of_user_ids\x18\t \x03(\rR\x17targetFollowerOfUserIds\x12 \n" +
+	"\vimpressions\x18\n" +
+	" \x01(\x03R\vimpressions\x12\x16\n" +
+	"\x06clicks\x18\v \x01(\x03R\x06clicks\x12&\n" +
+	"\x06thread\x18\f \x01(\v2\x0e.thread.ThreadR\x06thread\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"<\n" +
+	"\x15GetAdCampaignsRequest\x12#\n" +
+	"\radvertiser_id\x18\x01 \x01(\rR\fadvertiserId\"J\n" +
+	"\x16GetAdCampaignsResponse\x120\n" +
+	"\tcampaigns\x18\x01 \x03(\v2\x12.thread.AdCampaignR\tcampaigns\"\x80\x01\n" +
+	"\x14RecordAdClickRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\rR\n" +
+	"campaignId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\rH\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"m\n" +
+	"\x19FilterMutedThreadsRequest\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x01(\rR\bviewerId\x12\x14\n" +
+	"\x05scope\x18\x02 \x01(\tR\x05scope\x12\x1d\n" +
+	"\n" +
+	"thread_ids\x18\x03 \x03(\rR\tthreadIds\";\n" +
+	"\x1aFilterMutedThreadsResponse\x12\x1d\n" +
+	"\n" +
+	"thread_ids\x18\x01 \x03(\rR\tthreadIds\"\xe3\x01\n" +
+	"\x15GetListThreadsRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\rR\x06listId\x12/\n" +
+	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12(\n" +
+	"\x10exclude_user_ids\x18\x05 \x03(\rR\x0eexcludeUserIds\x12\x16\n" +
+	"\x06cursor\x18\x06 \x01(\tR\x06cursorB\x14\n" +
+	"\x12_requester_user_id\"~\n" +
+	"\x16GetListThreadsResponse\x12(\n" +
+	"\athreads\x18\x01 \x03(\v2\x0e.thread.ThreadR\athreads\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"l\n" +
+	"\x16ReplyVisibilityRequest\x12&\n" +
+	"\x0freply_thread_id\x18\x01 \x01(\rR\rreplyThreadId\x12*\n" +
+	"\x11requester_user_id\x18\x02 \x01(\rR\x0frequesterUserId\"L\n" +
+	"\x14RemoveMentionRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"\x9c\x01\n" +
+	"\x1dUpdateReplyRestrictionRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12E\n" +
+	"\x11reply_restriction\x18\x03 \x01(\x0e2\x18.thread.ReplyRestrictionR\x10replyRestriction\"\xc7\x02\n" +
+	"\x10ModerationAction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tthread_id\x18\x02 \x01(\rR\bthreadId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\rR\aactorId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12-\n" +
+	"\x10target_thread_id\x18\x05 \x01(\rH\x00R\x0etargetThreadId\x88\x01\x01\x12)\n" +
+	"\x0etarget_user_id\x18\x06 \x01(\rH\x01R\ftargetUserId\x88\x01\x01\x12\x16\n" +
+	"\x06detail\x18\a \x01(\tR\x06detail\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x13\n" +
+	"\x11_target_thread_idB\x11\n" +
+	"\x0f_target_user_id\"\x8c\x01\n" +
+	"\x17GetModerationLogRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12*\n" +
+	"\x11requester_user_id\x18\x02 \x01(\rR\x0frequesterUserId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"i\n" +
+	"\x18GetModerationLogResponse\x122\n" +
+	"\aactions\x18\x01 \x03(\v2\x18.thread.ModerationActionR\aactions\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"\xbb\x01\n" +
+	"\x0fThreadViewEvent\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12\x1b\n" +
+	"\tviewer_id\x18\x02 \x01(\rR\bviewerId\x12\x1d\n" +
+	"\n" +
+	"viewer_key\x18\x03 \x01(\tR\tviewerKey\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"L\n" +
+	"\x19RecordThreadEventsRequest\x12/\n" +
+	"\x06events\x18\x01 \x03(\v2\x17.thread.ThreadViewEventR\x06events\"z\n" +
+	"\x19GetThreadAnalyticsRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12*\n" +
+	"\x11requester_user_id\x18\x02 \x01(\rR\x0frequesterUserId\x12\x14\n" +
+	"\x05hours\x18\x03 \x01(\x05R\x05hours\"\xa0\x02\n" +
+	"\x15ThreadAnalyticsBucket\x12.\n" +
+	"\x04hour\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04hour\x12 \n" +
+	"\vimpressions\x18\x02 \x01(\x03R\vimpressions\x121\n" +
+	"\x14follower_impressions\x18\x03 \x01(\x03R\x13followerImpressions\x128\n" +
+	"\x18non_follower_impressions\x18\x04 \x01(\x03R\x16nonFollowerImpressions\x12!\n" +
+	"\fdetail_views\x18\x05 \x01(\x03R\vdetailViews\x12%\n" +
+	"\x0eprofile_clicks\x18\x06 \x01(\x03R\rprofileClicks\"\xf3\x02\n" +
+	"\x0fThreadAnalytics\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12 \n" +
+	"\vimpressions\x18\x02 \x01(\x03R\vimpressions\x12%\n" +
+	"\x0efollower_reach\x18\x03 \x01(\x03R\rfollowerReach\x12,\n" +
+	"\x12non_follower_reach\x18\x04 \x01(\x03R\x10nonFollowerReach\x12!\n" +
+	"\fdetail_views\x18\x05 \x01(\x03R\vdetailViews\x12%\n" +
+	"\x0eprofile_clicks\x18\x06 \x01(\x03R\rprofileClicks\x12 \n" +
+	"\vengagements\x18\a \x01(\x03R\vengagements\x12'\n" +
+	"\x0fengagement_rate\x18\b \x01(\x01R\x0eengagementRate\x127\n" +
+	"\abuckets\x18\t \x03(\v2\x1d.thread.ThreadAnalyticsBucketR\abuckets\"\x93\x04\n" +
+	"\vThreadDraft\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1b\n" +
+	"\tmedia_ids\x18\x04 \x03(\rR\bmediaIds\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x05 \x03(\tR\n" +
+	"categories\x12-\n" +
+	"\x10parent_thread_id\x18\x06 \x01(\rH\x00R\x0eparentThreadId\x88\x01\x01\x12&\n" +
+	"\fcommunity_id\x18\a \x01(\rH\x01R\vcommunityId\x88\x01\x01\x12E\n" +
+	"\x11reply_restriction\x18\b \x01(\x0e2\x18.thread.ReplyRestrictionR\x10replyRestriction\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12'\n" +
+	"\x0fcontent_warning\x18\v \x01(\tR\x0econtentWarning\x12!\n" +
+	"\fis_sensitive\x18\f \x01(\bR\visSensitiveB\x13\n" +
+	"\x11_parent_thread_idB\x0f\n" +
+	"\r_community_id\"\xad\x03\n" +
+	"\x10SaveDraftRequest\x12\x19\n" +
+	"\bdraft_id\x18\x01 \x01(\rR\adraftId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x1b\n" +
+	"\tmedia_ids\x18\x04 \x03(\rR\bmediaIds\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x05 \x03(\tR\n" +
+	"categories\x12-\n" +
+	"\x10parent_thread_id\x18\x06 \x01(\rH\x00R\x0eparentThreadId\x88\x01\x01\x12&\n" +
+	"\fcommunity_id\x18\a \x01(\rH\x01R\vcommunityId\x88\x01\x01\x12E\n" +
+	"\x11reply_restriction\x18\b \x01(\x0e2\x18.thread.ReplyRestrictionR\x10replyRestriction\x12'\n" +
+	"\x0fcontent_warning\x18\t \x01(\tR\x0econtentWarning\x12!\n" +
+	"\fis_sensitive\x18\n" +
+	" \x01(\bR\visSensitiveB\x13\n" +
+	"\x11_parent_thread_idB\x0f\n" +
+	"\r_community_id\"U\n" +
+	"\x10GetDraftsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"[\n" +
+	"\x11GetDraftsResponse\x12+\n" +
+	"\x06drafts\x18\x01 \x03(\v2\x13.thread.ThreadDraftR\x06drafts\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"H\n" +
+	"\x12DraftActionRequest\x12\x19\n" +
+	"\bdraft_id\x18\x01 \x01(\rR\adraftId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"\x88\x01\n" +
+	"\x13PublishDraftRequest\x12\x19\n" +
+	"\bdraft_id\x18\x01 \x01(\rR\adraftId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12=\n" +
+	"\fscheduled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\"\x9f\x01\n" +
+	"\x1bSetThreadSensitivityRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12!\n" +
+	"\fis_sensitive\x18\x03 \x01(\bR\visSensitive\x12'\n" +
+	"\x0fcontent_warning\x18\x04 \x01(\tR\x0econtentWarning\"v\n" +
+	"\x19ListDeletedThreadsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\"\xa9\x01\n" +
+	"\rDeletedThread\x12&\n" +
+	"\x06thread\x18\x01 \x01(\v2\x0e.thread.ThreadR\x06thread\x129\n" +
+	"\n" +
+	"deleted_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x125\n" +
+	"\bpurge_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\apurgeAt\"\x89\x01\n" +
+	"\x1aListDeletedThreadsResponse\x12/\n" +
+	"\athreads\x18\x01 \x03(\v2\x15.thread.DeletedThreadR\athreads\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"L\n" +
+	"\x14RestoreThreadRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId*`\n" +
 	"\x10ReplyRestriction\x12!\n" +
 	"\x1dREPLY_RESTRICTION_UNSPECIFIED\x10\x00\x12\f\n" +
 	"\bEVERYONE\x10\x01\x12\r\n" +
 	"\tFOLLOWING\x10\x02\x12\f\n" +
-	"\bVERIFIED\x10\x032\xd1\a\n" +
+	"\bVERIFIED\x10\x032\xa6\x1e\n" +
 	"\rThreadService\x12=\n" +
 	"\vHealthCheck\x12\x16.google.protobuf.Empty\x1a\x16.thread.HealthResponse\x12;\n" +
-	"\fCreateThread\x12\x1b.thread.CreateThreadRequest\x1a\x0e.thread.Thread\x125\n" +
+	"\fCreateThread\x12\x1b.thread.CreateThreadRequest\x1a\x0e.thread.Thread\x12X\n" +
+	"\x11CreateThreadChain\x12 .thread.CreateThreadChainRequest\x1a!.thread.CreateThreadChainResponse\x125\n" +
 	"\tGetThread\x12\x18.thread.GetThreadRequest\x1a\x0e.thread.Thread\x12C\n" +
 	"\fDeleteThread\x12\x1b.thread.DeleteThreadRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
 	"\n" +
@@ -1341,7 +6293,45 @@ const file_proto_thread_proto_rawDesc = "" +
 	"\x14GetBookmarkedThreads\x12#.thread.GetBookmarkedThreadsRequest\x1a$.thread.GetBookmarkedThreadsResponse\x12^\n" +
 	"\x13GetCommunityThreads\x12\".thread.GetCommunityThreadsRequest\x1a#.thread.GetCommunityThreadsResponse\x12C\n" +
 	"\n" +
-	"GetReplies\x12\x19.thread.GetRepliesRequest\x1a\x1a.thread.GetRepliesResponseBCZAgithub.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genprotob\x06proto3"
+	"GetReplies\x12\x19.thread.GetRepliesRequest\x1a\x1a.thread.GetRepliesResponse\x12^\n" +
+	"\x13GetScheduledThreads\x12\".thread.GetScheduledThreadsRequest\x1a#.thread.GetScheduledThreadsResponse\x12C\n" +
+	"\x10RescheduleThread\x12\x1f.thread.RescheduleThreadRequest\x1a\x0e.thread.Thread\x12U\n" +
+	"\x15CancelScheduledThread\x12$.thread.CancelScheduledThreadRequest\x1a\x16.google.protobuf.Empty\x12?\n" +
+	"\x06Repost\x12\x1d.thread.InteractThreadRequest\x1a\x16.google.protobuf.Empty\x12A\n" +
+	"\bUnrepost\x12\x1d.thread.InteractThreadRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"\tGetQuotes\x12\x18.thread.GetQuotesRequest\x1a\x19.thread.GetQuotesResponse\x127\n" +
+	"\n" +
+	"EditThread\x12\x19.thread.EditThreadRequest\x1a\x0e.thread.Thread\x12[\n" +
+	"\x12GetThreadRevisions\x12!.thread.GetThreadRevisionsRequest\x1a\".thread.GetThreadRevisionsResponse\x12R\n" +
+	"\x0fGetConversation\x12\x1e.thread.GetConversationRequest\x1a\x1f.thread.GetConversationResponse\x121\n" +
+	"\bVotePoll\x12\x17.thread.VotePollRequest\x1a\f.thread.Poll\x12=\n" +
+	"\x0eGetPollResults\x12\x1d.thread.GetPollResultsRequest\x1a\f.thread.Poll\x12^\n" +
+	"\x13GetThreadsByHashtag\x12\".thread.GetThreadsByHashtagRequest\x1a#.thread.GetThreadsByHashtagResponse\x12R\n" +
+	"\x0fGetHashtagStats\x12\x1e.thread.GetHashtagStatsRequest\x1a\x1f.thread.GetHashtagStatsResponse\x12F\n" +
+	"\vGetMentions\x12\x1a.thread.GetMentionsRequest\x1a\x1b.thread.GetMentionsResponse\x12a\n" +
+	"\x14GetThreadsByCategory\x12#.thread.GetThreadsByCategoryRequest\x1a$.thread.GetThreadsByCategoryResponse\x12U\n" +
+	"\x10GetCategoryStats\x12\x1f.thread.GetCategoryStatsRequest\x1a .thread.GetCategoryStatsResponse\x12O\n" +
+	"\x14CreatePromotedThread\x12#.thread.CreatePromotedThreadRequest\x1a\x12.thread.AdCampaign\x12O\n" +
+	"\x0eGetAdCampaigns\x12\x1d.thread.GetAdCampaignsRequest\x1a\x1e.thread.GetAdCampaignsResponse\x12E\n" +
+	"\rRecordAdClick\x12\x1c.thread.RecordAdClickRequest\x1a\x16.google.protobuf.Empty\x12[\n" +
+	"\x12FilterMutedThreads\x12!.thread.FilterMutedThreadsRequest\x1a\".thread.FilterMutedThreadsResponse\x12O\n" +
+	"\x0eGetListThreads\x12\x1d.thread.GetListThreadsRequest\x1a\x1e.thread.GetListThreadsResponse\x12C\n" +
+	"\tHideReply\x12\x1e.thread.ReplyVisibilityRequest\x1a\x16.google.protobuf.Empty\x12E\n" +
+	"\vUnhideReply\x12\x1e.thread.ReplyVisibilityRequest\x1a\x16.google.protobuf.Empty\x12I\n" +
+	"\x10GetHiddenReplies\x12\x19.thread.GetRepliesRequest\x1a\x1a.thread.GetRepliesResponse\x12E\n" +
+	"\rRemoveMention\x12\x1c.thread.RemoveMentionRequest\x1a\x16.google.protobuf.Empty\x12O\n" +
+	"\x16UpdateReplyRestriction\x12%.thread.UpdateReplyRestrictionRequest\x1a\x0e.thread.Thread\x12U\n" +
+	"\x10GetModerationLog\x12\x1f.thread.GetModerationLogRequest\x1a .thread.GetModerationLogResponse\x12O\n" +
+	"\x12RecordThreadEvents\x12!.thread.RecordThreadEventsRequest\x1a\x16.google.protobuf.Empty\x12P\n" +
+	"\x12GetThreadAnalytics\x12!.thread.GetThreadAnalyticsRequest\x1a\x17.thread.ThreadAnalytics\x12<\n" +
+	"\vCreateDraft\x12\x18.thread.SaveDraftRequest\x1a\x13.thread.ThreadDraft\x12<\n" +
+	"\vUpdateDraft\x12\x18.thread.SaveDraftRequest\x1a\x13.thread.ThreadDraft\x12@\n" +
+	"\tGetDrafts\x12\x18.thread.GetDraftsRequest\x1a\x19.thread.GetDraftsResponse\x12A\n" +
+	"\vDeleteDraft\x12\x1a.thread.DraftActionRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\fPublishDraft\x12\x1b.thread.PublishDraftRequest\x1a\x0e.thread.Thread\x12K\n" +
+	"\x14SetThreadSensitivity\x12#.thread.SetThreadSensitivityRequest\x1a\x0e.thread.Thread\x12[\n" +
+	"\x12ListDeletedThreads\x12!.thread.ListDeletedThreadsRequest\x1a\".thread.ListDeletedThreadsResponse\x12=\n" +
+	"\rRestoreThread\x12\x1c.thread.RestoreThreadRequest\x1a\x0e.thread.ThreadBCZAgithub.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genprotob\x06proto3"
 
 var (
 	file_proto_thread_proto_rawDescOnce sync.Once
@@ -1356,71 +6346,263 @@ func file_proto_thread_proto_rawDescGZIP() []byte {
 }
 
 var file_proto_thread_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_thread_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_proto_thread_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
 var file_proto_thread_proto_goTypes = []any{
-	(ReplyRestriction)(0),                // 0: thread.ReplyRestriction
-	(*HealthResponse)(nil),               // 1: thread.HealthResponse
-	(*Thread)(nil),                       // 2: thread.Thread
-	(*CreateThreadRequest)(nil),          // 3: thread.CreateThreadRequest
-	(*GetThreadRequest)(nil),             // 4: thread.GetThreadRequest
-	(*DeleteThreadRequest)(nil),          // 5: thread.DeleteThreadRequest
-	(*InteractThreadRequest)(nil),        // 6: thread.InteractThreadRequest
-	(*GetFeedThreadsRequest)(nil),        // 7: thread.GetFeedThreadsRequest
-	(*GetFeedThreadsResponse)(nil),       // 8: thread.GetFeedThreadsResponse
-	(*GetUserThreadsRequest)(nil),        // 9: thread.GetUserThreadsRequest
-	(*GetUserThreadsResponse)(nil),       // 10: thread.GetUserThreadsResponse
-	(*GetCommunityThreadsRequest)(nil),   // 11: thread.GetCommunityThreadsRequest
-	(*GetCommunityThreadsResponse)(nil),  // 12: thread.GetCommunityThreadsResponse
-	(*GetBookmarkedThreadsRequest)(nil),  // 13: thread.GetBookmarkedThreadsRequest
-	(*GetBookmarkedThreadsResponse)(nil), // 14: thread.GetBookmarkedThreadsResponse
-	(*GetRepliesRequest)(nil),            // 15: thread.GetRepliesRequest
-	(*GetRepliesResponse)(nil),           // 16: thread.GetRepliesResponse
-	(*timestamppb.Timestamp)(nil),        // 17: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                // 18: google.protobuf.Empty
+	(ReplyRestriction)(0),                 // 0: thread.ReplyRestriction
+	(*HealthResponse)(nil),                // 1: thread.HealthResponse
+	(*Thread)(nil),                        // 2: thread.Thread
+	(*LinkPreview)(nil),                   // 3: thread.LinkPreview
+	(*CreateThreadRequest)(nil),           // 4: thread.CreateThreadRequest
+	(*ChainPost)(nil),                     // 5: thread.ChainPost
+	(*CreateThreadChainRequest)(nil),      // 6: thread.CreateThreadChainRequest
+	(*CreateThreadChainResponse)(nil),     // 7: thread.CreateThreadChainResponse
+	(*GetThreadRequest)(nil),              // 8: thread.GetThreadRequest
+	(*DeleteThreadRequest)(nil),           // 9: thread.DeleteThreadRequest
+	(*InteractThreadRequest)(nil),         // 10: thread.InteractThreadRequest
+	(*GetFeedThreadsRequest)(nil),         // 11: thread.GetFeedThreadsRequest
+	(*GetFeedThreadsResponse)(nil),        // 12: thread.GetFeedThreadsResponse
+	(*GetUserThreadsRequest)(nil),         // 13: thread.GetUserThreadsRequest
+	(*GetUserThreadsResponse)(nil),        // 14: thread.GetUserThreadsResponse
+	(*GetCommunityThreadsRequest)(nil),    // 15: thread.GetCommunityThreadsRequest
+	(*GetCommunityThreadsResponse)(nil),   // 16: thread.GetCommunityThreadsResponse
+	(*GetBookmarkedThreadsRequest)(nil),   // 17: thread.GetBookmarkedThreadsRequest
+	(*GetBookmarkedThreadsResponse)(nil),  // 18: thread.GetBookmarkedThreadsResponse
+	(*GetRepliesRequest)(nil),             // 19: thread.GetRepliesRequest
+	(*GetRepliesResponse)(nil),            // 20: thread.GetRepliesResponse
+	(*GetScheduledThreadsRequest)(nil),    // 21: thread.GetScheduledThreadsRequest
+	(*GetScheduledThreadsResponse)(nil),   // 22: thread.GetScheduledThreadsResponse
+	(*RescheduleThreadRequest)(nil),       // 23: thread.RescheduleThreadRequest
+	(*CancelScheduledThreadRequest)(nil),  // 24: thread.CancelScheduledThreadRequest
+	(*GetQuotesRequest)(nil),              // 25: thread.GetQuotesRequest
+	(*GetQuotesResponse)(nil),             // 26: thread.GetQuotesResponse
+	(*EditThreadRequest)(nil),             // 27: thread.EditThreadRequest
+	(*ThreadRevision)(nil),                // 28: thread.ThreadRevision
+	(*GetThreadRevisionsRequest)(nil),     // 29: thread.GetThreadRevisionsRequest
+	(*GetThreadRevisionsResponse)(nil),    // 30: thread.GetThreadRevisionsResponse
+	(*GetConversationRequest)(nil),        // 31: thread.GetConversationRequest
+	(*ConversationNode)(nil),              // 32: thread.ConversationNode
+	(*GetConversationResponse)(nil),       // 33: thread.GetConversationResponse
+	(*PollOption)(nil),                    // 34: thread.PollOption
+	(*Poll)(nil),                          // 35: thread.Poll
+	(*VotePollRequest)(nil),               // 36: thread.VotePollRequest
+	(*GetPollResultsRequest)(nil),         // 37: thread.GetPollResultsRequest
+	(*GetThreadsByHashtagRequest)(nil),    // 38: thread.GetThreadsByHashtagRequest
+	(*GetThreadsByHashtagResponse)(nil),   // 39: thread.GetThreadsByHashtagResponse
+	(*GetHashtagStatsRequest)(nil),        // 40: thread.GetHashtagStatsRequest
+	(*RelatedHashtag)(nil),                // 41: thread.RelatedHashtag
+	(*GetHashtagStatsResponse)(nil),       // 42: thread.GetHashtagStatsResponse
+	(*GetMentionsRequest)(nil),            // 43: thread.GetMentionsRequest
+	(*GetMentionsResponse)(nil),           // 44: thread.GetMentionsResponse
+	(*GetThreadsByCategoryRequest)(nil),   // 45: thread.GetThreadsByCategoryRequest
+	(*GetThreadsByCategoryResponse)(nil),  // 46: thread.GetThreadsByCategoryResponse
+	(*GetCategoryStatsRequest)(nil),       // 47: thread.GetCategoryStatsRequest
+	(*CategoryStat)(nil),                  // 48: thread.CategoryStat
+	(*GetCategoryStatsResponse)(nil),      // 49: thread.GetCategoryStatsResponse
+	(*CreatePromotedThreadRequest)(nil),   // 50: thread.CreatePromotedThreadRequest
+	(*AdCampaign)(nil),                    // 51: thread.AdCampaign
+	(*GetAdCampaignsRequest)(nil),         // 52: thread.GetAdCampaignsRequest
+	(*GetAdCampaignsResponse)(nil),        // 53: thread.GetAdCampaignsResponse
+	(*RecordAdClickRequest)(nil),          // 54: thread.RecordAdClickRequest
+	(*FilterMutedThreadsRequest)(nil),     // 55: thread.FilterMutedThreadsRequest
+	(*FilterMutedThreadsResponse)(nil),    // 56: thread.FilterMutedThreadsResponse
+	(*GetListThreadsRequest)(nil),         // 57: thread.GetListThreadsRequest
+	(*GetListThreadsResponse)(nil),        // 58: thread.GetListThreadsResponse
+	(*ReplyVisibilityRequest)(nil),        // 59: thread.ReplyVisibilityRequest
+	(*RemoveMentionRequest)(nil),          // 60: thread.RemoveMentionRequest
+	(*UpdateReplyRestrictionRequest)(nil), // 61: thread.UpdateReplyRestrictionRequest
+	(*ModerationAction)(nil),              // 62: thread.ModerationAction
+	(*GetModerationLogRequest)(nil),       // 63: thread.GetModerationLogRequest
+	(*GetModerationLogResponse)(nil),      // 64: thread.GetModerationLogResponse
+	(*ThreadViewEvent)(nil),               // 65: thread.ThreadViewEvent
+	(*RecordThreadEventsRequest)(nil),     // 66: thread.RecordThreadEventsRequest
+	(*GetThreadAnalyticsRequest)(nil),     // 67: thread.GetThreadAnalyticsRequest
+	(*ThreadAnalyticsBucket)(nil),         // 68: thread.ThreadAnalyticsBucket
+	(*ThreadAnalytics)(nil),               // 69: thread.ThreadAnalytics
+	(*ThreadDraft)(nil),                   // 70: thread.ThreadDraft
+	(*SaveDraftRequest)(nil),              // 71: thread.SaveDraftRequest
+	(*GetDraftsRequest)(nil),              // 72: thread.GetDraftsRequest
+	(*GetDraftsResponse)(nil),             // 73: thread.GetDraftsResponse
+	(*DraftActionRequest)(nil),            // 74: thread.DraftActionRequest
+	(*PublishDraftRequest)(nil),           // 75: thread.PublishDraftRequest
+	(*SetThreadSensitivityRequest)(nil),   // 76: thread.SetThreadSensitivityRequest
+	(*ListDeletedThreadsRequest)(nil),     // 77: thread.ListDeletedThreadsRequest
+	(*DeletedThread)(nil),                 // 78: thread.DeletedThread
+	(*ListDeletedThreadsResponse)(nil),    // 79: thread.ListDeletedThreadsResponse
+	(*RestoreThreadRequest)(nil),          // 80: thread.RestoreThreadRequest
+	(*timestamppb.Timestamp)(nil),         // 81: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 82: google.protobuf.Empty
 }
 var file_proto_thread_proto_depIdxs = []int32{
-	0,  // 0: thread.Thread.reply_restriction:type_name -> thread.ReplyRestriction
-	17, // 1: thread.Thread.scheduled_at:type_name -> google.protobuf.Timestamp
-	17, // 2: thread.Thread.posted_at:type_name -> google.protobuf.Timestamp
-	17, // 3: thread.Thread.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: thread.CreateThreadRequest.reply_restriction:type_name -> thread.ReplyRestriction
-	17, // 5: thread.CreateThreadRequest.scheduled_at:type_name -> google.protobuf.Timestamp
-	2,  // 6: thread.GetFeedThreadsResponse.threads:type_name -> thread.Thread
-	2,  // 7: thread.GetUserThreadsResponse.threads:type_name -> thread.Thread
-	2,  // 8: thread.GetCommunityThreadsResponse.threads:type_name -> thread.Thread
-	2,  // 9: thread.GetBookmarkedThreadsResponse.threads:type_name -> thread.Thread
-	2,  // 10: thread.GetRepliesResponse.threads:type_name -> thread.Thread
-	18, // 11: thread.ThreadService.HealthCheck:input_type -> google.protobuf.Empty
-	3,  // 12: thread.ThreadService.CreateThread:input_type -> thread.CreateThreadRequest
-	4,  // 13: thread.ThreadService.GetThread:input_type -> thread.GetThreadRequest
-	5,  // 14: thread.ThreadService.DeleteThread:input_type -> thread.DeleteThreadRequest
-	6,  // 15: thread.ThreadService.LikeThread:input_type -> thread.InteractThreadRequest
-	6,  // 16: thread.ThreadService.UnlikeThread:input_type -> thread.InteractThreadRequest
-	6,  // 17: thread.ThreadService.BookmarkThread:input_type -> thread.InteractThreadRequest
-	6,  // 18: thread.ThreadService.UnbookmarkThread:input_type -> thread.InteractThreadRequest
-	7,  // 19: thread.ThreadService.GetFeedThreads:input_type -> thread.GetFeedThreadsRequest
-	9,  // 20: thread.ThreadService.GetUserThreads:input_type -> thread.GetUserThreadsRequest
-	13, // 21: thread.ThreadService.GetBookmarkedThreads:input_type -> thread.GetBookmarkedThreadsRequest
-	11, // 22: thread.ThreadService.GetCommunityThreads:input_type -> thread.GetCommunityThreadsRequest
-	15, // 23: thread.ThreadService.GetReplies:input_type -> thread.GetRepliesRequest
-	1,  // 24: thread.ThreadService.HealthCheck:output_type -> thread.HealthResponse
-	2,  // 25: thread.ThreadService.CreateThread:output_type -> thread.Thread
-	2,  // 26: thread.ThreadService.GetThread:output_type -> thread.Thread
-	18, // 27: thread.ThreadService.DeleteThread:output_type -> google.protobuf.Empty
-	18, // 28: thread.ThreadService.LikeThread:output_type -> google.protobuf.Empty
-	18, // 29: thread.ThreadService.UnlikeThread:output_type -> google.protobuf.Empty
-	18, // 30: thread.ThreadService.BookmarkThread:output_type -> google.protobuf.Empty
-	18, // 31: thread.ThreadService.UnbookmarkThread:output_type -> google.protobuf.Empty
-	8,  // 32: thread.ThreadService.GetFeedThreads:output_type -> thread.GetFeedThreadsResponse
-	10, // 33: thread.ThreadService.GetUserThreads:output_type -> thread.GetUserThreadsResponse
-	14, // 34: thread.ThreadService.GetBookmarkedThreads:output_type -> thread.GetBookmarkedThreadsResponse
-	12, // 35: thread.ThreadService.GetCommunityThreads:output_type -> thread.GetCommunityThreadsResponse
-	16, // 36: thread.ThreadService.GetReplies:output_type -> thread.GetRepliesResponse
-	24, // [24:37] is the sub-list for method output_type
-	11, // [11:24] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	0,   // 0: thread.Thread.reply_restriction:type_name -> thread.ReplyRestriction
+	81,  // 1: thread.Thread.scheduled_at:type_name -> google.protobuf.Timestamp
+	81,  // 2: thread.Thread.posted_at:type_name -> google.protobuf.Timestamp
+	81,  // 3: thread.Thread.created_at:type_name -> google.protobuf.Timestamp
+	2,   // 4: thread.Thread.quoted_thread:type_name -> thread.Thread
+	81,  // 5: thread.Thread.reposted_at:type_name -> google.protobuf.Timestamp
+	81,  // 6: thread.Thread.edited_at:type_name -> google.protobuf.Timestamp
+	35,  // 7: thread.Thread.poll:type_name -> thread.Poll
+	3,   // 8: thread.Thread.link_preview:type_name -> thread.LinkPreview
+	0,   // 9: thread.CreateThreadRequest.reply_restriction:type_name -> thread.ReplyRestriction
+	81,  // 10: thread.CreateThreadRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	81,  // 11: thread.CreateThreadRequest.poll_closes_at:type_name -> google.protobuf.Timestamp
+	5,   // 12: thread.CreateThreadChainRequest.posts:type_name -> thread.ChainPost
+	0,   // 13: thread.CreateThreadChainRequest.reply_restriction:type_name -> thread.ReplyRestriction
+	81,  // 14: thread.CreateThreadChainRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	2,   // 15: thread.CreateThreadChainResponse.threads:type_name -> thread.Thread
+	2,   // 16: thread.GetFeedThreadsResponse.threads:type_name -> thread.Thread
+	2,   // 17: thread.GetUserThreadsResponse.threads:type_name -> thread.Thread
+	2,   // 18: thread.GetCommunityThreadsResponse.threads:type_name -> thread.Thread
+	2,   // 19: thread.GetBookmarkedThreadsResponse.threads:type_name -> thread.Thread
+	2,   // 20: thread.GetRepliesResponse.threads:type_name -> thread.Thread
+	2,   // 21: thread.GetScheduledThreadsResponse.threads:type_name -> thread.Thread
+	81,  // 22: thread.RescheduleThreadRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	2,   // 23: thread.GetQuotesResponse.threads:type_name -> thread.Thread
+	81,  // 24: thread.ThreadRevision.created_at:type_name -> google.protobuf.Timestamp
+	28,  // 25: thread.GetThreadRevisionsResponse.revisions:type_name -> thread.ThreadRevision
+	2,   // 26: thread.ConversationNode.thread:type_name -> thread.Thread
+	32,  // 27: thread.ConversationNode.replies:type_name -> thread.ConversationNode
+	2,   // 28: thread.GetConversationResponse.ancestors:type_name -> thread.Thread
+	32,  // 29: thread.GetConversationResponse.focus:type_name -> thread.ConversationNode
+	34,  // 30: thread.Poll.options:type_name -> thread.PollOption
+	81,  // 31: thread.Poll.closes_at:type_name -> google.protobuf.Timestamp
+	2,   // 32: thread.GetThreadsByHashtagResponse.threads:type_name -> thread.Thread
+	41,  // 33: thread.GetHashtagStatsResponse.related:type_name -> thread.RelatedHashtag
+	2,   // 34: thread.GetMentionsResponse.threads:type_name -> thread.Thread
+	2,   // 35: thread.GetThreadsByCategoryResponse.threads:type_name -> thread.Thread
+	48,  // 36: thread.GetCategoryStatsResponse.stats:type_name -> thread.CategoryStat
+	4,   // 37: thread.CreatePromotedThreadRequest.thread:type_name -> thread.CreateThreadRequest
+	81,  // 38: thread.CreatePromotedThreadRequest.starts_at:type_name -> google.protobuf.Timestamp
+	81,  // 39: thread.CreatePromotedThreadRequest.ends_at:type_name -> google.protobuf.Timestamp
+	81,  // 40: thread.AdCampaign.starts_at:type_name -> google.protobuf.Timestamp
+	81,  // 41: thread.AdCampaign.ends_at:type_name -> google.protobuf.Timestamp
+	2,   // 42: thread.AdCampaign.thread:type_name -> thread.Thread
+	81,  // 43: thread.AdCampaign.created_at:type_name -> google.protobuf.Timestamp
+	51,  // 44: thread.GetAdCampaignsResponse.campaigns:type_name -> thread.AdCampaign
+	2,   // 45: thread.GetListThreadsResponse.threads:type_name -> thread.Thread
+	0,   // 46: thread.UpdateReplyRestrictionRequest.reply_restriction:type_name -> thread.ReplyRestriction
+	81,  // 47: thread.ModerationAction.created_at:type_name -> google.protobuf.Timestamp
+	62,  // 48: thread.GetModerationLogResponse.actions:type_name -> thread.ModerationAction
+	81,  // 49: thread.ThreadViewEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	65,  // 50: thread.RecordThreadEventsRequest.events:type_name -> thread.ThreadViewEvent
+	81,  // 51: thread.ThreadAnalyticsBucket.hour:type_name -> google.protobuf.Timestamp
+	68,  // 52: thread.ThreadAnalytics.buckets:type_name -> thread.ThreadAnalyticsBucket
+	0,   // 53: thread.ThreadDraft.reply_restriction:type_name -> thread.ReplyRestriction
+	81,  // 54: thread.ThreadDraft.created_at:type_name -> google.protobuf.Timestamp
+	81,  // 55: thread.ThreadDraft.updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 56: thread.SaveDraftRequest.reply_restriction:type_name -> thread.ReplyRestriction
+	70,  // 57: thread.GetDraftsResponse.drafts:type_name -> thread.ThreadDraft
+	81,  // 58: thread.PublishDraftRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	2,   // 59: thread.DeletedThread.thread:type_name -> thread.Thread
+	81,  // 60: thread.DeletedThread.deleted_at:type_name -> google.protobuf.Timestamp
+	81,  // 61: thread.DeletedThread.purge_at:type_name -> google.protobuf.Timestamp
+	78,  // 62: thread.ListDeletedThreadsResponse.threads:type_name -> thread.DeletedThread
+	82,  // 63: thread.ThreadService.HealthCheck:input_type -> google.protobuf.Empty
+	4,   // 64: thread.ThreadService.CreateThread:input_type -> thread.CreateThreadRequest
+	6,   // 65: thread.ThreadService.CreateThreadChain:input_type -> thread.CreateThreadChainRequest
+	8,   // 66: thread.ThreadService.GetThread:input_type -> thread.GetThreadRequest
+	9,   // 67: thread.ThreadService.DeleteThread:input_type -> thread.DeleteThreadRequest
+	10,  // 68: thread.ThreadService.LikeThread:input_type -> thread.InteractThreadRequest
+	10,  // 69: thread.ThreadService.UnlikeThread:input_type -> thread.InteractThreadRequest
+	10,  // 70: thread.ThreadService.BookmarkThread:input_type -> thread.InteractThreadRequest
+	10,  // 71: thread.ThreadService.UnbookmarkThread:input_type -> thread.InteractThreadRequest
+	11,  // 72: thread.ThreadService.GetFeedThreads:input_type -> thread.GetFeedThreadsRequest
+	13,  // 73: thread.ThreadService.GetUserThreads:input_type -> thread.GetUserThreadsRequest
+	17,  // 74: thread.ThreadService.GetBookmarkedThreads:input_type -> thread.GetBookmarkedThreadsRequest
+	15,  // 75: thread.ThreadService.GetCommunityThreads:input_type -> thread.GetCommunityThreadsRequest
+	19,  // 76: thread.ThreadService.GetReplies:input_type -> thread.GetRepliesRequest
+	21,  // 77: thread.ThreadService.GetScheduledThreads:input_type -> thread.GetScheduledThreadsRequest
+	23,  // 78: thread.ThreadService.RescheduleThread:input_type -> thread.RescheduleThreadRequest
+	24,  // 79: thread.ThreadService.CancelScheduledThread:input_type -> thread.CancelScheduledThreadRequest
+	10,  // 80: thread.ThreadService.Repost:input_type -> thread.InteractThreadRequest
+	10,  // 81: thread.ThreadService.Unrepost:input_type -> thread.InteractThreadRequest
+	25,  // 82: thread.ThreadService.GetQuotes:input_type -> thread.GetQuotesRequest
+	27,  // 83: thread.ThreadService.EditThread:input_type -> thread.EditThreadRequest
+	29,  // 84: thread.ThreadService.GetThreadRevisions:input_type -> thread.GetThreadRevisionsRequest
+	31,  // 85: thread.ThreadService.GetConversation:input_type -> thread.GetConversationRequest
+	36,  // 86: thread.ThreadService.VotePoll:input_type -> thread.VotePollRequest
+	37,  // 87: thread.ThreadService.GetPollResults:input_type -> thread.GetPollResultsRequest
+	38,  // 88: thread.ThreadService.GetThreadsByHashtag:input_type -> thread.GetThreadsByHashtagRequest
+	40,  // 89: thread.ThreadService.GetHashtagStats:input_type -> thread.GetHashtagStatsRequest
+	43,  // 90: thread.ThreadService.GetMentions:input_type -> thread.GetMentionsRequest
+	45,  // 91: thread.ThreadService.GetThreadsByCategory:input_type -> thread.GetThreadsByCategoryRequest
+	47,  // 92: thread.ThreadService.GetCategoryStats:input_type -> thread.GetCategoryStatsRequest
+	50,  // 93: thread.ThreadService.CreatePromotedThread:input_type -> thread.CreatePromotedThreadRequest
+	52,  // 94: thread.ThreadService.GetAdCampaigns:input_type -> thread.GetAdCampaignsRequest
+	54,  // 95: thread.ThreadService.RecordAdClick:input_type -> thread.RecordAdClickRequest
+	55,  // 96: thread.ThreadService.FilterMutedThreads:input_type -> thread.FilterMutedThreadsRequest
+	57,  // 97: thread.ThreadService.GetListThreads:input_type -> thread.GetListThreadsRequest
+	59,  // 98: thread.ThreadService.HideReply:input_type -> thread.ReplyVisibilityRequest
+	59,  // 99: thread.ThreadService.UnhideReply:input_type -> thread.ReplyVisibilityRequest
+	19,  // 100: thread.ThreadService.GetHiddenReplies:input_type -> thread.GetRepliesRequest
+	60,  // 101: thread.ThreadService.RemoveMention:input_type -> thread.RemoveMentionRequest
+	61,  // 102: thread.ThreadService.UpdateReplyRestriction:input_type -> thread.UpdateReplyRestrictionRequest
+	63,  // 103: thread.ThreadService.GetModerationLog:input_type -> thread.GetModerationLogRequest
+	66,  // 104: thread.ThreadService.RecordThreadEvents:input_type -> thread.RecordThreadEventsRequest
+	67,  // 105: thread.ThreadService.GetThreadAnalytics:input_type -> thread.GetThreadAnalyticsRequest
+	71,  // 106: thread.ThreadService.CreateDraft:input_type -> thread.SaveDraftRequest
+	71,  // 107: thread.ThreadService.UpdateDraft:input_type -> thread.SaveDraftRequest
+	72,  // 108: thread.ThreadService.GetDrafts:input_type -> thread.GetDraftsRequest
+	74,  // 109: thread.ThreadService.DeleteDraft:input_type -> thread.DraftActionRequest
+	75,  // 110: thread.ThreadService.PublishDraft:input_type -> thread.PublishDraftRequest
+	76,  // 111: thread.ThreadService.SetThreadSensitivity:input_type -> thread.SetThreadSensitivityRequest
+	77,  // 112: thread.ThreadService.ListDeletedThreads:input_type -> thread.ListDeletedThreadsRequest
+	80,  // 113: thread.ThreadService.RestoreThread:input_type -> thread.RestoreThreadRequest
+	1,   // 114: thread.ThreadService.HealthCheck:output_type -> thread.HealthResponse
+	2,   // 115: thread.ThreadService.CreateThread:output_type -> thread.Thread
+	7,   // 116: thread.ThreadService.CreateThreadChain:output_type -> thread.CreateThreadChainResponse
+	2,   // 117: thread.ThreadService.GetThread:output_type -> thread.Thread
+	82,  // 118: thread.ThreadService.DeleteThread:output_type -> google.protobuf.Empty
+	82,  // 119: thread.ThreadService.LikeThread:output_type -> google.protobuf.Empty
+	82,  // 120: thread.ThreadService.UnlikeThread:output_type -> google.protobuf.Empty
+	82,  // 121: thread.ThreadService.BookmarkThread:output_type -> google.protobuf.Empty
+	82,  // 122: thread.ThreadService.UnbookmarkThread:output_type -> google.protobuf.Empty
+	12,  // 123: thread.ThreadService.GetFeedThreads:output_type -> thread.GetFeedThreadsResponse
+	14,  // 124: thread.ThreadService.GetUserThreads:output_type -> thread.GetUserThreadsResponse
+	18,  // 125: thread.ThreadService.GetBookmarkedThreads:output_type -> thread.GetBookmarkedThreadsResponse
+	16,  // 126: thread.ThreadService.GetCommunityThreads:output_type -> thread.GetCommunityThreadsResponse
+	20,  // 127: thread.ThreadService.GetReplies:output_type -> thread.GetRepliesResponse
+	22,  // 128: thread.ThreadService.GetScheduledThreads:output_type -> thread.GetScheduledThreadsResponse
+	2,   // 129: thread.ThreadService.RescheduleThread:output_type -> thread.Thread
+	82,  // 130: thread.ThreadService.CancelScheduledThread:output_type -> google.protobuf.Empty
+	82,  // 131: thread.ThreadService.Repost:output_type -> google.protobuf.Empty
+	82,  // 132: thread.ThreadService.Unrepost:output_type -> google.protobuf.Empty
+	26,  // 133: thread.ThreadService.GetQuotes:output_type -> thread.GetQuotesResponse
+	2,   // 134: thread.ThreadService.EditThread:output_type -> thread.Thread
+	30,  // 135: thread.ThreadService.GetThreadRevisions:output_type -> thread.GetThreadRevisionsResponse
+	33,  // 136: thread.ThreadService.GetConversation:output_type -> thread.GetConversationResponse
+	35,  // 137: thread.ThreadService.VotePoll:output_type -> thread.Poll
+	35,  // 138: thread.ThreadService.GetPollResults:output_type -> thread.Poll
+	39,  // 139: thread.ThreadService.GetThreadsByHashtag:output_type -> thread.GetThreadsByHashtagResponse
+	42,  // 140: thread.ThreadService.GetHashtagStats:output_type -> thread.GetHashtagStatsResponse
+	44,  // 141: thread.ThreadService.GetMentions:output_type -> thread.GetMentionsResponse
+	46,  // 142: thread.ThreadService.GetThreadsByCategory:output_type -> thread.GetThreadsByCategoryResponse
+	49,  // 143: thread.ThreadService.GetCategoryStats:output_type -> thread.GetCategoryStatsResponse
+	51,  // 144: thread.ThreadService.CreatePromotedThread:output_type -> thread.AdCampaign
+	53,  // 145: thread.ThreadService.GetAdCampaigns:output_type -> thread.GetAdCampaignsResponse
+	82,  // 146: thread.ThreadService.RecordAdClick:output_type -> google.protobuf.Empty
+	56,  // 147: thread.ThreadService.FilterMutedThreads:output_type -> thread.FilterMutedThreadsResponse
+	58,  // 148: thread.ThreadService.GetListThreads:output_type -> thread.GetListThreadsResponse
+	82,  // 149: thread.ThreadService.HideReply:output_type -> google.protobuf.Empty
+	82,  // 150: thread.ThreadService.UnhideReply:output_type -> google.protobuf.Empty
+	20,  // 151: thread.ThreadService.GetHiddenReplies:output_type -> thread.GetRepliesResponse
+	82,  // 152: thread.ThreadService.RemoveMention:output_type -> google.protobuf.Empty
+	2,   // 153: thread.ThreadService.UpdateReplyRestriction:output_type -> thread.Thread
+	64,  // 154: thread.ThreadService.GetModerationLog:output_type -> thread.GetModerationLogResponse
+	82,  // 155: thread.ThreadService.RecordThreadEvents:output_type -> google.protobuf.Empty
+	69,  // 156: thread.ThreadService.GetThreadAnalytics:output_type -> thread.ThreadAnalytics
+	70,  // 157: thread.ThreadService.CreateDraft:output_type -> thread.ThreadDraft
+	70,  // 158: thread.ThreadService.UpdateDraft:output_type -> thread.ThreadDraft
+	73,  // 159: thread.ThreadService.GetDrafts:output_type -> thread.GetDraftsResponse
+	82,  // 160: thread.ThreadService.DeleteDraft:output_type -> google.protobuf.Empty
+	2,   // 161: thread.ThreadService.PublishDraft:output_type -> thread.Thread
+	2,   // 162: thread.ThreadService.SetThreadSensitivity:output_type -> thread.Thread
+	79,  // 163: thread.ThreadService.ListDeletedThreads:output_type -> thread.ListDeletedThreadsResponse
+	2,   // 164: thread.ThreadService.RestoreThread:output_type -> thread.Thread
+	114, // [114:165] is the sub-list for method output_type
+	63,  // [63:114] is the sub-list for method input_type
+	63,  // [63:63] is the sub-list for extension type_name
+	63,  // [63:63] is the sub-list for extension extendee
+	0,   // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_proto_thread_proto_init() }
@@ -1429,20 +6611,34 @@ func file_proto_thread_proto_init() {
 		return
 	}
 	file_proto_thread_proto_msgTypes[1].OneofWrappers = []any{}
-	file_proto_thread_proto_msgTypes[2].OneofWrappers = []any{}
 	file_proto_thread_proto_msgTypes[3].OneofWrappers = []any{}
-	file_proto_thread_proto_msgTypes[6].OneofWrappers = []any{}
-	file_proto_thread_proto_msgTypes[8].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[5].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[7].OneofWrappers = []any{}
 	file_proto_thread_proto_msgTypes[10].OneofWrappers = []any{}
 	file_proto_thread_proto_msgTypes[12].OneofWrappers = []any{}
 	file_proto_thread_proto_msgTypes[14].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[16].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[18].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[24].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[28].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[30].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[34].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[36].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[37].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[42].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[44].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[53].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[56].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[61].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[69].OneofWrappers = []any{}
+	file_proto_thread_proto_msgTypes[70].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_thread_proto_rawDesc), len(file_proto_thread_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   16,
+			NumMessages:   80,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,19 +20,57 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ThreadService_HealthCheck_FullMethodName          = "/thread.ThreadService/HealthCheck"
-	ThreadService_CreateThread_FullMethodName         = "/thread.ThreadService/CreateThread"
-	ThreadService_GetThread_FullMethodName            = "/thread.ThreadService/GetThread"
-	ThreadService_DeleteThread_FullMethodName         = "/thread.ThreadService/DeleteThread"
-	ThreadService_LikeThread_FullMethodName           = "/thread.ThreadService/LikeThread"
-	ThreadService_UnlikeThread_FullMethodName         = "/thread.ThreadService/UnlikeThread"
-	ThreadService_BookmarkThread_FullMethodName       = "/thread.ThreadService/BookmarkThread"
-	ThreadService_UnbookmarkThread_FullMethodName     = "/thread.ThreadService/UnbookmarkThread"
-	ThreadService_GetFeedThreads_FullMethodName       = "/thread.ThreadService/GetFeedThreads"
-	ThreadService_GetUserThreads_FullMethodName       = "/thread.ThreadService/GetUserThreads"
-	ThreadService_GetBookmarkedThreads_FullMethodName = "/thread.ThreadService/GetBookmarkedThreads"
-	ThreadService_GetCommunityThreads_FullMethodName  = "/thread.ThreadService/GetCommunityThreads"
-	ThreadService_GetReplies_FullMethodName           = "/thread.ThreadService/GetReplies"
+	ThreadService_HealthCheck_FullMethodName            = "/thread.ThreadService/HealthCheck"
+	ThreadService_CreateThread_FullMethodName           = "/thread.ThreadService/CreateThread"
+	ThreadService_CreateThreadChain_FullMethodName      = "/thread.ThreadService/CreateThreadChain"
+	ThreadService_GetThread_FullMethodName              = "/thread.ThreadService/GetThread"
+	ThreadService_DeleteThread_FullMethodName           = "/thread.ThreadService/DeleteThread"
+	ThreadService_LikeThread_FullMethodName             = "/thread.ThreadService/LikeThread"
+	ThreadService_UnlikeThread_FullMethodName           = "/thread.ThreadService/UnlikeThread"
+	ThreadService_BookmarkThread_FullMethodName         = "/thread.ThreadService/BookmarkThread"
+	ThreadService_UnbookmarkThread_FullMethodName       = "/thread.ThreadService/UnbookmarkThread"
+	ThreadService_GetFeedThreads_FullMethodName         = "/thread.ThreadService/GetFeedThreads"
+	ThreadService_GetUserThreads_FullMethodName         = "/thread.ThreadService/GetUserThreads"
+	ThreadService_GetBookmarkedThreads_FullMethodName   = "/thread.ThreadService/GetBookmarkedThreads"
+	ThreadService_GetCommunityThreads_FullMethodName    = "/thread.ThreadService/GetCommunityThreads"
+	ThreadService_GetReplies_FullMethodName             = "/thread.ThreadService/GetReplies"
+	ThreadService_GetScheduledThreads_FullMethodName    = "/thread.ThreadService/GetScheduledThreads"
+	ThreadService_RescheduleThread_FullMethodName       = "/thread.ThreadService/RescheduleThread"
+	ThreadService_CancelScheduledThread_FullMethodName  = "/thread.ThreadService/CancelScheduledThread"
+	ThreadService_Repost_FullMethodName                 = "/thread.ThreadService/Repost"
+	ThreadService_Unrepost_FullMethodName               = "/thread.ThreadService/Unrepost"
+	ThreadService_GetQuotes_FullMethodName              = "/thread.ThreadService/GetQuotes"
+	ThreadService_EditThread_FullMethodName             = "/thread.ThreadService/EditThread"
+	ThreadService_GetThreadRevisions_FullMethodName     = "/thread.ThreadService/GetThreadRevisions"
+	ThreadService_GetConversation_FullMethodName        = "/thread.ThreadService/GetConversation"
+	ThreadService_VotePoll_FullMethodName               = "/thread.ThreadService/VotePoll"
+	ThreadService_GetPollResults_FullMethodName         = "/thread.ThreadService/GetPollResults"
+	ThreadService_GetThreadsByHashtag_FullMethodName    = "/thread.ThreadService/GetThreadsByHashtag"
+	ThreadService_GetHashtagStats_FullMethodName        = "/thread.ThreadService/GetHashtagStats"
+	ThreadService_GetMentions_FullMethodName            = "/thread.ThreadService/GetMentions"
+	ThreadService_GetThreadsByCategory_FullMethodName   = "/thread.ThreadService/GetThreadsByCategory"
+	ThreadService_GetCategoryStats_FullMethodName       = "/thread.ThreadService/GetCategoryStats"
+	ThreadService_CreatePromotedThread_FullMethodName   = "/thread.ThreadService/CreatePromotedThread"
+	ThreadService_GetAdCampaigns_FullMethodName         = "/thread.ThreadService/GetAdCampaigns"
+	ThreadService_RecordAdClick_FullMethodName          = "/thread.ThreadService/RecordAdClick"
+	ThreadService_FilterMutedThreads_FullMethodName     = "/thread.ThreadService/FilterMutedThreads"
+	ThreadService_GetListThreads_FullMethodName         = "/thread.ThreadService/GetListThreads"
+	ThreadService_HideReply_FullMethodName              = "/thread.ThreadService/HideReply"
+	ThreadService_UnhideReply_FullMethodName            = "/thread.ThreadService/UnhideReply"
+	ThreadService_GetHiddenReplies_FullMethodName       = "/thread.ThreadService/GetHiddenReplies"
+	ThreadService_RemoveMention_FullMethodName          = "/thread.ThreadService/RemoveMention"
+	ThreadService_UpdateReplyRestriction_FullMethodName = "/thread.ThreadService/UpdateReplyRestriction"
+	ThreadService_GetModerationLog_FullMethodName       = "/thread.ThreadService/GetModerationLog"
+	ThreadService_RecordThreadEvents_FullMethodName     = "/thread.ThreadService/RecordThreadEvents"
+	ThreadService_GetThreadAnalytics_FullMethodName     = "/thread.ThreadService/GetThreadAnalytics"
+	ThreadService_CreateDraft_FullMethodName            = "/thread.ThreadService/CreateDraft"
+	ThreadService_UpdateDraft_FullMethodName            = "/thread.ThreadService/UpdateDraft"
+	ThreadService_GetDrafts_FullMethodName              = "/thread.ThreadService/GetDrafts"
+	ThreadService_DeleteDraft_FullMethodName            = "/thread.ThreadService/DeleteDraft"
+	ThreadService_PublishDraft_FullMethodName           = "/thread.ThreadService/PublishDraft"
+	ThreadService_SetThreadSensitivity_FullMethodName   = "/thread.ThreadService/SetThreadSensitivity"
+	ThreadService_ListDeletedThreads_FullMethodName     = "/thread.ThreadService/ListDeletedThreads"
+	ThreadService_RestoreThread_FullMethodName          = "/thread.ThreadService/RestoreThread"
 )
 
 // ThreadServiceClient is the client API for ThreadService service.
@@ -41,6 +79,7 @@ const (
 type ThreadServiceClient interface {
 	HealthCheck(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthResponse, error)
 	CreateThread(ctx context.Context, in *CreateThreadRequest, opts ...grpc.CallOption) (*Thread, error)
+	CreateThreadChain(ctx context.Context, in *CreateThreadChainRequest, opts ...grpc.CallOption) (*CreateThreadChainResponse, error)
 	GetThread(ctx context.Context, in *GetThreadRequest, opts ...grpc.CallOption) (*Thread, error)
 	DeleteThread(ctx context.Context, in *DeleteThreadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	LikeThread(ctx context.Context, in *InteractThreadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -52,6 +91,43 @@ type ThreadServiceClient interface {
 	GetBookmarkedThreads(ctx context.Context, in *GetBookmarkedThreadsRequest, opts ...grpc.CallOption) (*GetBookmarkedThreadsResponse, error)
 	GetCommunityThreads(ctx context.Context, in *GetCommunityThreadsRequest, opts ...grpc.CallOption) (*GetCommunityThreadsResponse, error)
 	GetReplies(ctx context.Context, in *GetRepliesRequest, opts ...grpc.CallOption) (*GetRepliesResponse, error)
+	GetScheduledThreads(ctx context.Context, in *GetScheduledThreadsRequest, opts ...grpc.CallOption) (*GetScheduledThreadsResponse, error)
+	RescheduleThread(ctx context.Context, in *RescheduleThreadRequest, opts ...grpc.CallOption) (*Thread, error)
+	CancelScheduledThread(ctx context.Context, in *CancelScheduledThreadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Repost(ctx context.Context, in *InteractThreadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Unrepost(ctx context.Context, in *InteractThreadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetQuotes(ctx context.Context, in *GetQuotesRequest, opts ...grpc.CallOption) (*GetQuotesResponse, error)
+	EditThread(ctx context.Context, in *EditThreadRequest, opts ...grpc.CallOption) (*Thread, error)
+	GetThreadRevisions(ctx context.Context, in *GetThreadRevisionsRequest, opts ...grpc.CallOption) (*GetThreadRevisionsResponse, error)
+	GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*GetConversationResponse, error)
+	VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*Poll, error)
+	GetPollResults(ctx context.Context, in *GetPollResultsRequest, opts ...grpc.CallOption) (*Poll, error)
+	GetThreadsByHashtag(ctx context.Context, in *GetThreadsByHashtagRequest, opts ...grpc.CallOption) (*GetThreadsByHashtagResponse, error)
+	GetHashtagStats(ctx context.Context, in *GetHashtagStatsRequest, opts ...grpc.CallOption) (*GetHashtagStatsResponse, error)
+	GetMentions(ctx context.Context, in *GetMentionsRequest, opts ...grpc.CallOption) (*GetMentionsResponse, error)
+	GetThreadsByCategory(ctx context.Context, in *GetThreadsByCategoryRequest, opts ...grpc.CallOption) (*GetThreadsByCategoryResponse, error)
+	GetCategoryStats(ctx context.Context, in *GetCategoryStatsRequest, opts ...grpc.CallOption) (*GetCategoryStatsResponse, error)
+	CreatePromotedThread(ctx context.Context, in *CreatePromotedThreadRequest, opts ...grpc.CallOption) (*AdCampaign, error)
+	GetAdCampaigns(ctx context.Context, in *GetAdCampaignsRequest, opts ...grpc.CallOption) (*GetAdCampaignsResponse, error)
+	RecordAdClick(ctx context.Context, in *RecordAdClickRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	FilterMutedThreads(ctx context.Context, in *FilterMutedThreadsRequest, opts ...grpc.CallOption) (*FilterMutedThreadsResponse, error)
+	GetListThreads(ctx context.Context, in *GetListThreadsRequest, opts ...grpc.CallOption) (*GetListThreadsResponse, error)
+	HideReply(ctx context.Context, in *ReplyVisibilityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnhideReply(ctx context.Context, in *ReplyVisibilityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetHiddenReplies(ctx context.Context, in *GetRepliesRequest, opts ...grpc.CallOption) (*GetRepliesResponse, error)
+	RemoveMention(ctx context.Context, in *RemoveMentionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateReplyRestriction(ctx context.Context, in *UpdateReplyRestrictionRequest, opts ...grpc.CallOption) (*Thread, error)
+	GetModerationLog(ctx context.Context, in *GetModerationLogRequest, opts ...grpc.CallOption) (*GetModerationLogResponse, error)
+	RecordThreadEvents(ctx context.Context, in *RecordThreadEventsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetThreadAnalytics(ctx context.Context, in *GetThreadAnalyticsRequest, opts ...grpc.CallOption) (*ThreadAnalytics, error)
+	CreateDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*ThreadDraft, error)
+	UpdateDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*ThreadDraft, error)
+	GetDrafts(ctx context.Context, in *GetDraftsRequest, opts ...grpc.CallOption) (*GetDraftsResponse, error)
+	DeleteDraft(ctx context.Context, in *DraftActionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*Thread, error)
+	SetThreadSensitivity(ctx context.Context, in *SetThreadSensitivityRequest, opts ...grpc.CallOption) (*Thread, error)
+	ListDeletedThreads(ctx context.Context, in *ListDeletedThreadsRequest, opts ...grpc.CallOption) (*ListDeletedThreadsResponse, error)
+	RestoreThread(ctx context.Context, in *RestoreThreadRequest, opts ...grpc.CallOption) (*Thread, error)
 }
 
 type threadServiceClient struct {
@@ -82,6 +158,16 @@ func (c *threadServiceClient) CreateThread(ctx context.Context, in *CreateThread
 	return out, nil
 }
 
+func (c *threadServiceClient) CreateThreadChain(ctx context.Context, in *CreateThreadChainRequest, opts ...grpc.CallOption) (*CreateThreadChainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateThreadChainResponse)
+	err := c.cc.Invoke(ctx, ThreadService_CreateThreadChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *threadServiceClient) GetThread(ctx context.Context, in *GetThreadRequest, opts ...grpc.CallOption) (*Thread, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Thread)
@@ -192,323 +278,1529 @@ func (c *threadServiceClient) GetReplies(ctx context.Context, in *GetRepliesRequ
 	return out, nil
 }
 
-// ThreadServiceServer is the server API for ThreadService service.
-// All implementations must embed UnimplementedThreadServiceServer
-// for forward compatibility.
-type ThreadServiceServer interface {
-	HealthCheck(context.Context, *emptypb.Empty) (*HealthResponse, error)
-	CreateThread(context.Context, *CreateThreadRequest) (*Thread, error)
-	GetThread(context.Context, *GetThreadRequest) (*Thread, error)
-	DeleteThread(context.Context, *DeleteThreadRequest) (*emptypb.Empty, error)
-	LikeThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error)
-	UnlikeThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error)
-	BookmarkThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error)
-	UnbookmarkThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error)
-	GetFeedThreads(context.Context, *GetFeedThreadsRequest) (*GetFeedThreadsResponse, error)
-	GetUserThreads(context.Context, *GetUserThreadsRequest) (*GetUserThreadsResponse, error)
-	GetBookmarkedThreads(context.Context, *GetBookmarkedThreadsRequest) (*GetBookmarkedThreadsResponse, error)
-	GetCommunityThreads(context.Context, *GetCommunityThreadsRequest) (*GetCommunityThreadsResponse, error)
-	GetReplies(context.Context, *GetRepliesRequest) (*GetRepliesResponse, error)
-	mustEmbedUnimplementedThreadServiceServer()
+func (c *threadServiceClient) GetScheduledThreads(ctx context.Context, in *GetScheduledThreadsRequest, opts ...grpc.CallOption) (*GetScheduledThreadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScheduledThreadsResponse)
+	err := c.cc.Invoke(ctx, ThreadService_GetScheduledThreads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedThreadServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedThreadServiceServer struct{}
+func (c *threadServiceClient) RescheduleThread(ctx context.Context, in *RescheduleThreadRequest, opts ...grpc.CallOption) (*Thread, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Thread)
+	err := c.cc.Invoke(ctx, ThreadService_RescheduleThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedThreadServiceServer) HealthCheck(context.Context, *emptypb.Empty) (*HealthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+func (c *threadServiceClient) CancelScheduledThread(ctx context.Context, in *CancelScheduledThreadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ThreadService_CancelScheduledThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedThreadServiceServer) CreateThread(context.Context, *CreateThreadRequest) (*Thread, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateThread not implemented")
+
+func (c *threadServiceClient) Repost(ctx context.Context, in *InteractThreadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ThreadService_Repost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedThreadServiceServer) GetThread(context.Context, *GetThreadRequest) (*Thread, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetThread not implemented")
+
+func (c *threadServiceClient) Unrepost(ctx context.Context, in *InteractThreadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ThreadService_Unrepost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedThreadServiceServer) DeleteThread(context.Context, *DeleteThreadRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteThread not implemented")
+
+func (c *threadServiceClient) GetQuotes(ctx context.Context, in *GetQuotesRequest, opts ...grpc.CallOption) (*GetQuotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuotesResponse)
+	err := c.cc.Invoke(ctx, ThreadService_GetQuotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedThreadServiceServer) LikeThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LikeThread not implemented")
+
+func (c *threadServiceClient) EditThread(ctx context.Context, in *EditThreadRequest, opts ...grpc.CallOption) (*Thread, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Thread)
+	err := c.cc.Invoke(ctx, ThreadService_EditThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedThreadServiceServer) UnlikeThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnlikeThread not implemented")
+
+func (c *threadServiceClient) GetThreadRevisions(ctx context.Context, in *GetThreadRevisionsRequest, opts ...grpc.CallOption) (*GetThreadRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetThreadRevisionsResponse)
+	err := c.cc.Invoke(ctx, ThreadService_GetThreadRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedThreadServiceServer) BookmarkThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BookmarkThread not implemented")
+
+func (c *threadServiceClient) GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*GetConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConversationResponse)
+	err := c.cc.Invoke(ctx, ThreadService_GetConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedThreadServiceServer) UnbookmarkThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnbookmarkThread not implemented")
+
+func (c *threadServiceClient) VotePoll(ctx context.Context, in *VotePollRequest, opts ...grpc.CallOption) (*Poll, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Poll)
+	err := c.cc.Invoke(ctx, ThreadService_VotePoll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) GetPollResults(ctx context.Context, in *GetPollResultsRequest, opts ...grpc.CallOption) (*Poll, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Poll)
+	err := c.cc.Invoke(ctx, ThreadService_GetPollResults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) GetThreadsByHashtag(ctx context.Context, in *GetThreadsByHashtagRequest, opts ...grpc.CallOption) (*GetThreadsByHashtagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetThreadsByHashtagResponse)
+	err := c.cc.Invoke(ctx, ThreadService_GetThreadsByHashtag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) GetHashtagStats(ctx context.Context, in *GetHashtagStatsRequest, opts ...grpc.CallOption) (*GetHashtagStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHashtagStatsResponse)
+	err := c.cc.Invoke(ctx, ThreadService_GetHashtagStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) GetMentions(ctx context.Context, in *GetMentionsRequest, opts ...grpc.CallOption) (*GetMentionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMentionsResponse)
+	err := c.cc.Invoke(ctx, ThreadService_GetMentions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) GetThreadsByCategory(ctx context.Context, in *GetThreadsByCategoryRequest, opts ...grpc.CallOption) (*GetThreadsByCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetThreadsByCategoryResponse)
+	err := c.cc.Invoke(ctx, ThreadService_GetThreadsByCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) GetCategoryStats(ctx context.Context, in *GetCategoryStatsRequest, opts ...grpc.CallOption) (*GetCategoryStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryStatsResponse)
+	err := c.cc.Invoke(ctx, ThreadService_GetCategoryStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) CreatePromotedThread(ctx context.Context, in *CreatePromotedThreadRequest, opts ...grpc.CallOption) (*AdCampaign, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdCampaign)
+	err := c.cc.Invoke(ctx, ThreadService_CreatePromotedThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) GetAdCampaigns(ctx context.Context, in *GetAdCampaignsRequest, opts ...grpc.CallOption) (*GetAdCampaignsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAdCampaignsResponse)
+	err := c.cc.Invoke(ctx, ThreadService_GetAdCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) RecordAdClick(ctx context.Context, in *RecordAdClickRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ThreadService_RecordAdClick_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) FilterMutedThreads(ctx context.Context, in *FilterMutedThreadsRequest, opts ...grpc.CallOption) (*FilterMutedThreadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilterMutedThreadsResponse)
+	err := c.cc.Invoke(ctx, ThreadService_FilterMutedThreads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) GetListThreads(ctx context.Context, in *GetListThreadsRequest, opts ...grpc.CallOption) (*GetListThreadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetListThreadsResponse)
+	err := c.cc.Invoke(ctx, ThreadService_GetListThreads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) HideReply(ctx context.Context, in *ReplyVisibilityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ThreadService_HideReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) UnhideReply(ctx context.Context, in *ReplyVisibilityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ThreadService_UnhideReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) GetHiddenReplies(ctx context.Context, in *GetRepliesRequest, opts ...grpc.CallOption) (*GetRepliesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRepliesResponse)
+	err := c.cc.Invoke(ctx, ThreadService_GetHiddenReplies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) RemoveMention(ctx context.Context, in *RemoveMentionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ThreadService_RemoveMention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) UpdateReplyRestriction(ctx context.Context, in *UpdateReplyRestrictionRequest, opts ...grpc.CallOption) (*Thread, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Thread)
+	err := c.cc.Invoke(ctx, ThreadService_UpdateReplyRestriction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) GetModerationLog(ctx context.Context, in *GetModerationLogRequest, opts ...grpc.CallOption) (*GetModerationLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModerationLogResponse)
+	err := c.cc.Invoke(ctx, ThreadService_GetModerationLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) RecordThreadEvents(ctx context.Context, in *RecordThreadEventsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ThreadService_RecordThreadEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) GetThreadAnalytics(ctx context.Context, in *GetThreadAnalyticsRequest, opts ...grpc.CallOption) (*ThreadAnalytics, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ThreadAnalytics)
+	err := c.cc.Invoke(ctx, ThreadService_GetThreadAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) CreateDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*ThreadDraft, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ThreadDraft)
+	err := c.cc.Invoke(ctx, ThreadService_CreateDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) UpdateDraft(ctx context.Context, in *SaveDraftRequest, opts ...grpc.CallOption) (*ThreadDraft, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ThreadDraft)
+	err := c.cc.Invoke(ctx, ThreadService_UpdateDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) GetDrafts(ctx context.Context, in *GetDraftsRequest, opts ...grpc.CallOption) (*GetDraftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDraftsResponse)
+	err := c.cc.Invoke(ctx, ThreadService_GetDrafts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) DeleteDraft(ctx context.Context, in *DraftActionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ThreadService_DeleteDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) PublishDraft(ctx context.Context, in *PublishDraftRequest, opts ...grpc.CallOption) (*Thread, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Thread)
+	err := c.cc.Invoke(ctx, ThreadService_PublishDraft_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) SetThreadSensitivity(ctx context.Context, in *SetThreadSensitivityRequest, opts ...grpc.CallOption) (*Thread, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Thread)
+	err := c.cc.Invoke(ctx, ThreadService_SetThreadSensitivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) ListDeletedThreads(ctx context.Context, in *ListDeletedThreadsRequest, opts ...grpc.CallOption) (*ListDeletedThreadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedThreadsResponse)
+	err := c.cc.Invoke(ctx, ThreadService_ListDeletedThreads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *threadServiceClient) RestoreThread(ctx context.Context, in *RestoreThreadRequest, opts ...grpc.CallOption) (*Thread, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Thread)
+	err := c.cc.Invoke(ctx, ThreadService_RestoreThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ThreadServiceServer is the server API for ThreadService service.
+// All implementations must embed UnimplementedThreadServiceServer
+// for forward compatibility.
+type ThreadServiceServer interface {
+	HealthCheck(context.Context, *emptypb.Empty) (*HealthResponse, error)
+	CreateThread(context.Context, *CreateThreadRequest) (*Thread, error)
+	CreateThreadChain(context.Context, *CreateThreadChainRequest) (*CreateThreadChainResponse, error)
+	GetThread(context.Context, *GetThreadRequest) (*Thread, error)
+	DeleteThread(context.Context, *DeleteThreadRequest) (*emptypb.Empty, error)
+	LikeThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error)
+	UnlikeThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error)
+	BookmarkThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error)
+	UnbookmarkThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error)
+	GetFeedThreads(context.Context, *GetFeedThreadsRequest) (*GetFeedThreadsResponse, error)
+	GetUserThreads(context.Context, *GetUserThreadsRequest) (*GetUserThreadsResponse, error)
+	GetBookmarkedThreads(context.Context, *GetBookmarkedThreadsRequest) (*GetBookmarkedThreadsResponse, error)
+	GetCommunityThreads(context.Context, *GetCommunityThreadsRequest) (*GetCommunityThreadsResponse, error)
+	GetReplies(context.Context, *GetRepliesRequest) (*GetRepliesResponse, error)
+	GetScheduledThreads(context.Context, *GetScheduledThreadsRequest) (*GetScheduledThreadsResponse, error)
+	RescheduleThread(context.Context, *RescheduleThreadRequest) (*Thread, error)
+	CancelScheduledThread(context.Context, *CancelScheduledThreadRequest) (*emptypb.Empty, error)
+	Repost(context.Context, *InteractThreadRequest) (*emptypb.Empty, error)
+	Unrepost(context.Context, *InteractThreadRequest) (*emptypb.Empty, error)
+	GetQuotes(context.Context, *GetQuotesRequest) (*GetQuotesResponse, error)
+	EditThread(context.Context, *EditThreadRequest) (*Thread, error)
+	GetThreadRevisions(context.Context, *GetThreadRevisionsRequest) (*GetThreadRevisionsResponse, error)
+	GetConversation(context.Context, *GetConversationRequest) (*GetConversationResponse, error)
+	VotePoll(context.Context, *VotePollRequest) (*Poll, error)
+	GetPollResults(context.Context, *GetPollResultsRequest) (*Poll, error)
+	GetThreadsByHashtag(context.Context, *GetThreadsByHashtagRequest) (*GetThreadsByHashtagResponse, error)
+	GetHashtagStats(context.Context, *GetHashtagStatsRequest) (*GetHashtagStatsResponse, error)
+	GetMentions(context.Context, *GetMentionsRequest) (*GetMentionsResponse, error)
+	GetThreadsByCategory(context.Context, *GetThreadsByCategoryRequest) (*GetThreadsByCategoryResponse, error)
+	GetCategoryStats(context.Context, *GetCategoryStatsRequest) (*GetCategoryStatsResponse, error)
+	CreatePromotedThread(context.Context, *CreatePromotedThreadRequest) (*AdCampaign, error)
+	GetAdCampaigns(context.Context, *GetAdCampaignsRequest) (*GetAdCampaignsResponse, error)
+	RecordAdClick(context.Context, *RecordAdClickRequest) (*emptypb.Empty, error)
+	FilterMutedThreads(context.Context, *FilterMutedThreadsRequest) (*FilterMutedThreadsResponse, error)
+	GetListThreads(context.Context, *GetListThreadsRequest) (*GetListThreadsResponse, error)
+	HideReply(context.Context, *ReplyVisibilityRequest) (*emptypb.Empty, error)
+	UnhideReply(context.Context, *ReplyVisibilityRequest) (*emptypb.Empty, error)
+	GetHiddenReplies(context.Context, *GetRepliesRequest) (*GetRepliesResponse, error)
+	RemoveMention(context.Context, *RemoveMentionRequest) (*emptypb.Empty, error)
+	UpdateReplyRestriction(context.Context, *UpdateReplyRestrictionRequest) (*Thread, error)
+	GetModerationLog(context.Context, *GetModerationLogRequest) (*GetModerationLogResponse, error)
+	RecordThreadEvents(context.Context, *RecordThreadEventsRequest) (*emptypb.Empty, error)
+	GetThreadAnalytics(context.Context, *GetThreadAnalyticsRequest) (*ThreadAnalytics, error)
+	CreateDraft(context.Context, *SaveDraftRequest) (*ThreadDraft, error)
+	UpdateDraft(context.Context, *SaveDraftRequest) (*ThreadDraft, error)
+	GetDrafts(context.Context, *GetDraftsRequest) (*GetDraftsResponse, error)
+	DeleteDraft(context.Context, *DraftActionRequest) (*emptypb.Empty, error)
+	PublishDraft(context.Context, *PublishDraftRequest) (*Thread, error)
+	SetThreadSensitivity(context.Context, *SetThreadSensitivityRequest) (*Thread, error)
+	ListDeletedThreads(context.Context, *ListDeletedThreadsRequest) (*ListDeletedThreadsResponse, error)
+	RestoreThread(context.Context, *RestoreThreadRequest) (*Thread, error)
+	mustEmbedUnimplementedThreadServiceServer()
+}
+
+// UnimplementedThreadServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedThreadServiceServer struct{}
+
+func (UnimplementedThreadServiceServer) HealthCheck(context.Context, *emptypb.Empty) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (UnimplementedThreadServiceServer) CreateThread(context.Context, *CreateThreadRequest) (*Thread, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateThread not implemented")
+}
+func (UnimplementedThreadServiceServer) CreateThreadChain(context.Context, *CreateThreadChainRequest) (*CreateThreadChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateThreadChain not implemented")
+}
+func (UnimplementedThreadServiceServer) GetThread(context.Context, *GetThreadRequest) (*Thread, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThread not implemented")
+}
+func (UnimplementedThreadServiceServer) DeleteThread(context.Context, *DeleteThreadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteThread not implemented")
+}
+func (UnimplementedThreadServiceServer) LikeThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeThread not implemented")
+}
+func (UnimplementedThreadServiceServer) UnlikeThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlikeThread not implemented")
+}
+func (UnimplementedThreadServiceServer) BookmarkThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookmarkThread not implemented")
+}
+func (UnimplementedThreadServiceServer) UnbookmarkThread(context.Context, *InteractThreadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbookmarkThread not implemented")
+}
+func (UnimplementedThreadServiceServer) GetFeedThreads(context.Context, *GetFeedThreadsRequest) (*GetFeedThreadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeedThreads not implemented")
+}
+func (UnimplementedThreadServiceServer) GetUserThreads(context.Context, *GetUserThreadsRequest) (*GetUserThreadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserThreads not implemented")
+}
+func (UnimplementedThreadServiceServer) GetBookmarkedThreads(context.Context, *GetBookmarkedThreadsRequest) (*GetBookmarkedThreadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookmarkedThreads not implemented")
+}
+func (UnimplementedThreadServiceServer) GetCommunityThreads(context.Context, *GetCommunityThreadsRequest) (*GetCommunityThreadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunityThreads not implemented")
+}
+func (UnimplementedThreadServiceServer) GetReplies(context.Context, *GetRepliesRequest) (*GetRepliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReplies not implemented")
+}
+func (UnimplementedThreadServiceServer) GetScheduledThreads(context.Context, *GetScheduledThreadsRequest) (*GetScheduledThreadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledThreads not implemented")
+}
+func (UnimplementedThreadServiceServer) RescheduleThread(context.Context, *RescheduleThreadRequest) (*Thread, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleThread not implemented")
+}
+func (UnimplementedThreadServiceServer) CancelScheduledThread(context.Context, *CancelScheduledThreadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledThread not implemented")
+}
+func (UnimplementedThreadServiceServer) Repost(context.Context, *InteractThreadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Repost not implemented")
+}
+func (UnimplementedThreadServiceServer) Unrepost(context.Context, *InteractThreadRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unrepost not implemented")
+}
+func (UnimplementedThreadServiceServer) GetQuotes(context.Context, *GetQuotesRequest) (*GetQuotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuotes not implemented")
+}
+func (UnimplementedThreadServiceServer) EditThread(context.Context, *EditThreadRequest) (*Thread, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditThread not implemented")
+}
+func (UnimplementedThreadServiceServer) GetThreadRevisions(context.Context, *GetThreadRevisionsRequest) (*GetThreadRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThreadRevisions not implemented")
+}
+func (UnimplementedThreadServiceServer) GetConversation(context.Context, *GetConversationRequest) (*GetConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversation not implemented")
+}
+func (UnimplementedThreadServiceServer) VotePoll(context.Context, *VotePollRequest) (*Poll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotePoll not implemented")
+}
+func (UnimplementedThreadServiceServer) GetPollResults(context.Context, *GetPollResultsRequest) (*Poll, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPollResults not implemented")
+}
+func (UnimplementedThreadServiceServer) GetThreadsByHashtag(context.Context, *GetThreadsByHashtagRequest) (*GetThreadsByHashtagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThreadsByHashtag not implemented")
+}
+func (UnimplementedThreadServiceServer) GetHashtagStats(context.Context, *GetHashtagStatsRequest) (*GetHashtagStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHashtagStats not implemented")
+}
+func (UnimplementedThreadServiceServer) GetMentions(context.Context, *GetMentionsRequest) (*GetMentionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMentions not implemented")
+}
+func (UnimplementedThreadServiceServer) GetThreadsByCategory(context.Context, *GetThreadsByCategoryRequest) (*GetThreadsByCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThreadsByCategory not implemented")
+}
+func (UnimplementedThreadServiceServer) GetCategoryStats(context.Context, *GetCategoryStatsRequest) (*GetCategoryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryStats not implemented")
+}
+func (UnimplementedThreadServiceServer) CreatePromotedThread(context.Context, *CreatePromotedThreadRequest) (*AdCampaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromotedThread not implemented")
+}
+func (UnimplementedThreadServiceServer) GetAdCampaigns(context.Context, *GetAdCampaignsRequest) (*GetAdCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdCampaigns not implemented")
+}
+func (UnimplementedThreadServiceServer) RecordAdClick(context.Context, *RecordAdClickRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordAdClick not implemented")
+}
+func (UnimplementedThreadServiceServer) FilterMutedThreads(context.Context, *FilterMutedThreadsRequest) (*FilterMutedThreadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterMutedThreads not implemented")
+}
+func (UnimplementedThreadServiceServer) GetListThreads(context.Context, *GetListThreadsRequest) (*GetListThreadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListThreads not implemented")
+}
+func (UnimplementedThreadServiceServer) HideReply(context.Context, *ReplyVisibilityRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HideReply not implemented")
+}
+func (UnimplementedThreadServiceServer) UnhideReply(context.Context, *ReplyVisibilityRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnhideReply not implemented")
+}
+func (UnimplementedThreadServiceServer) GetHiddenReplies(context.Context, *GetRepliesRequest) (*GetRepliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHiddenReplies not implemented")
+}
+func (UnimplementedThreadServiceServer) RemoveMention(context.Context, *RemoveMentionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMention not implemented")
+}
+func (UnimplementedThreadServiceServer) UpdateReplyRestriction(context.Context, *UpdateReplyRestrictionRequest) (*Thread, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReplyRestriction not implemented")
+}
+func (UnimplementedThreadServiceServer) GetModerationLog(context.Context, *GetModerationLogRequest) (*GetModerationLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationLog not implemented")
+}
+func (UnimplementedThreadServiceServer) RecordThreadEvents(context.Context, *RecordThreadEventsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordThreadEvents not implemented")
+}
+func (UnimplementedThreadServiceServer) GetThreadAnalytics(context.Context, *GetThreadAnalyticsRequest) (*ThreadAnalytics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThreadAnalytics not implemented")
+}
+func (UnimplementedThreadServiceServer) CreateDraft(context.Context, *SaveDraftRequest) (*ThreadDraft, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDraft not implemented")
+}
+func (UnimplementedThreadServiceServer) UpdateDraft(context.Context, *SaveDraftRequest) (*ThreadDraft, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDraft not implemented")
+}
+func (UnimplementedThreadServiceServer) GetDrafts(context.Context, *GetDraftsRequest) (*GetDraftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDrafts not implemented")
+}
+func (UnimplementedThreadServiceServer) DeleteDraft(context.Context, *DraftActionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDraft not implemented")
+}
+func (UnimplementedThreadServiceServer) PublishDraft(context.Context, *PublishDraftRequest) (*Thread, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDraft not implemented")
+}
+func (UnimplementedThreadServiceServer) SetThreadSensitivity(context.Context, *SetThreadSensitivityRequest) (*Thread, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetThreadSensitivity not implemented")
+}
+func (UnimplementedThreadServiceServer) ListDeletedThreads(context.Context, *ListDeletedThreadsRequest) (*ListDeletedThreadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedThreads not implemented")
+}
+func (UnimplementedThreadServiceServer) RestoreThread(context.Context, *RestoreThreadRequest) (*Thread, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreThread not implemented")
+}
+func (UnimplementedThreadServiceServer) mustEmbedUnimplementedThreadServiceServer() {}
+func (UnimplementedThreadServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeThreadServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ThreadServiceServer will
+// result in compilation errors.
+type UnsafeThreadServiceServer interface {
+	mustEmbedUnimplementedThreadServiceServer()
+}
+
+func RegisterThreadServiceServer(s grpc.ServiceRegistrar, srv ThreadServiceServer) {
+	// If the following call pancis, it indicates UnimplementedThreadServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ThreadService_ServiceDesc, srv)
+}
+
+func _ThreadService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).HealthCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_HealthCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).HealthCheck(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_CreateThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).CreateThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_CreateThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).CreateThread(ctx, req.(*CreateThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_CreateThreadChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateThreadChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).CreateThreadChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_CreateThreadChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).CreateThreadChain(ctx, req.(*CreateThreadChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetThread(ctx, req.(*GetThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_DeleteThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).DeleteThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_DeleteThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).DeleteThread(ctx, req.(*DeleteThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_LikeThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InteractThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).LikeThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_LikeThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).LikeThread(ctx, req.(*InteractThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_UnlikeThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InteractThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).UnlikeThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_UnlikeThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).UnlikeThread(ctx, req.(*InteractThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_BookmarkThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InteractThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).BookmarkThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_BookmarkThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).BookmarkThread(ctx, req.(*InteractThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_UnbookmarkThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InteractThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).UnbookmarkThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_UnbookmarkThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).UnbookmarkThread(ctx, req.(*InteractThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetFeedThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedThreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetFeedThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetFeedThreads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetFeedThreads(ctx, req.(*GetFeedThreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetUserThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserThreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetUserThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetUserThreads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetUserThreads(ctx, req.(*GetUserThreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetBookmarkedThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookmarkedThreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetBookmarkedThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetBookmarkedThreads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetBookmarkedThreads(ctx, req.(*GetBookmarkedThreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetCommunityThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunityThreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetCommunityThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetCommunityThreads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetCommunityThreads(ctx, req.(*GetCommunityThreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetReplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetReplies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetReplies(ctx, req.(*GetRepliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetScheduledThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledThreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetScheduledThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetScheduledThreads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetScheduledThreads(ctx, req.(*GetScheduledThreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_RescheduleThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).RescheduleThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_RescheduleThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).RescheduleThread(ctx, req.(*RescheduleThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_CancelScheduledThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).CancelScheduledThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_CancelScheduledThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).CancelScheduledThread(ctx, req.(*CancelScheduledThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_Repost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InteractThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).Repost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_Repost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).Repost(ctx, req.(*InteractThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_Unrepost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InteractThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).Unrepost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_Unrepost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).Unrepost(ctx, req.(*InteractThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetQuotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetQuotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetQuotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetQuotes(ctx, req.(*GetQuotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_EditThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).EditThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_EditThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).EditThread(ctx, req.(*EditThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetThreadRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetThreadRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetThreadRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetThreadRevisions(ctx, req.(*GetThreadRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetConversation(ctx, req.(*GetConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_VotePoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VotePollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).VotePoll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_VotePoll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).VotePoll(ctx, req.(*VotePollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetPollResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPollResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetPollResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetPollResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetPollResults(ctx, req.(*GetPollResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetThreadsByHashtag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadsByHashtagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetThreadsByHashtag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetThreadsByHashtag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetThreadsByHashtag(ctx, req.(*GetThreadsByHashtagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetHashtagStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHashtagStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetHashtagStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetHashtagStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetHashtagStats(ctx, req.(*GetHashtagStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMentionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetMentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetMentions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetMentions(ctx, req.(*GetMentionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetThreadsByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadsByCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetThreadsByCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetThreadsByCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetThreadsByCategory(ctx, req.(*GetThreadsByCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_GetCategoryStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetCategoryStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetCategoryStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetCategoryStats(ctx, req.(*GetCategoryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThreadService_CreatePromotedThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromotedThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).CreatePromotedThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_CreatePromotedThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).CreatePromotedThread(ctx, req.(*CreatePromotedThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedThreadServiceServer) GetFeedThreads(context.Context, *GetFeedThreadsRequest) (*GetFeedThreadsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFeedThreads not implemented")
+
+func _ThreadService_GetAdCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetAdCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetAdCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetAdCampaigns(ctx, req.(*GetAdCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedThreadServiceServer) GetUserThreads(context.Context, *GetUserThreadsRequest) (*GetUserThreadsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserThreads not implemented")
+
+func _ThreadService_RecordAdClick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordAdClickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).RecordAdClick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_RecordAdClick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).RecordAdClick(ctx, req.(*RecordAdClickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedThreadServiceServer) GetBookmarkedThreads(context.Context, *GetBookmarkedThreadsRequest) (*GetBookmarkedThreadsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBookmarkedThreads not implemented")
+
+func _ThreadService_FilterMutedThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterMutedThreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).FilterMutedThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_FilterMutedThreads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).FilterMutedThreads(ctx, req.(*FilterMutedThreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedThreadServiceServer) GetCommunityThreads(context.Context, *GetCommunityThreadsRequest) (*GetCommunityThreadsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommunityThreads not implemented")
+
+func _ThreadService_GetListThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListThreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetListThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetListThreads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetListThreads(ctx, req.(*GetListThreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedThreadServiceServer) GetReplies(context.Context, *GetRepliesRequest) (*GetRepliesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReplies not implemented")
+
+func _ThreadService_HideReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).HideReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_HideReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).HideReply(ctx, req.(*ReplyVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedThreadServiceServer) mustEmbedUnimplementedThreadServiceServer() {}
-func (UnimplementedThreadServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeThreadServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ThreadServiceServer will
-// result in compilation errors.
-type UnsafeThreadServiceServer interface {
-	mustEmbedUnimplementedThreadServiceServer()
+func _ThreadService_UnhideReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).UnhideReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_UnhideReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).UnhideReply(ctx, req.(*ReplyVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterThreadServiceServer(s grpc.ServiceRegistrar, srv ThreadServiceServer) {
-	// If the following call pancis, it indicates UnimplementedThreadServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ThreadService_GetHiddenReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ThreadService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ThreadServiceServer).GetHiddenReplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ThreadService_GetHiddenReplies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThreadServiceServer).GetHiddenReplies(ctx, req.(*GetRepliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ThreadService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ThreadService_RemoveMention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMentionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThreadServiceServer).HealthCheck(ctx, in)
+		return srv.(ThreadServiceServer).RemoveMention(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThreadService_HealthCheck_FullMethodName,
+		FullMethod: ThreadService_RemoveMention_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThreadServiceServer).HealthCheck(ctx, req.(*emptypb.Empty))
+		return srv.(ThreadServiceServer).RemoveMention(ctx, req.(*RemoveMentionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThreadService_CreateThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateThreadRequest)
+func _ThreadService_UpdateReplyRestriction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReplyRestrictionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThreadServiceServer).CreateThread(ctx, in)
+		return srv.(ThreadServiceServer).UpdateReplyRestriction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThreadService_CreateThread_FullMethodName,
+		FullMethod: ThreadService_UpdateReplyRestriction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThreadServiceServer).CreateThread(ctx, req.(*CreateThreadRequest))
+		return srv.(ThreadServiceServer).UpdateReplyRestriction(ctx, req.(*UpdateReplyRestrictionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThreadService_GetThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetThreadRequest)
+func _ThreadService_GetModerationLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThreadServiceServer).GetThread(ctx, in)
+		return srv.(ThreadServiceServer).GetModerationLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThreadService_GetThread_FullMethodName,
+		FullMethod: ThreadService_GetModerationLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThreadServiceServer).GetThread(ctx, req.(*GetThreadRequest))
+		return srv.(ThreadServiceServer).GetModerationLog(ctx, req.(*GetModerationLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThreadService_DeleteThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteThreadRequest)
+func _ThreadService_RecordThreadEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordThreadEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThreadServiceServer).DeleteThread(ctx, in)
+		return srv.(ThreadServiceServer).RecordThreadEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThreadService_DeleteThread_FullMethodName,
+		FullMethod: ThreadService_RecordThreadEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThreadServiceServer).DeleteThread(ctx, req.(*DeleteThreadRequest))
+		return srv.(ThreadServiceServer).RecordThreadEvents(ctx, req.(*RecordThreadEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThreadService_LikeThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InteractThreadRequest)
+func _ThreadService_GetThreadAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadAnalyticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThreadServiceServer).LikeThread(ctx, in)
+		return srv.(ThreadServiceServer).GetThreadAnalytics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThreadService_LikeThread_FullMethodName,
+		FullMethod: ThreadService_GetThreadAnalytics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThreadServiceServer).LikeThread(ctx, req.(*InteractThreadRequest))
+		return srv.(ThreadServiceServer).GetThreadAnalytics(ctx, req.(*GetThreadAnalyticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThreadService_UnlikeThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InteractThreadRequest)
+func _ThreadService_CreateDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDraftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThreadServiceServer).UnlikeThread(ctx, in)
+		return srv.(ThreadServiceServer).CreateDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThreadService_UnlikeThread_FullMethodName,
+		FullMethod: ThreadService_CreateDraft_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThreadServiceServer).UnlikeThread(ctx, req.(*InteractThreadRequest))
+		return srv.(ThreadServiceServer).CreateDraft(ctx, req.(*SaveDraftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThreadService_BookmarkThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InteractThreadRequest)
+func _ThreadService_UpdateDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDraftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThreadServiceServer).BookmarkThread(ctx, in)
+		return srv.(ThreadServiceServer).UpdateDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThreadService_BookmarkThread_FullMethodName,
+		FullMethod: ThreadService_UpdateDraft_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThreadServiceServer).BookmarkThread(ctx, req.(*InteractThreadRequest))
+		return srv.(ThreadServiceServer).UpdateDraft(ctx, req.(*SaveDraftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThreadService_UnbookmarkThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InteractThreadRequest)
+func _ThreadService_GetDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDraftsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThreadServiceServer).UnbookmarkThread(ctx, in)
+		return srv.(ThreadServiceServer).GetDrafts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThreadService_UnbookmarkThread_FullMethodName,
+		FullMethod: ThreadService_GetDrafts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThreadServiceServer).UnbookmarkThread(ctx, req.(*InteractThreadRequest))
+		return srv.(ThreadServiceServer).GetDrafts(ctx, req.(*GetDraftsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThreadService_GetFeedThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFeedThreadsRequest)
+func _ThreadService_DeleteDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DraftActionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThreadServiceServer).GetFeedThreads(ctx, in)
+		return srv.(ThreadServiceServer).DeleteDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThreadService_GetFeedThreads_FullMethodName,
+		FullMethod: ThreadService_DeleteDraft_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThreadServiceServer).GetFeedThreads(ctx, req.(*GetFeedThreadsRequest))
+		return srv.(ThreadServiceServer).DeleteDraft(ctx, req.(*DraftActionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThreadService_GetUserThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserThreadsRequest)
+func _ThreadService_PublishDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishDraftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThreadServiceServer).GetUserThreads(ctx, in)
+		return srv.(ThreadServiceServer).PublishDraft(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThreadService_GetUserThreads_FullMethodName,
+		FullMethod: ThreadService_PublishDraft_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThreadServiceServer).GetUserThreads(ctx, req.(*GetUserThreadsRequest))
+		return srv.(ThreadServiceServer).PublishDraft(ctx, req.(*PublishDraftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThreadService_GetBookmarkedThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBookmarkedThreadsRequest)
+func _ThreadService_SetThreadSensitivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetThreadSensitivityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThreadServiceServer).GetBookmarkedThreads(ctx, in)
+		return srv.(ThreadServiceServer).SetThreadSensitivity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThreadService_GetBookmarkedThreads_FullMethodName,
+		FullMethod: ThreadService_SetThreadSensitivity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThreadServiceServer).GetBookmarkedThreads(ctx, req.(*GetBookmarkedThreadsRequest))
+		return srv.(ThreadServiceServer).SetThreadSensitivity(ctx, req.(*SetThreadSensitivityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThreadService_GetCommunityThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommunityThreadsRequest)
+func _ThreadService_ListDeletedThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedThreadsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThreadServiceServer).GetCommunityThreads(ctx, in)
+		return srv.(ThreadServiceServer).ListDeletedThreads(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThreadService_GetCommunityThreads_FullMethodName,
+		FullMethod: ThreadService_ListDeletedThreads_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThreadServiceServer).GetCommunityThreads(ctx, req.(*GetCommunityThreadsRequest))
+		return srv.(ThreadServiceServer).ListDeletedThreads(ctx, req.(*ListDeletedThreadsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ThreadService_GetReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRepliesRequest)
+func _ThreadService_RestoreThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreThreadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ThreadServiceServer).GetReplies(ctx, in)
+		return srv.(ThreadServiceServer).RestoreThread(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ThreadService_GetReplies_FullMethodName,
+		FullMethod: ThreadService_RestoreThread_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ThreadServiceServer).GetReplies(ctx, req.(*GetRepliesRequest))
+		return srv.(ThreadServiceServer).RestoreThread(ctx, req.(*RestoreThreadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -528,6 +1820,10 @@ var ThreadService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateThread",
 			Handler:    _ThreadService_CreateThread_Handler,
 		},
+		{
+			MethodName: "CreateThreadChain",
+			Handler:    _ThreadService_CreateThreadChain_Handler,
+		},
 		{
 			MethodName: "GetThread",
 			Handler:    _ThreadService_GetThread_Handler,
@@ -572,6 +1868,154 @@ var ThreadService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReplies",
 			Handler:    _ThreadService_GetReplies_Handler,
 		},
+		{
+			MethodName: "GetScheduledThreads",
+			Handler:    _ThreadService_GetScheduledThreads_Handler,
+		},
+		{
+			MethodName: "RescheduleThread",
+			Handler:    _ThreadService_RescheduleThread_Handler,
+		},
+		{
+			MethodName: "CancelScheduledThread",
+			Handler:    _ThreadService_CancelScheduledThread_Handler,
+		},
+		{
+			MethodName: "Repost",
+			Handler:    _ThreadService_Repost_Handler,
+		},
+		{
+			MethodName: "Unrepost",
+			Handler:    _ThreadService_Unrepost_Handler,
+		},
+		{
+			MethodName: "GetQuotes",
+			Handler:    _ThreadService_GetQuotes_Handler,
+		},
+		{
+			MethodName: "EditThread",
+			Handler:    _ThreadService_EditThread_Handler,
+		},
+		{
+			MethodName: "GetThreadRevisions",
+			Handler:    _ThreadService_GetThreadRevisions_Handler,
+		},
+		{
+			MethodName: "GetConversation",
+			Handler:    _ThreadService_GetConversation_Handler,
+		},
+		{
+			MethodName: "VotePoll",
+			Handler:    _ThreadService_VotePoll_Handler,
+		},
+		{
+			MethodName: "GetPollResults",
+			Handler:    _ThreadService_GetPollResults_Handler,
+		},
+		{
+			MethodName: "GetThreadsByHashtag",
+			Handler:    _ThreadService_GetThreadsByHashtag_Handler,
+		},
+		{
+			MethodName: "GetHashtagStats",
+			Handler:    _ThreadService_GetHashtagStats_Handler,
+		},
+		{
+			MethodName: "GetMentions",
+			Handler:    _ThreadService_GetMentions_Handler,
+		},
+		{
+			MethodName: "GetThreadsByCategory",
+			Handler:    _ThreadService_GetThreadsByCategory_Handler,
+		},
+		{
+			MethodName: "GetCategoryStats",
+			Handler:    _ThreadService_GetCategoryStats_Handler,
+		},
+		{
+			MethodName: "CreatePromotedThread",
+			Handler:    _ThreadService_CreatePromotedThread_Handler,
+		},
+		{
+			MethodName: "GetAdCampaigns",
+			Handler:    _ThreadService_GetAdCampaigns_Handler,
+		},
+		{
+			MethodName: "RecordAdClick",
+			Handler:    _ThreadService_RecordAdClick_Handler,
+		},
+		{
+			MethodName: "FilterMutedThreads",
+			Handler:    _ThreadService_FilterMutedThreads_Handler,
+		},
+		{
+			MethodName: "GetListThreads",
+			Handler:    _ThreadService_GetListThreads_Handler,
+		},
+		{
+			MethodName: "HideReply",
+			Handler:    _ThreadService_HideReply_Handler,
+		},
+		{
+			MethodName: "UnhideReply",
+			Handler:    _ThreadService_UnhideReply_Handler,
+		},
+		{
+			MethodName: "GetHiddenReplies",
+			Handler:    _ThreadService_GetHiddenReplies_Handler,
+		},
+		{
+			MethodName: "RemoveMention",
+			Handler:    _ThreadService_RemoveMention_Handler,
+		},
+		{
+			MethodName: "UpdateReplyRestriction",
+			Handler:    _ThreadService_UpdateReplyRestriction_Handler,
+		},
+		{
+			MethodName: "GetModerationLog",
+			Handler:    _ThreadService_GetModerationLog_Handler,
+		},
+		{
+			MethodName: "RecordThreadEvents",
+			Handler:    _ThreadService_RecordThreadEvents_Handler,
+		},
+		{
+			MethodName: "GetThreadAnalytics",
+			Handler:    _ThreadService_GetThreadAnalytics_Handler,
+		},
+		{
+			MethodName: "CreateDraft",
+			Handler:    _ThreadService_CreateDraft_Handler,
+		},
+		{
+			MethodName: "UpdateDraft",
+			Handler:    _ThreadService_UpdateDraft_Handler,
+		},
+		{
+			MethodName: "GetDrafts",
+			Handler:    _ThreadService_GetDrafts_Handler,
+		},
+		{
+			MethodName: "DeleteDraft",
+			Handler:    _ThreadService_DeleteDraft_Handler,
+		},
+		{
+			MethodName: "PublishDraft",
+			Handler:    _ThreadService_PublishDraft_Handler,
+		},
+		{
+			MethodName: "SetThreadSensitivity",
+			Handler:    _ThreadService_SetThreadSensitivity_Handler,
+		},
+		{
+			MethodName: "ListDeletedThreads",
+			Handler:    _ThreadService_ListDeletedThreads_Handler,
+		},
+		{
+			MethodName: "RestoreThread",
+			Handler:    _ThreadService_RestoreThread_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/thread.proto",
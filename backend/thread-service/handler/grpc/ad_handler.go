@@ -0,0 +1,308 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/ads"
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/utils"
+	userpb "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	adCandidatePoolSize = 200 // servable campaigns considered per feed page
+	maxAdSessionIDLen   = 64  // ad_impressions.session_id is varchar(64)
+)
+
+// CreatePromotedThread posts a thread and starts an ad campaign for it. Only verified accounts
+// and admins can advertise.
+func (h *ThreadHandler) CreatePromotedThread(ctx context.Context, req *threadpb.CreatePromotedThreadRequest) (*threadpb.AdCampaign, error) {
+	threadReq := req.GetThread()
+	if threadReq == nil || threadReq.GetUserId() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread with a user ID is required")
+	}
+	advertiserID := threadReq.GetUserId()
+	log.Printf("ThreadSvc: CreatePromotedThread by User %d, Budget: %d", advertiserID, req.GetBudgetCents())
+
+	if threadReq.ParentThreadId != nil || threadReq.CommunityId != nil || threadReq.GetScheduledAt().IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "Promoted threads must be top-level, outside communities and not scheduled")
+	}
+	if threadReq.Content == "" && len(threadReq.MediaIds) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread must have content or media")
+	}
+	if req.GetBudgetCents() < h.ads.CostPerImpressionCents {
+		return nil, status.Errorf(codes.InvalidArgument, "Budget must cover at least one impression (%d cents)", h.ads.CostPerImpressionCents)
+	}
+	now := time.Now().UTC()
+	startsAt := now
+	if req.GetStartsAt().IsValid() && req.GetStartsAt().AsTime().After(now) {
+		startsAt = req.GetStartsAt().AsTime().UTC()
+	}
+	if !req.GetEndsAt().IsValid() || !req.GetEndsAt().AsTime().After(startsAt) {
+		return nil, status.Errorf(codes.InvalidArgument, "End time must be after the start time")
+	}
+	var targetCategories []string
+	for _, c := range req.GetTargetCategories() {
+		category, ok := utils.NormalizeCategory(c)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown target category: %s", c)
+		}
+		targetCategories = append(targetCategories, category)
+	}
+
+	allowed, err := h.canAdvertise(ctx, advertiserID)
+	if err != nil {
+		log.Printf("CreatePromotedThread: Could not check advertiser %d: %v", advertiserID, err)
+		return nil, status.Errorf(codes.Internal, "Could not verify advertiser")
+	}
+	if !allowed {
+		return nil, status.Errorf(codes.PermissionDenied, "Only verified accounts can create promoted threads")
+	}
+
+	campaign := &postgres.AdCampaign{
+		AdvertiserID:            uint(advertiserID),
+		BudgetCents:             req.GetBudgetCents(),
+		StartsAt:                startsAt,
+		EndsAt:                  req.GetEndsAt().AsTime().UTC(),
+		TargetCategories:        targetCategories,
+		TargetFollowerOfUserIDs: uint32SliceToInt64Array(req.GetTargetFollowerOfUserIds()),
+	}
+	// The campaign commits with the thread, so a failed campaign never leaves an unpromoted copy of the ad
+	threadProto, err := h.createThread(ctx, threadReq, func(tempRepo *postgres.ThreadRepository, thread *postgres.Thread) error {
+		campaign.ThreadID = thread.ID
+		if err := tempRepo.CreateAdCampaign(ctx, campaign); err != nil {
+			log.Printf("CreatePromotedThread: Failed to create campaign for thread %d: %v", thread.ID, err)
+			return status.Errorf(codes.Internal, "Failed to create ad campaign")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	threadProto.IsAdvertisement = true
+	campaignProto := mapAdCampaignToProto(postgres.AdCampaignReport{AdCampaign: *campaign})
+	campaignProto.Thread = threadProto
+	return campaignProto, nil
+}
+
+// canAdvertise reports whether userID is an admin or a verified account.
+func (h *ThreadHandler) canAdvertise(ctx context.Context, userID uint32) (bool, error) {
+	if h.ads.AdminUserIDs[uint(userID)] {
+		return true, nil
+	}
+	resp, err := h.userClient.GetUserProfile(ctx, &userpb.GetUserProfileRequest{UserIdToView: userID})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetUser().GetIsVerified(), nil
+}
+
+// GetAdCampaigns reports an advertiser's campaigns with their spend, impressions and clicks.
+func (h *ThreadHandler) GetAdCampaigns(ctx context.Context, req *threadpb.GetAdCampaignsRequest) (*threadpb.GetAdCampaignsResponse, error) {
+	if req.AdvertiserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Advertiser ID is required")
+	}
+	reports, err := h.repo.GetAdCampaignReports(ctx, uint(req.AdvertiserId))
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get ad campaigns for user %d: %v", req.AdvertiserId, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve ad campaigns")
+	}
+
+	threadIDs := make([]uint, len(reports))
+	for i, r := range reports {
+		threadIDs[i] = r.ThreadID
+	}
+	threadsMap, err := h.repo.GetThreadsByIDs(ctx, threadIDs)
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to load ad threads for user %d: %v", req.AdvertiserId, err)
+	}
+	var dbThreads []postgres.Thread
+	for _, id := range threadIDs {
+		if t, ok := threadsMap[id]; ok {
+			dbThreads = append(dbThreads, t)
+		}
+	}
+	threadProtos := make(map[uint32]*threadpb.Thread, len(dbThreads))
+	for _, t := range h.hydrateThreads(ctx, dbThreads, req.AdvertiserId) {
+		threadProtos[t.GetId()] = t
+	}
+
+	resp := &threadpb.GetAdCampaignsResponse{}
+	for _, r := range reports {
+		campaignProto := mapAdCampaignToProto(r)
+		campaignProto.Thread = threadProtos[uint32(r.ThreadID)]
+		resp.Campaigns = append(resp.Campaigns, campaignProto)
+	}
+	return resp, nil
+}
+
+// RecordAdClick counts a click on an ad served in the given session.
+func (h *ThreadHandler) RecordAdClick(ctx context.Context, req *threadpb.RecordAdClickRequest) (*emptypb.Empty, error) {
+	if req.CampaignId == 0 || req.SessionId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Campaign ID and session ID are required")
+	}
+	if err := h.repo.RecordAdClick(ctx, uint(req.CampaignId), uint(req.GetUserId()), req.SessionId); err != nil {
+		if err.Error() == "ad impression not found" {
+			return nil, status.Errorf(codes.NotFound, "Ad was not served in this session")
+		}
+		log.Printf("ThreadSvc: Failed to record click on campaign %d: %v", req.CampaignId, err)
+		return nil, status.Errorf(codes.Internal, "Could not record ad click")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// insertAds places promoted threads into a feed page at the configured cadence and charges them.
+// Ads are only served with a session ID, which is what keeps one from repeating within a session.
+// Advertisers in the request's exclude IDs are never served, and neither are ads containing a muted phrase.
+// Any failure leaves the page as it was.
+func (h *ThreadHandler) insertAds(ctx context.Context, req *threadpb.GetFeedThreadsRequest, organic []*threadpb.Thread, mutes muteFilter) []*threadpb.Thread {
+	sessionID := req.GetSessionId()
+	slots := ads.Slots(len(organic), h.ads.Cadence)
+	if sessionID == "" || len(sessionID) > maxAdSessionIDLen || slots == 0 {
+		return organic
+	}
+	now := time.Now().UTC()
+	viewerID := uint(req.GetCurrentUserId())
+	excludeUserIDs := uint32SliceToUint(req.GetExcludeUserIds())
+
+	dbCampaigns, err := h.repo.GetServableAdCampaigns(ctx, now, excludeUserIDs, adCandidatePoolSize)
+	if err != nil || len(dbCampaigns) == 0 {
+		if err != nil {
+			log.Printf("Ads: Failed to get campaigns: %v", err)
+		}
+		return organic
+	}
+	seen, err := h.repo.GetSessionAdCampaignIDs(ctx, sessionID)
+	if err != nil {
+		log.Printf("Ads: Failed to get campaigns served in session: %v", err)
+		return organic
+	}
+
+	viewer := ads.Viewer{UserID: viewerID, Excluded: make(map[uint]bool), Seen: seen}
+	for _, id := range excludeUserIDs {
+		viewer.Excluded[id] = true
+	}
+	onPage := make(map[uint]bool, len(organic))
+	for _, t := range organic {
+		onPage[uint(t.GetId())] = true
+	}
+	if !mutes.words.Empty() {
+		candidateIDs := make([]uint, len(dbCampaigns))
+		for i, c := range dbCampaigns {
+			candidateIDs[i] = c.ThreadID
+		}
+		candidates, err := h.repo.GetThreadsByIDs(ctx, candidateIDs)
+		if err != nil {
+			log.Printf("Ads: Failed to load candidate threads: %v", err)
+			return organic
+		}
+		for id, t := range candidates {
+			if mutes.words.Matches(t.Content) {
+				onPage[id] = true
+			} // treated like an ad already shown
+		}
+	}
+
+	campaigns := make([]ads.Campaign, 0, len(dbCampaigns))
+	targeted := false
+	for _, c := range dbCampaigns {
+		if onPage[c.ThreadID] {
+			continue
+		}
+		campaign := mapAdCampaignToCandidate(c)
+		targeted = targeted || campaign.Targeted()
+		campaigns = append(campaigns, campaign)
+	}
+	if targeted && viewerID != 0 {
+		rv := h.loadRankingViewer(ctx, viewerID)
+		viewer.Following, viewer.CategoryAffinity = rv.Following, rv.CategoryAffinity
+	}
+
+	selected := ads.Select(campaigns, viewer, now, h.ads, slots)
+	if len(selected) == 0 {
+		return organic
+	}
+	selectedIDs := make([]uint, len(selected))
+	for i, c := range selected {
+		selectedIDs[i] = c.ID
+	}
+	charged, err := h.repo.ChargeAdImpressions(ctx, selectedIDs, viewerID, sessionID, h.ads.CostPerImpressionCents)
+	if err != nil {
+		log.Printf("Ads: Failed to record impressions: %v", err)
+		return organic
+	}
+
+	threadIDs := make([]uint, 0, len(charged))
+	campaignByThread := make(map[uint]uint, len(charged))
+	chargedSet := make(map[uint]bool, len(charged))
+	for _, id := range charged {
+		chargedSet[id] = true
+	}
+	for _, c := range selected {
+		if chargedSet[c.ID] {
+			threadIDs = append(threadIDs, c.ThreadID)
+			campaignByThread[c.ThreadID] = c.ID
+		}
+	}
+	threadsMap, err := h.repo.GetThreadsByIDs(ctx, threadIDs)
+	if err != nil {
+		log.Printf("Ads: Failed to load ad threads: %v", err)
+		return organic
+	}
+	dbThreads := make([]postgres.Thread, 0, len(threadIDs))
+	for _, id := range threadIDs {
+		if t, ok := threadsMap[id]; ok {
+			dbThreads = append(dbThreads, t)
+		}
+	}
+	adThreads := h.hydrateThreads(ctx, dbThreads, req.GetCurrentUserId())
+	for _, t := range adThreads {
+		campaignID := uint32(campaignByThread[uint(t.GetId())])
+		t.IsAdvertisement = true
+		t.AdCampaignId = &campaignID
+	}
+	return ads.Insert(organic, adThreads, h.ads.Cadence)
+}
+
+func mapAdCampaignToCandidate(c postgres.AdCampaign) ads.Campaign {
+	followerOf := make([]uint, len(c.TargetFollowerOfUserIDs))
+	for i, id := range c.TargetFollowerOfUserIDs {
+		followerOf[i] = uint(id)
+	}
+	return ads.Campaign{
+		ID:               c.ID,
+		ThreadID:         c.ThreadID,
+		AdvertiserID:     c.AdvertiserID,
+		BudgetCents:      c.BudgetCents,
+		SpentCents:       c.SpentCents,
+		StartsAt:         c.StartsAt,
+		EndsAt:           c.EndsAt,
+		TargetCategories: c.TargetCategories,
+		TargetFollowerOf: followerOf,
+	}
+}
+
+func mapAdCampaignToProto(r postgres.AdCampaignReport) *threadpb.AdCampaign {
+	return &threadpb.AdCampaign{
+		Id:                      uint32(r.ID),
+		ThreadId:                uint32(r.ThreadID),
+		AdvertiserId:            uint32(r.AdvertiserID),
+		BudgetCents:             r.BudgetCents,
+		SpentCents:              r.SpentCents,
+		StartsAt:                timestamppb.New(r.StartsAt),
+		EndsAt:                  timestamppb.New(r.EndsAt),
+		TargetCategories:        r.TargetCategories,
+		TargetFollowerOfUserIds: int64ArrayToUint32Slice(r.TargetFollowerOfUserIDs),
+		Impressions:             r.Impressions,
+		Clicks:                  r.Clicks,
+		CreatedAt:               timestamppb.New(r.CreatedAt),
+	}
+}
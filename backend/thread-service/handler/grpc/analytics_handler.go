@@ -0,0 +1,228 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/analytics"
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+	userpb "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxRecordEvents       = 500
+	defaultAnalyticsHours = 7 * 24
+	maxAnalyticsHours     = 30 * 24
+)
+
+// analyticsStore adapts the thread repository to the analytics aggregator.
+type analyticsStore struct {
+	repo *postgres.ThreadRepository
+}
+
+func (s analyticsStore) ThreadEventsBetween(ctx context.Context, from, to time.Time) ([]analytics.Event, error) {
+	rows, err := s.repo.GetThreadViewEventsBetween(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]analytics.Event, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, analytics.Event{
+			ThreadID:     row.ThreadID,
+			ViewerKey:    row.ViewerKey,
+			Kind:         row.Kind,
+			FromFollower: row.FromFollower,
+			OccurredAt:   row.OccurredAt,
+		})
+	}
+	return events, nil
+}
+
+func (s analyticsStore) ReplaceAnalyticsBuckets(ctx context.Context, from, to time.Time, buckets []analytics.Bucket) error {
+	rows := make([]postgres.ThreadAnalyticsHour, 0, len(buckets))
+	for _, b := range buckets {
+		rows = append(rows, postgres.ThreadAnalyticsHour{
+			ThreadID:               b.ThreadID,
+			Hour:                   b.Hour,
+			Impressions:            b.Impressions,
+			FollowerImpressions:    b.FollowerImpressions,
+			NonFollowerImpressions: b.NonFollowerImpressions,
+			DetailViews:            b.DetailViews,
+			ProfileClicks:          b.ProfileClicks,
+		})
+	}
+	return s.repo.ReplaceThreadAnalyticsHours(ctx, from, to, rows)
+}
+
+// RunAnalyticsAggregator rebuilds the hourly analytics buckets until ctx is cancelled.
+func (h *ThreadHandler) RunAnalyticsAggregator(ctx context.Context) {
+	h.analytics.Start(ctx)
+}
+
+// RecordThreadEvents stores a batch of impressions, detail views and profile clicks reported by the gateway.
+// Events for unknown threads, by the thread's own author, or older than analytics.MaxEventAge are dropped.
+func (h *ThreadHandler) RecordThreadEvents(ctx context.Context, req *threadpb.RecordThreadEventsRequest) (*emptypb.Empty, error) {
+	if len(req.Events) == 0 {
+		return &emptypb.Empty{}, nil
+	}
+	if len(req.Events) > maxRecordEvents {
+		return nil, status.Errorf(codes.InvalidArgument, "At most %d events can be recorded at once", maxRecordEvents)
+	}
+
+	threadIDs := make([]uint, 0, len(req.Events))
+	for _, e := range req.Events {
+		threadIDs = append(threadIDs, uint(e.GetThreadId()))
+	}
+	threads, err := h.repo.GetThreadsByIDs(ctx, threadIDs)
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to load threads for %d view events: %v", len(req.Events), err)
+		return nil, status.Errorf(codes.Internal, "Could not record events")
+	}
+
+	now := time.Now().UTC()
+	follows := make(map[[2]uint32]bool) // (viewer, author) -> viewer follows author
+	rows := make([]postgres.ThreadViewEvent, 0, len(req.Events))
+	for _, e := range req.Events {
+		if !analytics.ValidKind(e.GetKind()) {
+			continue
+		}
+		thread, ok := threads[uint(e.GetThreadId())]
+		if !ok {
+			continue
+		}
+		viewerKey := e.GetViewerKey()
+		if e.GetViewerId() != 0 {
+			if uint(e.GetViewerId()) == thread.UserID {
+				continue // authors viewing their own threads aren't counted
+			}
+			viewerKey = fmt.Sprintf("u:%d", e.GetViewerId())
+		}
+		if viewerKey == "" {
+			continue
+		}
+		var occurredAt time.Time
+		if e.OccurredAt != nil && e.OccurredAt.IsValid() {
+			occurredAt = e.OccurredAt.AsTime()
+		}
+		occurredAt, ok = analytics.NormalizeTime(occurredAt, now)
+		if !ok {
+			continue
+		}
+
+		event := analytics.Event{
+			ThreadID:     thread.ID,
+			ViewerKey:    viewerKey,
+			Kind:         e.GetKind(),
+			FromFollower: h.viewerFollows(ctx, follows, e.GetViewerId(), uint32(thread.UserID)),
+			OccurredAt:   occurredAt,
+		}
+		rows = append(rows, postgres.ThreadViewEvent{
+			ThreadID:     event.ThreadID,
+			ViewerKey:    event.ViewerKey,
+			Kind:         event.Kind,
+			FromFollower: event.FromFollower,
+			OccurredAt:   event.OccurredAt,
+			DedupeKey:    analytics.DedupeKey(event),
+		})
+	}
+
+	if err := h.repo.RecordThreadViewEvents(ctx, rows); err != nil {
+		log.Printf("ThreadSvc: Failed to record %d view events: %v", len(rows), err)
+		return nil, status.Errorf(codes.Internal, "Could not record events")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// viewerFollows reports whether viewerID follows authorID, remembering answers in cache for the rest of the batch.
+// Signed-out viewers and failed lookups count as non-followers.
+func (h *ThreadHandler) viewerFollows(ctx context.Context, cache map[[2]uint32]bool, viewerID, authorID uint32) bool {
+	if viewerID == 0 || h.userClient == nil {
+		return false
+	}
+	key := [2]uint32{viewerID, authorID}
+	if follows, ok := cache[key]; ok {
+		return follows
+	}
+	resp, err := h.userClient.IsFollowing(ctx, &userpb.FollowCheckRequest{FollowerId: viewerID, FollowedId: authorID})
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to check if user %d follows %d: %v", viewerID, authorID, err)
+		return false
+	}
+	cache[key] = resp.GetIsTrue()
+	return cache[key]
+}
+
+// GetThreadAnalytics returns a thread's lifetime impressions, reach and engagement rate, and an hourly series
+// of the last req.Hours hours. Only the author can see them.
+func (h *ThreadHandler) GetThreadAnalytics(ctx context.Context, req *threadpb.GetThreadAnalyticsRequest) (*threadpb.ThreadAnalytics, error) {
+	log.Printf("ThreadSvc: GetThreadAnalytics of thread %d by user %d", req.ThreadId, req.RequesterUserId)
+	if req.ThreadId == 0 || req.RequesterUserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread ID and requester ID are required")
+	}
+	hours := int(req.Hours)
+	if hours <= 0 {
+		hours = defaultAnalyticsHours
+	}
+	if hours > maxAnalyticsHours {
+		hours = maxAnalyticsHours
+	}
+
+	thread, err := h.getOwnThread(ctx, req.ThreadId, req.RequesterUserId)
+	if err != nil {
+		return nil, err
+	}
+
+	since := analytics.HourOf(time.Now()).Add(-time.Duration(hours-1) * time.Hour)
+	series, err := h.repo.GetThreadAnalyticsHours(ctx, thread.ID, since)
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get analytics of thread %d: %v", thread.ID, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve analytics")
+	}
+	totals, err := h.repo.GetThreadAnalyticsTotals(ctx, thread.ID)
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get analytics totals of thread %d: %v", thread.ID, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve analytics")
+	}
+	followerReach, nonFollowerReach, err := h.repo.GetThreadReach(ctx, thread.ID, analytics.KindImpression)
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get reach of thread %d: %v", thread.ID, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve analytics")
+	}
+	stats, err := h.repo.GetThreadStatsForMultipleThreads(ctx, []uint{thread.ID})
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get stats of thread %d: %v", thread.ID, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve analytics")
+	}
+	s := stats[thread.ID]
+	engagements := s.LikeCount + s.ReplyCount + s.RepostCount + s.QuoteCount + s.BookmarkCount + totals.DetailViews + totals.ProfileClicks
+
+	resp := &threadpb.ThreadAnalytics{
+		ThreadId:         uint32(thread.ID),
+		Impressions:      totals.Impressions,
+		FollowerReach:    followerReach,
+		NonFollowerReach: nonFollowerReach,
+		DetailViews:      totals.DetailViews,
+		ProfileClicks:    totals.ProfileClicks,
+		Engagements:      engagements,
+		EngagementRate:   analytics.EngagementRate(engagements, totals.Impressions),
+		Buckets:          make([]*threadpb.ThreadAnalyticsBucket, 0, len(series)),
+	}
+	for _, b := range series {
+		resp.Buckets = append(resp.Buckets, &threadpb.ThreadAnalyticsBucket{
+			Hour:                   timestamppb.New(b.Hour),
+			Impressions:            b.Impressions,
+			FollowerImpressions:    b.FollowerImpressions,
+			NonFollowerImpressions: b.NonFollowerImpressions,
+			DetailViews:            b.DetailViews,
+			ProfileClicks:          b.ProfileClicks,
+		})
+	}
+	return resp, nil
+}
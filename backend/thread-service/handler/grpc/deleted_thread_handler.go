@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"time"
+
+	searchpb "github.com/Acad600-TPA/WEB-MJ-242/backend/search-service/genproto/proto"
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/timeline"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RestoreWindow is how long deleted threads can be restored; the purger hard deletes them after that.
+func (h *ThreadHandler) RestoreWindow() time.Duration {
+	return h.restoreWindow
+}
+
+// ListDeletedThreads returns the user's own threads that were deleted recently enough to be restored.
+func (h *ThreadHandler) ListDeletedThreads(ctx context.Context, req *threadpb.ListDeletedThreadsRequest) (*threadpb.ListDeletedThreadsResponse, error) {
+	log.Printf("ThreadSvc: ListDeletedThreads for UserID: %d, Page: %d", req.UserId, req.Page)
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "User ID is required")
+	}
+	limit, offset := getLimitOffset(req.Page, req.Limit)
+	after, err := parsePageCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	since := time.Now().UTC().Add(-h.restoreWindow)
+	dbThreads, err := h.repo.GetDeletedThreadsByUser(ctx, uint(req.UserId), since, limit, offset, after)
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get deleted threads for user %d: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve deleted threads")
+	}
+
+	hydrated := h.hydrateThreads(ctx, dbThreads, req.UserId)
+	deleted := make([]*threadpb.DeletedThread, len(dbThreads))
+	for i := range dbThreads {
+		deletedAt := dbThreads[i].DeletedAt.Time
+		deleted[i] = &threadpb.DeletedThread{
+			Thread:    hydrated[i],
+			DeletedAt: timestamppb.New(deletedAt),
+			PurgeAt:   timestamppb.New(deletedAt.Add(h.restoreWindow)),
+		}
+	}
+
+	hasMore := len(dbThreads) == limit
+	nextCursor := ""
+	if hasMore {
+		last := dbThreads[len(dbThreads)-1]
+		nextCursor = utils.EncodeCursor(last.DeletedAt.Time, last.ID)
+	}
+	return &threadpb.ListDeletedThreadsResponse{Threads: deleted, HasMore: hasMore, NextCursor: nextCursor}, nil
+}
+
+// RestoreThread brings back one of the user's deleted threads within the restore window, with its
+// interactions and counters, and pushes it back into followers' timelines. Hashtags and mentions are
+// resolved again from the content, so users who removed their mention or no longer allow the author
+// to mention them stay unmentioned.
+func (h *ThreadHandler) RestoreThread(ctx context.Context, req *threadpb.RestoreThreadRequest) (*threadpb.Thread, error) {
+	log.Printf("ThreadSvc: RestoreThread %d by User %d", req.ThreadId, req.UserId)
+	if req.ThreadId == 0 || req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread ID and User ID are required")
+	}
+
+	thread, err := h.repo.GetDeletedThreadByID(ctx, uint(req.ThreadId))
+	if err != nil {
+		if err.Error() == "thread not found" {
+			return nil, status.Errorf(codes.NotFound, "Deleted thread not found")
+		}
+		log.Printf("ThreadSvc: Failed to get deleted thread %d: %v", req.ThreadId, err)
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve deleted thread")
+	}
+	if thread.UserID != uint(req.UserId) {
+		return nil, status.Errorf(codes.NotFound, "Deleted thread not found")
+	}
+	if thread.Status != postgres.ThreadStatusPublished {
+		return nil, status.Errorf(codes.FailedPrecondition, "Cancelled scheduled threads can't be restored")
+	}
+	if time.Since(thread.DeletedAt.Time) > h.restoreWindow {
+		return nil, status.Errorf(codes.FailedPrecondition, "Threads can only be restored within %v of deleting them", h.restoreWindow)
+	}
+
+	hashtags := utils.ExtractHashtags(thread.Content)
+	removedMentions, err := h.repo.GetRemovedMentionUserIDs(ctx, thread.ID)
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get removed mentions of thread %d: %v", thread.ID, err)
+		return nil, status.Errorf(codes.Internal, "Could not restore thread")
+	}
+	var mentionIDs []uint
+	for _, id := range h.resolveMentionedUserIDs(ctx, thread.Content, req.UserId) {
+		if !removedMentions[uint(id)] {
+			mentionIDs = append(mentionIDs, uint(id))
+		}
+	}
+
+	restored, err := h.repo.RestoreThread(ctx, thread.ID, hashtags, mentionIDs)
+	if err != nil {
+		if err.Error() == "thread not found" {
+			return nil, status.Errorf(codes.NotFound, "Deleted thread not found")
+		} // restored or purged concurrently
+		log.Printf("ThreadSvc: Failed to restore thread %d: %v", thread.ID, err)
+		return nil, status.Errorf(codes.Internal, "Could not restore thread")
+	}
+
+	// Deletion took the tags off trending; put them back. Mentioned users aren't notified a second time.
+	if len(hashtags) > 0 {
+		_, errSearch := h.searchClient.IncrementHashtagCounts(ctx, &searchpb.IncrementHashtagCountsRequest{Hashtags: hashtags})
+		if errSearch != nil {
+			log.Printf("Error calling SearchService to increment hashtags of restored thread %d: %v", restored.ID, errSearch)
+		}
+	}
+
+	// Timelines the thread was trimmed from while it was deleted, or that were rebuilt without it, get it back
+	h.fanOut(timeline.Entry{ThreadID: restored.ID, SourceUserID: restored.UserID, At: restored.PostedAt}, false)
+
+	log.Printf("Thread %d restored by user %d", restored.ID, req.UserId)
+	return h.hydrateThreads(ctx, []postgres.Thread{*restored}, req.UserId)[0], nil
+}
@@ -0,0 +1,205 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxDraftsPerUser keeps abandoned drafts from piling up without bound.
+const maxDraftsPerUser = 100
+
+func (h *ThreadHandler) CreateDraft(ctx context.Context, req *threadpb.SaveDraftRequest) (*threadpb.ThreadDraft, error) {
+	log.Printf("ThreadSvc: CreateDraft for user %d", req.UserId)
+	draft, err := draftFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := h.repo.CountDrafts(ctx, draft.UserID)
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to count drafts of user %d: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "Could not save draft")
+	}
+	if count >= maxDraftsPerUser {
+		return nil, status.Errorf(codes.ResourceExhausted, "You can keep at most %d drafts", maxDraftsPerUser)
+	}
+
+	if err := h.repo.CreateDraft(ctx, draft); err != nil {
+		log.Printf("ThreadSvc: Failed to create draft for user %d: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "Could not save draft")
+	}
+	return mapDraftToProto(draft), nil
+}
+
+func (h *ThreadHandler) UpdateDraft(ctx context.Context, req *threadpb.SaveDraftRequest) (*threadpb.ThreadDraft, error) {
+	log.Printf("ThreadSvc: UpdateDraft %d for user %d", req.DraftId, req.UserId)
+	if req.DraftId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Draft ID is required")
+	}
+	draft, err := draftFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	draft.ID = uint(req.DraftId)
+
+	if err := h.repo.UpdateDraft(ctx, draft); err != nil {
+		if err.Error() == "draft not found" {
+			return nil, status.Errorf(codes.NotFound, "Draft not found")
+		}
+		log.Printf("ThreadSvc: Failed to update draft %d: %v", req.DraftId, err)
+		return nil, status.Errorf(codes.Internal, "Could not save draft")
+	}
+	// Reload for CreatedAt, which the update doesn't touch
+	saved, err := h.repo.GetDraft(ctx, draft.ID, draft.UserID)
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to reload draft %d: %v", req.DraftId, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve draft")
+	}
+	return mapDraftToProto(saved), nil
+}
+
+func (h *ThreadHandler) GetDrafts(ctx context.Context, req *threadpb.GetDraftsRequest) (*threadpb.GetDraftsResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "User ID is required")
+	}
+	limit, offset := getLimitOffset(req.Page, req.Limit)
+	drafts, err := h.repo.GetDrafts(ctx, uint(req.UserId), limit, offset)
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get drafts of user %d: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve drafts")
+	}
+
+	resp := &threadpb.GetDraftsResponse{Drafts: make([]*threadpb.ThreadDraft, 0, len(drafts)), HasMore: len(drafts) == limit}
+	for i := range drafts {
+		resp.Drafts = append(resp.Drafts, mapDraftToProto(&drafts[i]))
+	}
+	return resp, nil
+}
+
+func (h *ThreadHandler) DeleteDraft(ctx context.Context, req *threadpb.DraftActionRequest) (*emptypb.Empty, error) {
+	log.Printf("ThreadSvc: DeleteDraft %d for user %d", req.DraftId, req.UserId)
+	if req.DraftId == 0 || req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Draft ID and User ID are required")
+	}
+	if err := h.repo.DeleteDraft(ctx, uint(req.DraftId), uint(req.UserId)); err != nil {
+		if err.Error() == "draft not found" {
+			return nil, status.Errorf(codes.NotFound, "Draft not found")
+		}
+		log.Printf("ThreadSvc: Failed to delete draft %d: %v", req.DraftId, err)
+		return nil, status.Errorf(codes.Internal, "Could not delete draft")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// PublishDraft posts a draft through the same path as CreateThread, reply permission checks included, and
+// deletes the draft in the same transaction. If anything fails the draft is kept and nothing is posted.
+func (h *ThreadHandler) PublishDraft(ctx context.Context, req *threadpb.PublishDraftRequest) (*threadpb.Thread, error) {
+	log.Printf("ThreadSvc: PublishDraft %d for user %d", req.DraftId, req.UserId)
+	if req.DraftId == 0 || req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Draft ID and User ID are required")
+	}
+	draft, err := h.repo.GetDraft(ctx, uint(req.DraftId), uint(req.UserId))
+	if err != nil {
+		if err.Error() == "draft not found" {
+			return nil, status.Errorf(codes.NotFound, "Draft not found")
+		}
+		log.Printf("ThreadSvc: Failed to get draft %d: %v", req.DraftId, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve draft")
+	}
+	if draft.Content == "" && len(draft.MediaIDs) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Draft must have content or media before it can be posted")
+	}
+
+	createReq := &threadpb.CreateThreadRequest{
+		UserId:           req.UserId,
+		Content:          draft.Content,
+		ReplyRestriction: mapStringToReplyRestriction(draft.ReplyRestriction),
+		ScheduledAt:      req.ScheduledAt,
+		MediaIds:         int64ArrayToUint32Slice(draft.MediaIDs),
+		Categories:       draft.Categories,
+		ContentWarning:   draft.ContentWarning,
+		IsSensitive:      draft.IsSensitive,
+	}
+	if draft.ParentThreadID != nil {
+		parentID := uint32(*draft.ParentThreadID)
+		createReq.ParentThreadId = &parentID
+	}
+	if draft.CommunityID != nil {
+		communityID := uint32(*draft.CommunityID)
+		createReq.CommunityId = &communityID
+	}
+
+	return h.createThread(ctx, createReq, func(tempRepo *postgres.ThreadRepository, _ *postgres.Thread) error {
+		if err := tempRepo.DeleteDraft(ctx, draft.ID, draft.UserID); err != nil {
+			if err.Error() == "draft not found" {
+				return status.Errorf(codes.NotFound, "Draft was already posted or deleted")
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+// draftFromRequest validates a save request. Empty drafts are refused; there is nothing to come back to.
+func draftFromRequest(req *threadpb.SaveDraftRequest) (*postgres.ThreadDraft, error) {
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "User ID is required")
+	}
+	if req.Content == "" && len(req.MediaIds) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Draft must have content or media")
+	}
+	contentWarning, err := utils.NormalizeContentWarning(req.ContentWarning)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid content warning: %v", err)
+	}
+	draft := &postgres.ThreadDraft{
+		UserID:           uint(req.UserId),
+		Content:          req.Content,
+		MediaIDs:         uint32SliceToInt64Array(req.MediaIds),
+		Categories:       utils.NormalizeCategories(req.Categories),
+		ReplyRestriction: mapReplyRestrictionToString(req.ReplyRestriction),
+		ContentWarning:   contentWarning,
+		IsSensitive:      req.IsSensitive,
+	}
+	if req.GetParentThreadId() != 0 {
+		parentID := uint(req.GetParentThreadId())
+		draft.ParentThreadID = &parentID
+	}
+	if req.GetCommunityId() != 0 {
+		communityID := uint(req.GetCommunityId())
+		draft.CommunityID = &communityID
+	}
+	return draft, nil
+}
+
+func mapDraftToProto(draft *postgres.ThreadDraft) *threadpb.ThreadDraft {
+	pbDraft := &threadpb.ThreadDraft{
+		Id:               uint32(draft.ID),
+		UserId:           uint32(draft.UserID),
+		Content:          draft.Content,
+		MediaIds:         int64ArrayToUint32Slice(draft.MediaIDs),
+		Categories:       draft.Categories,
+		ReplyRestriction: mapStringToReplyRestriction(draft.ReplyRestriction),
+		ContentWarning:   draft.ContentWarning,
+		IsSensitive:      draft.IsSensitive,
+		CreatedAt:        timestamppb.New(draft.CreatedAt),
+		UpdatedAt:        timestamppb.New(draft.UpdatedAt),
+	}
+	if draft.ParentThreadID != nil {
+		id := uint32(*draft.ParentThreadID)
+		pbDraft.ParentThreadId = &id
+	}
+	if draft.CommunityID != nil {
+		id := uint32(*draft.CommunityID)
+		pbDraft.CommunityId = &id
+	}
+	return pbDraft
+}
@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/linkpreview"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/utils"
+)
+
+// linkPreviewStore adapts the thread repository to the link preview worker's cache.
+type linkPreviewStore struct {
+	repo *postgres.ThreadRepository
+}
+
+func (s linkPreviewStore) GetLinkPreviewEntry(ctx context.Context, pageURL string) (*linkpreview.Entry, error) {
+	row, err := s.repo.GetLinkPreview(ctx, pageURL)
+	if err != nil || row == nil {
+		return nil, err
+	}
+	entry := &linkpreview.Entry{URL: row.URL, FetchedAt: row.FetchedAt}
+	if !row.Failed {
+		entry.Preview = &linkpreview.Preview{Title: row.Title, Description: row.Description, ImageURL: row.ImageURL, SiteName: row.SiteName}
+	}
+	return entry, nil
+}
+
+func (s linkPreviewStore) SaveLinkPreviewEntry(ctx context.Context, entry linkpreview.Entry) error {
+	row := &postgres.LinkPreview{URL: entry.URL, FetchedAt: entry.FetchedAt, Failed: entry.Preview == nil}
+	if entry.Preview != nil {
+		row.Title = entry.Preview.Title
+		row.Description = entry.Preview.Description
+		row.ImageURL = entry.Preview.ImageURL
+		row.SiteName = entry.Preview.SiteName
+	}
+	return s.repo.SaveLinkPreview(ctx, row)
+}
+
+// RunLinkPreviewWorker fetches queued link previews until ctx is cancelled. It returns at once when previews are disabled.
+func (h *ThreadHandler) RunLinkPreviewWorker(ctx context.Context) {
+	if h.linkPreviews == nil {
+		log.Println("Link previews disabled.")
+		return
+	}
+	h.linkPreviews.Start(ctx)
+}
+
+// queueLinkPreview asks the worker for a preview of a thread's link. The thread is saved without one;
+// the card shows up once the fetch has finished.
+func (h *ThreadHandler) queueLinkPreview(pageURL *string) {
+	if h.linkPreviews == nil || pageURL == nil {
+		return
+	}
+	h.linkPreviews.Enqueue(*pageURL)
+}
+
+// firstPreviewURL returns the normalized URL a thread's preview card is built from, or nil if content has no link.
+func firstPreviewURL(content string) *string {
+	pageURL, ok := utils.FirstPreviewURL(content)
+	if !ok {
+		return nil
+	}
+	return &pageURL
+}
+
+func mapLinkPreviewToProto(preview *postgres.LinkPreview) *threadpb.LinkPreview {
+	return &threadpb.LinkPreview{
+		Url:         preview.URL,
+		Title:       preview.Title,
+		Description: preview.Description,
+		ImageUrl:    preview.ImageURL,
+		SiteName:    preview.SiteName,
+	}
+}
@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+	userpb "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/mute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetListThreads pages through the threads posted by a list's members, newest first.
+func (h *ThreadHandler) GetListThreads(ctx context.Context, req *threadpb.GetListThreadsRequest) (*threadpb.GetListThreadsResponse, error) {
+	log.Printf("ThreadSvc: GetListThreads for ListID: %d, Requester: %d", req.ListId, req.GetRequesterUserId())
+
+	if req.ListId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "List ID is required")
+	}
+	if h.userClient == nil {
+		return nil, status.Errorf(codes.Unavailable, "User service unavailable")
+	}
+	limit, offset := getLimitOffset(req.Page, req.Limit)
+	after, err := parsePageCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := h.userClient.GetListMemberIDs(ctx, &userpb.ListRequest{ListId: req.ListId, RequesterId: req.GetRequesterUserId()})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "List not found")
+		}
+		log.Printf("ThreadSvc: Failed to get members of list %d: %v", req.ListId, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve list timeline")
+	}
+	// An empty IncludeOnlyUserIDs means no filter, so a list without members has an empty timeline
+	if len(members.GetUserIds()) == 0 {
+		return &threadpb.GetListThreadsResponse{Threads: []*threadpb.Thread{}}, nil
+	}
+
+	mutes := h.loadMuteFilter(ctx, req.GetRequesterUserId(), mute.ScopeHome)
+
+	dbThreads, err := h.repo.GetThreads(ctx, postgres.GetThreadsParams{
+		Limit:              limit,
+		Offset:             offset,
+		After:              after,
+		ExcludeUserIDs:     uint32SliceToUint(mutes.withExcluded(req.GetExcludeUserIds())),
+		IncludeOnlyUserIDs: uint32SliceToUint(members.GetUserIds()),
+	})
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get threads of list %d: %v", req.ListId, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve list timeline")
+	}
+
+	return &threadpb.GetListThreadsResponse{
+		Threads:    mutes.apply(h.hydrateThreads(ctx, dbThreads, req.GetRequesterUserId())),
+		HasMore:    len(dbThreads) == limit,
+		NextCursor: nextThreadCursor(dbThreads, limit),
+	}, nil
+}
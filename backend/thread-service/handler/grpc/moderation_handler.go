@@ -0,0 +1,181 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/mute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// HideReply collapses a reply behind the "hidden replies" section of its parent thread.
+// Only the author of the parent thread can hide replies to it.
+func (h *ThreadHandler) HideReply(ctx context.Context, req *threadpb.ReplyVisibilityRequest) (*emptypb.Empty, error) {
+	return h.setReplyHidden(ctx, req, true)
+}
+
+func (h *ThreadHandler) UnhideReply(ctx context.Context, req *threadpb.ReplyVisibilityRequest) (*emptypb.Empty, error) {
+	return h.setReplyHidden(ctx, req, false)
+}
+
+func (h *ThreadHandler) setReplyHidden(ctx context.Context, req *threadpb.ReplyVisibilityRequest, hidden bool) (*emptypb.Empty, error) {
+	log.Printf("ThreadSvc: Set reply %d hidden=%v by user %d", req.ReplyThreadId, hidden, req.RequesterUserId)
+	if req.ReplyThreadId == 0 || req.RequesterUserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Reply thread ID and requester ID are required")
+	}
+
+	reply, err := h.repo.GetThreadByID(ctx, uint(req.ReplyThreadId))
+	if err != nil {
+		if err.Error() == "thread not found" {
+			return nil, status.Errorf(codes.NotFound, "Reply not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve reply")
+	}
+	if reply.Status != postgres.ThreadStatusPublished || reply.ParentThreadID == nil {
+		return nil, status.Errorf(codes.NotFound, "Reply not found")
+	}
+	parent, err := h.repo.GetThreadByID(ctx, *reply.ParentThreadID)
+	if err != nil {
+		if err.Error() == "thread not found" {
+			return nil, status.Errorf(codes.NotFound, "Parent thread not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve parent thread")
+	}
+	if parent.UserID != uint(req.RequesterUserId) {
+		return nil, status.Errorf(codes.PermissionDenied, "Only the author of a thread can hide its replies")
+	}
+
+	if err := h.repo.SetReplyHidden(ctx, reply, uint(req.RequesterUserId), hidden); err != nil {
+		log.Printf("ThreadSvc: Failed to change visibility of reply %d: %v", reply.ID, err)
+		return nil, status.Errorf(codes.Internal, "Could not change reply visibility")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetHiddenReplies lists the replies the parent thread's author hid. Anyone who can see the thread can open them.
+func (h *ThreadHandler) GetHiddenReplies(ctx context.Context, req *threadpb.GetRepliesRequest) (*threadpb.GetRepliesResponse, error) {
+	log.Printf("ThreadSvc: GetHiddenReplies for ParentThreadID: %d, Requester: %d", req.ParentThreadId, req.GetRequesterUserId())
+
+	if req.ParentThreadId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Parent Thread ID is required")
+	}
+	limit, offset := getLimitOffset(req.Page, req.Limit)
+	after, err := parsePageCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	mutes := h.loadMuteFilter(ctx, req.GetRequesterUserId(), mute.ScopeReplies)
+
+	dbReplies, err := h.repo.GetHiddenReplies(ctx, uint(req.ParentThreadId), limit, offset, after, uint32SliceToUint(mutes.withExcluded(req.GetExcludeUserIds())))
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get hidden replies for parent %d: %v", req.ParentThreadId, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve hidden replies")
+	}
+
+	return &threadpb.GetRepliesResponse{
+		Threads:    mutes.apply(h.hydrateThreads(ctx, dbReplies, req.GetRequesterUserId())),
+		HasMore:    len(dbReplies) == limit,
+		NextCursor: nextThreadCursor(dbReplies, limit),
+	}, nil
+}
+
+// RemoveMention lets a mentioned user take their @mention off someone else's thread. The @handle stays in
+// the text but no longer links to them, and later edits of the thread won't mention or notify them again.
+func (h *ThreadHandler) RemoveMention(ctx context.Context, req *threadpb.RemoveMentionRequest) (*emptypb.Empty, error) {
+	log.Printf("ThreadSvc: RemoveMention of user %d from thread %d", req.UserId, req.ThreadId)
+	if req.ThreadId == 0 || req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread ID and User ID are required")
+	}
+
+	if err := h.repo.RemoveOwnMention(ctx, uint(req.ThreadId), uint(req.UserId)); err != nil {
+		if err.Error() == "mention not found" {
+			return nil, status.Errorf(codes.NotFound, "You are not mentioned in this thread")
+		}
+		log.Printf("ThreadSvc: Failed to remove mention of user %d from thread %d: %v", req.UserId, req.ThreadId, err)
+		return nil, status.Errorf(codes.Internal, "Could not remove mention")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// UpdateReplyRestriction changes who can reply to a thread after posting. Replies already posted stay.
+func (h *ThreadHandler) UpdateReplyRestriction(ctx context.Context, req *threadpb.UpdateReplyRestrictionRequest) (*threadpb.Thread, error) {
+	log.Printf("ThreadSvc: UpdateReplyRestriction of thread %d to %v by user %d", req.ThreadId, req.ReplyRestriction, req.UserId)
+	if req.ThreadId == 0 || req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread ID and User ID are required")
+	}
+	if req.ReplyRestriction == threadpb.ReplyRestriction_REPLY_RESTRICTION_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "Reply restriction is required")
+	}
+
+	thread, err := h.getOwnThread(ctx, req.ThreadId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.repo.UpdateReplyRestriction(ctx, thread, uint(req.UserId), mapReplyRestrictionToString(req.ReplyRestriction)); err != nil {
+		log.Printf("ThreadSvc: Failed to update reply restriction of thread %d: %v", thread.ID, err)
+		return nil, status.Errorf(codes.Internal, "Could not update reply restriction")
+	}
+	return h.hydrateThreads(ctx, []postgres.Thread{*thread}, req.UserId)[0], nil
+}
+
+// GetModerationLog returns who hid which replies, removed mentions or changed the reply restriction of a thread.
+func (h *ThreadHandler) GetModerationLog(ctx context.Context, req *threadpb.GetModerationLogRequest) (*threadpb.GetModerationLogResponse, error) {
+	log.Printf("ThreadSvc: GetModerationLog of thread %d by user %d", req.ThreadId, req.RequesterUserId)
+	if req.ThreadId == 0 || req.RequesterUserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread ID and requester ID are required")
+	}
+
+	thread, err := h.getOwnThread(ctx, req.ThreadId, req.RequesterUserId)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset := getLimitOffset(req.Page, req.Limit)
+	actions, err := h.repo.GetModerationActions(ctx, thread.ID, limit, offset)
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get moderation log of thread %d: %v", thread.ID, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve moderation log")
+	}
+
+	resp := &threadpb.GetModerationLogResponse{Actions: make([]*threadpb.ModerationAction, 0, len(actions)), HasMore: len(actions) == limit}
+	for _, a := range actions {
+		pbAction := &threadpb.ModerationAction{
+			Id:        uint32(a.ID),
+			ThreadId:  uint32(a.ThreadID),
+			ActorId:   uint32(a.ActorID),
+			Action:    a.Action,
+			Detail:    a.Detail,
+			CreatedAt: timestamppb.New(a.CreatedAt),
+		}
+		if a.TargetThreadID != nil {
+			id := uint32(*a.TargetThreadID)
+			pbAction.TargetThreadId = &id
+		}
+		if a.TargetUserID != nil {
+			id := uint32(*a.TargetUserID)
+			pbAction.TargetUserId = &id
+		}
+		resp.Actions = append(resp.Actions, pbAction)
+	}
+	return resp, nil
+}
+
+// getOwnThread loads a thread and checks that userID wrote it, for author-only actions.
+func (h *ThreadHandler) getOwnThread(ctx context.Context, threadID, userID uint32) (*postgres.Thread, error) {
+	thread, err := h.repo.GetThreadByID(ctx, uint(threadID))
+	if err != nil {
+		if err.Error() == "thread not found" {
+			return nil, status.Errorf(codes.NotFound, "Thread not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve thread")
+	}
+	if thread.UserID != uint(userID) {
+		return nil, status.Errorf(codes.PermissionDenied, "Only the author of this thread can do that")
+	}
+	return thread, nil
+}
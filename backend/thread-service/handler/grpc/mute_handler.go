@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	userpb "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/mute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// muteFilter hides what a viewer muted: their muted accounts, and the phrases muted in one scope.
+type muteFilter struct {
+	userIDs map[uint32]bool
+	words   *mute.Filter
+}
+
+// loadMuteFilter fetches the viewer's mutes for scope. It fails open so a slow user-service
+// shows muted content rather than an empty timeline.
+func (h *ThreadHandler) loadMuteFilter(ctx context.Context, viewerID uint32, scope string) muteFilter {
+	if viewerID == 0 || h.userClient == nil {
+		return muteFilter{}
+	}
+	resp, err := h.userClient.GetMuteFilter(ctx, &userpb.GetMuteFilterRequest{UserId: viewerID, Scope: scope})
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to load %s mutes of user %d: %v", scope, viewerID, err)
+		return muteFilter{}
+	}
+	f := muteFilter{userIDs: make(map[uint32]bool, len(resp.GetMutedUserIds())), words: mute.NewFilter(resp.GetPhrases())}
+	for _, id := range resp.GetMutedUserIds() {
+		f.userIDs[id] = true
+	}
+	return f
+}
+
+func (f muteFilter) empty() bool {
+	return len(f.userIDs) == 0 && f.words.Empty()
+}
+
+// withExcluded adds the muted accounts to excludeUserIDs, so queries skip them rather than return short pages.
+func (f muteFilter) withExcluded(excludeUserIDs []uint32) []uint32 {
+	if len(f.userIDs) == 0 {
+		return excludeUserIDs
+	}
+	merged := make([]uint32, 0, len(excludeUserIDs)+len(f.userIDs))
+	merged = append(merged, excludeUserIDs...)
+	for id := range f.userIDs {
+		merged = append(merged, id)
+	}
+	return merged
+}
+
+// hides reports whether t was written or reposted by a muted account, or contains a muted phrase.
+// The content warning is checked too; on a masked thread it is the only text the viewer sees.
+func (f muteFilter) hides(t *threadpb.Thread) bool {
+	if f.userIDs[t.GetUserId()] || (t.RepostedByUserId != nil && f.userIDs[t.GetRepostedByUserId()]) {
+		return true
+	}
+	return f.words.Matches(t.GetContent()) || f.words.Matches(t.GetContentWarning())
+}
+
+// apply drops hidden threads. Callers compute paging before filtering, so a page may come back short.
+func (f muteFilter) apply(threads []*threadpb.Thread) []*threadpb.Thread {
+	if f.empty() {
+		return threads
+	}
+	kept := make([]*threadpb.Thread, 0, len(threads))
+	for _, t := range threads {
+		if !f.hides(t) {
+			kept = append(kept, t)
+		}
+	}
+	return kept
+}
+
+func (h *ThreadHandler) FilterMutedThreads(ctx context.Context, req *threadpb.FilterMutedThreadsRequest) (*threadpb.FilterMutedThreadsResponse, error) {
+	if !mute.IsScope(req.GetScope()) {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown mute scope")
+	}
+	mutes := h.loadMuteFilter(ctx, req.GetViewerId(), req.GetScope())
+	if mutes.empty() || len(req.GetThreadIds()) == 0 {
+		return &threadpb.FilterMutedThreadsResponse{ThreadIds: req.GetThreadIds()}, nil
+	}
+
+	threads, err := h.repo.GetThreadsByIDs(ctx, uint32SliceToUint(req.GetThreadIds()))
+	if err != nil {
+		log.Printf("ThreadSvc: FilterMutedThreads failed to load threads: %v", err)
+		return nil, status.Errorf(codes.Internal, "Could not filter threads")
+	}
+	resp := &threadpb.FilterMutedThreadsResponse{ThreadIds: make([]uint32, 0, len(req.GetThreadIds()))}
+	for _, id := range req.GetThreadIds() {
+		t, ok := threads[uint(id)]
+		if ok && (mutes.userIDs[uint32(t.UserID)] || mutes.words.Matches(t.Content) || mutes.words.Matches(t.ContentWarning)) {
+			continue
+		}
+		resp.ThreadIds = append(resp.ThreadIds, id)
+	}
+	return resp, nil
+}
@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/utils"
+	userpb "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetThreadSensitivity marks a thread as sensitive and sets or clears its content warning. Authors can change
+// their own threads and moderators anyone's; every change goes into the thread's moderation log.
+func (h *ThreadHandler) SetThreadSensitivity(ctx context.Context, req *threadpb.SetThreadSensitivityRequest) (*threadpb.Thread, error) {
+	log.Printf("ThreadSvc: SetThreadSensitivity of thread %d to sensitive=%v by user %d", req.ThreadId, req.IsSensitive, req.UserId)
+	if req.ThreadId == 0 || req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread ID and User ID are required")
+	}
+	contentWarning, err := utils.NormalizeContentWarning(req.ContentWarning)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid content warning: %v", err)
+	}
+
+	thread, err := h.repo.GetThreadByID(ctx, uint(req.ThreadId))
+	if err != nil {
+		if err.Error() == "thread not found" {
+			return nil, status.Errorf(codes.NotFound, "Thread not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve thread")
+	}
+	isAuthor := thread.UserID == uint(req.UserId)
+	if !isAuthor && thread.Status != postgres.ThreadStatusPublished {
+		return nil, status.Errorf(codes.NotFound, "Thread not found")
+	}
+	if !isAuthor && !h.isModerator(req.UserId) {
+		return nil, status.Errorf(codes.PermissionDenied, "Only the author or a moderator can change this thread's sensitivity")
+	}
+
+	if err := h.repo.UpdateSensitivity(ctx, thread, uint(req.UserId), req.IsSensitive, contentWarning); err != nil {
+		log.Printf("ThreadSvc: Failed to update sensitivity of thread %d: %v", thread.ID, err)
+		return nil, status.Errorf(codes.Internal, "Could not update thread sensitivity")
+	}
+	return h.hydrateThreads(ctx, []postgres.Thread{*thread}, req.UserId)[0], nil
+}
+
+// isModerator reports whether userID moderates content site-wide (MODERATOR_USER_IDS).
+func (h *ThreadHandler) isModerator(userID uint32) bool {
+	return h.moderation.IsModerator(uint(userID))
+}
+
+// maskSensitiveThreads withholds the sensitive parts of other people's flagged threads, quoted threads
+// included, unless the viewer opted into sensitive content. The preference is only looked up when needed.
+func (h *ThreadHandler) maskSensitiveThreads(ctx context.Context, threads []*threadpb.Thread, viewerID uint32) {
+	needed := false
+	for _, t := range threads {
+		if masksFor(t, viewerID) || masksFor(t.GetQuotedThread(), viewerID) {
+			needed = true
+			break
+		}
+	}
+	if !needed || h.showsSensitiveContent(ctx, viewerID) {
+		return
+	}
+	for _, t := range threads {
+		maskThread(t, viewerID)
+		maskThread(t.GetQuotedThread(), viewerID)
+	}
+}
+
+// maskRevisions withholds the same parts of a flagged thread's earlier versions that maskThread withholds
+// from the thread itself, so the edit history doesn't give away what is masked.
+func (h *ThreadHandler) maskRevisions(ctx context.Context, thread *postgres.Thread, revisions []*threadpb.ThreadRevision, viewerID uint32) {
+	flagged := thread.IsSensitive || thread.ContentWarning != ""
+	if !flagged || len(revisions) == 0 || thread.UserID == uint(viewerID) || h.showsSensitiveContent(ctx, viewerID) {
+		return
+	}
+	for _, rev := range revisions {
+		rev.IsMasked = true
+		rev.MediaIds = nil
+		if thread.ContentWarning != "" {
+			rev.Content = ""
+		}
+	}
+}
+
+// showsSensitiveContent reports whether the viewer turned on "show sensitive content". It fails closed:
+// anonymous viewers, and viewers whose setting can't be loaded, get masked threads.
+func (h *ThreadHandler) showsSensitiveContent(ctx context.Context, viewerID uint32) bool {
+	if viewerID == 0 || h.userClient == nil {
+		return false
+	}
+	prefs, err := h.userClient.GetContentPreferences(ctx, &userpb.GetContentPreferencesRequest{UserId: viewerID})
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to load sensitive content setting of user %d: %v", viewerID, err)
+		return false
+	}
+	return prefs.GetShowSensitiveContent()
+}
+
+// masksFor reports whether t is flagged and written by someone other than the viewer.
+func masksFor(t *threadpb.Thread, viewerID uint32) bool {
+	return t != nil && (t.GetIsSensitive() || t.GetContentWarning() != "") && t.GetUserId() != viewerID
+}
+
+// maskThread drops the media and link preview of a flagged thread, and with a content warning also the
+// text, mentions and poll, leaving the warning for clients to show in their place.
+func maskThread(t *threadpb.Thread, viewerID uint32) {
+	if !masksFor(t, viewerID) {
+		return
+	}
+	t.IsMasked = true
+	t.MediaIds = nil
+	t.LinkPreview = nil
+	if t.GetContentWarning() != "" {
+		t.Content = ""
+		t.MentionedUserIds = nil
+		t.Poll = nil
+	}
+}
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/timeline"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/utils"
+	userpb "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	minChainPosts = 2
+	maxChainPosts = 25
+)
+
+// CreateThreadChain posts a numbered series as a chain of self-replies in one transaction, so a failure
+// halfway never leaves a broken chain. Events for every post are published only after the commit.
+func (h *ThreadHandler) CreateThreadChain(ctx context.Context, req *threadpb.CreateThreadChainRequest) (*threadpb.CreateThreadChainResponse, error) {
+	log.Printf("Received CreateThreadChain request for user %d with %d posts", req.UserId, len(req.Posts))
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "User ID is required")
+	}
+	if len(req.Posts) < minChainPosts || len(req.Posts) > maxChainPosts {
+		return nil, status.Errorf(codes.InvalidArgument, "A thread chain needs between %d and %d posts", minChainPosts, maxChainPosts)
+	}
+	for i, post := range req.Posts {
+		if post.GetContent() == "" && len(post.GetMediaIds()) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Post %d must have content or media", i+1)
+		}
+	}
+
+	var communityID *uint
+	if req.GetCommunityId() != 0 {
+		id := uint(req.GetCommunityId())
+		communityID = &id
+	}
+
+	threads := make([]*postgres.Thread, len(req.Posts))
+	hashtags := make([][]string, len(req.Posts))
+	mentionedUserIDs := make([][]uint32, len(req.Posts))
+	for i, post := range req.Posts {
+		threads[i] = &postgres.Thread{
+			UserID:           uint(req.UserId),
+			Content:          post.GetContent(),
+			ReplyRestriction: mapReplyRestrictionToString(req.ReplyRestriction),
+			MediaIDs:         uint32SliceToInt64Array(post.GetMediaIds()),
+			Categories:       utils.NormalizeCategories(post.GetCategories()),
+			CommunityID:      communityID,
+			LinkPreviewURL:   firstPreviewURL(post.GetContent()),
+		}
+		if req.ScheduledAt != nil && req.ScheduledAt.IsValid() {
+			scheduledTime := req.ScheduledAt.AsTime()
+			threads[i].ScheduledAt = &scheduledTime
+		}
+		hashtags[i] = utils.ExtractHashtags(post.GetContent())
+		mentionedUserIDs[i] = h.resolveMentionedUserIDs(ctx, post.GetContent(), req.UserId)
+	}
+
+	authorUsername := "Someone"
+	if h.userClient != nil {
+		profile, err := h.userClient.GetUserProfile(ctx, &userpb.GetUserProfileRequest{UserIdToView: req.UserId})
+		if err == nil && profile != nil && profile.User != nil {
+			authorUsername = profile.User.Username
+		}
+	}
+
+	err := h.repo.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		tempRepo := postgres.NewThreadRepositoryWithTx(tx)
+		for i, thread := range threads {
+			if i > 0 {
+				parentID := threads[i-1].ID
+				thread.ParentThreadID = &parentID
+			}
+			if err := tempRepo.CreateThread(ctx, thread); err != nil {
+				return fmt.Errorf("failed to create post %d: %w", i+1, err)
+			}
+			if len(hashtags[i]) > 0 {
+				if err := tempRepo.AddHashtags(ctx, thread.ID, hashtags[i]); err != nil {
+					return fmt.Errorf("failed to add hashtags to post %d: %w", i+1, err)
+				}
+			}
+			if len(mentionedUserIDs[i]) > 0 {
+				if err := tempRepo.AddMentions(ctx, thread.ID, thread.UserID, uint32SliceToUint(mentionedUserIDs[i])); err != nil {
+					return fmt.Errorf("failed to add mentions to post %d: %w", i+1, err)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Failed to create thread chain for user %d: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "Could not create thread chain")
+	}
+
+	resp := &threadpb.CreateThreadChainResponse{Threads: make([]*threadpb.Thread, 0, len(threads))}
+	for i, thread := range threads {
+		h.queueLinkPreview(thread.LinkPreviewURL)
+		// Scheduled chains get their events when the scheduler publishes each post
+		if thread.Status == postgres.ThreadStatusPublished {
+			h.publishThreadSideEffects(ctx, thread, mentionedUserIDs[i], authorUsername, hashtags[i])
+			h.publishReplyAndQuoteEvents(ctx, thread, authorUsername)
+			h.fanOut(timeline.Entry{ThreadID: thread.ID, SourceUserID: thread.UserID, At: thread.PostedAt}, false)
+		}
+		resp.Threads = append(resp.Threads, mapThreadToProto(thread))
+	}
+	if threads[0].Status != postgres.ThreadStatusPublished {
+		log.Printf("Thread chain %d scheduled for %v; deferring mention and hashtag events", threads[0].ID, threads[0].PostedAt)
+	}
+	return resp, nil
+}
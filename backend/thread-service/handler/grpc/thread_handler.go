@@ -2,18 +2,30 @@ package grpc
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	searchpb "github.com/Acad600-TPA/WEB-MJ-242/backend/search-service/genproto/proto"
 	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/ads"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/analytics"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/linkpreview"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/moderation"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/ranking"
 	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/timeline"
 	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/utils"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/mute"
 	userpb "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genproto/proto"
 	"github.com/lib/pq"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
@@ -24,8 +36,23 @@ type ThreadHandler struct {
 	repo *postgres.ThreadRepository
 	userClient userpb.UserServiceClient
 	searchClient searchpb.SearchServiceClient
+	editWindow time.Duration
+	restoreWindow time.Duration
+	ranking ranking.Config
+	rankSnapshots *ranking.SnapshotCache
+	timelines *timeline.Store // nil when Redis is unavailable; the following feed is then built in Postgres
+	ads ads.Config
+	moderation moderation.Config
+	linkPreviews *linkpreview.Worker // nil disables link preview fetching
+	analytics *analytics.Aggregator
 }
 
+// defaultEditWindow is how long after posting a thread can still be edited
+const defaultEditWindow = 30 * time.Minute
+
+// defaultRestoreWindow is how long a deleted thread can be restored before it is purged
+const defaultRestoreWindow = 30 * 24 * time.Hour
+
 type ThreadLikedEventPayload struct {
     ThreadID         uint32 `json:"thread_id"`
     ThreadAuthorID   uint32 `json:"thread_author_id"`
@@ -33,6 +60,30 @@ type ThreadLikedEventPayload struct {
     LikedByUsername  string `json:"liked_by_username"`
 }
 
+type ThreadRepostedEventPayload struct {
+    ThreadID            uint32 `json:"thread_id"`
+    ThreadAuthorID      uint32 `json:"thread_author_id"`
+    RepostedByUserID    uint32 `json:"reposted_by_user_id"`
+    RepostedByUsername  string `json:"reposted_by_username"`
+}
+
+type ThreadDeletedEventPayload struct {
+    ThreadID       uint32    `json:"thread_id"`
+    ThreadAuthorID uint32    `json:"thread_author_id"`
+    ParentThreadID *uint32   `json:"parent_thread_id,omitempty"`
+    WasPublished   bool      `json:"was_published"`
+    Hashtags       []string  `json:"hashtags,omitempty"` // tags to take off trending; empty unless WasPublished
+    DeletedAt      time.Time `json:"deleted_at"`         // tells a re-delete after RestoreThread apart from a redelivery
+}
+
+type ThreadPollClosedEventPayload struct {
+    ThreadID       uint32 `json:"thread_id"`
+    ThreadAuthorID uint32 `json:"thread_author_id"`
+    PollID         uint32 `json:"poll_id"`
+    TotalVotes     int64  `json:"total_votes"`
+    WinningOption  string `json:"winning_option"` // empty if there were no votes or a tie for first
+}
+
 type MentionEventPayload struct {
     ThreadID             uint32 `json:"thread_id"`
     MentionedUserID      uint32 `json:"mentioned_user_id"`
@@ -41,11 +92,54 @@ type MentionEventPayload struct {
     ThreadContentSnippet string `json:"thread_content_snippet"`
 }
 
-func NewThreadHandler(repo *postgres.ThreadRepository, userClient userpb.UserServiceClient, searchClient searchpb.SearchServiceClient) *ThreadHandler {
+type ThreadRepliedEventPayload struct {
+    ThreadID             uint32   `json:"thread_id"` // the reply
+    ParentThreadID       uint32   `json:"parent_thread_id"`
+    ParentAuthorID       uint32   `json:"parent_author_id"`
+    ReplyAuthorID        uint32   `json:"reply_author_id"`
+    ReplyAuthorUsername  string   `json:"reply_author_username"`
+    ParticipantIDs       []uint32 `json:"participant_ids,omitempty"` // authors further up the chain, nearest first, without the parent author or replier
+    ThreadContentSnippet string   `json:"thread_content_snippet"`
+}
+
+type ThreadQuotedEventPayload struct {
+    ThreadID             uint32 `json:"thread_id"` // the quote
+    QuotedThreadID       uint32 `json:"quoted_thread_id"`
+    QuotedAuthorID       uint32 `json:"quoted_author_id"`
+    QuotingUserID        uint32 `json:"quoting_user_id"`
+    QuotingUsername      string `json:"quoting_username"`
+    ThreadContentSnippet string `json:"thread_content_snippet"`
+}
+
+// maxReplyParticipants caps how many authors further up a reply chain hear about a reply
+const maxReplyParticipants = 10
+
+func NewThreadHandler(repo *postgres.ThreadRepository, userClient userpb.UserServiceClient, searchClient searchpb.SearchServiceClient, timelines *timeline.Store, previewFetcher linkpreview.Fetcher) *ThreadHandler {
+	editWindow := defaultEditWindow
+	if v, err := strconv.Atoi(os.Getenv("THREAD_EDIT_WINDOW_MINUTES")); err == nil && v > 0 {
+		editWindow = time.Duration(v) * time.Minute
+	}
+	restoreWindow := defaultRestoreWindow
+	if v, err := strconv.Atoi(os.Getenv("THREAD_RESTORE_WINDOW_DAYS")); err == nil && v > 0 {
+		restoreWindow = time.Duration(v) * 24 * time.Hour
+	}
+	var previews *linkpreview.Worker
+	if previewFetcher != nil {
+		previews = linkpreview.NewWorker(previewFetcher, linkPreviewStore{repo: repo}, linkpreview.ConfigFromEnv())
+	}
 	return &ThreadHandler{
 		repo: repo,
 		userClient: userClient,
 		searchClient: searchClient,
+		editWindow: editWindow,
+		restoreWindow: restoreWindow,
+		ranking: ranking.ConfigFromEnv(),
+		rankSnapshots: ranking.NewSnapshotCache(),
+		timelines: timelines,
+		ads: ads.ConfigFromEnv(),
+		moderation: moderation.ConfigFromEnv(),
+		linkPreviews: previews,
+		analytics: analytics.NewAggregator(analyticsStore{repo: repo}, analytics.ConfigFromEnv()),
 	}
 }
 
@@ -66,17 +160,58 @@ func (h *ThreadHandler) CreateThread(ctx context.Context, req *threadpb.CreateTh
 	if req.Content == "" && len(req.MediaIds) == 0 {
          return nil, status.Errorf(codes.InvalidArgument, "Thread must have content or media")
     }
+	return h.createThread(ctx, req, nil)
+}
 
+// createThread is CreateThread after argument checks. inTx, when set, runs inside the thread's transaction
+// once the thread is inserted, so callers can make other changes that must commit or roll back with it;
+// a gRPC status error it returns is passed through to the caller.
+func (h *ThreadHandler) createThread(ctx context.Context, req *threadpb.CreateThreadRequest, inTx func(tempRepo *postgres.ThreadRepository, thread *postgres.Thread) error) (*threadpb.Thread, error) {
+	contentWarning, err := utils.NormalizeContentWarning(req.ContentWarning)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid content warning: %v", err)
+	}
 	thread := &postgres.Thread{
 		UserID:           uint(req.UserId),
 		Content:          req.Content,
-		ReplyRestriction: req.ReplyRestriction.String(),
+		ReplyRestriction: mapReplyRestrictionToString(req.ReplyRestriction),
         MediaIDs:         uint32SliceToInt64Array(req.MediaIds),
-		Categories: 	  req.Categories,
+		Categories: 	  utils.NormalizeCategories(req.Categories),
+		LinkPreviewURL:   firstPreviewURL(req.Content),
+		ContentWarning:   contentWarning,
+		IsSensitive:      req.IsSensitive,
 	}
     if req.GetParentThreadId() != 0 {
          parentID := uint(req.GetParentThreadId())
+         parent, err := h.repo.GetThreadByID(ctx, parentID)
+         if err != nil {
+             if err.Error() == "thread not found" { return nil, status.Errorf(codes.NotFound, "Parent thread not found") }
+             return nil, status.Errorf(codes.Internal, "Failed to retrieve parent thread")
+         }
+         if parent.Status != postgres.ThreadStatusPublished {
+             return nil, status.Errorf(codes.NotFound, "Parent thread not found")
+         }
+         allowed, err := h.canReply(ctx, parent, req.UserId)
+         if err != nil {
+             log.Printf("CreateThread: Could not evaluate reply restriction on thread %d for user %d: %v", parentID, req.UserId, err)
+             return nil, status.Errorf(codes.Internal, "Could not verify reply permission")
+         }
+         if !allowed {
+             return nil, status.Errorf(codes.PermissionDenied, "You are not allowed to reply to this thread")
+         }
          thread.ParentThreadID = &parentID
+    }
+    if req.GetQuotedThreadId() != 0 {
+         quotedID := uint(req.GetQuotedThreadId())
+         quoted, err := h.repo.GetThreadByID(ctx, quotedID)
+         if err != nil {
+             if err.Error() == "thread not found" { return nil, status.Errorf(codes.NotFound, "Quoted thread not found") }
+             return nil, status.Errorf(codes.Internal, "Failed to retrieve quoted thread")
+         }
+         if quoted.Status != postgres.ThreadStatusPublished {
+             return nil, status.Errorf(codes.NotFound, "Quoted thread not found")
+         }
+         thread.QuotedThreadID = &quotedID
     }
      if req.GetCommunityId() != 0 {
          communityID := uint(req.GetCommunityId())
@@ -87,10 +222,27 @@ func (h *ThreadHandler) CreateThread(ctx context.Context, req *threadpb.CreateTh
          thread.ScheduledAt = &scheduledTime
     }
 
+    var poll *postgres.Poll
+    if len(req.GetPollOptions()) > 0 {
+         options, err := utils.NormalizePollOptions(req.GetPollOptions())
+         if err != nil { return nil, status.Errorf(codes.InvalidArgument, "Invalid poll: %v", err) }
+         // The poll's lifetime counts from when the thread goes live
+         goesLiveAt := time.Now().UTC()
+         if thread.ScheduledAt != nil && thread.ScheduledAt.After(goesLiveAt) { goesLiveAt = *thread.ScheduledAt }
+         var requestedClose time.Time
+         if req.PollClosesAt != nil && req.PollClosesAt.IsValid() { requestedClose = req.PollClosesAt.AsTime() }
+         closesAt, err := utils.PollClosingTime(goesLiveAt, requestedClose)
+         if err != nil { return nil, status.Errorf(codes.InvalidArgument, "Invalid poll: %v", err) }
+
+         poll = &postgres.Poll{ClosesAt: closesAt.UTC()}
+         for i, text := range options {
+             poll.Options = append(poll.Options, postgres.PollOption{Position: i, Text: text})
+         }
+    }
+
 	extractedHashtags := utils.ExtractHashtags(req.Content)
-	extractedMentionUsernames := utils.ExtractMentions(req.Content)
 
-	var mentionedUserIDs []uint32
+	mentionedUserIDs := h.resolveMentionedUserIDs(ctx, req.Content, req.UserId)
 	var mentionerUsername string = "Someone"
 
 	// Fetch mentioner's username
@@ -101,24 +253,17 @@ func (h *ThreadHandler) CreateThread(ctx context.Context, req *threadpb.CreateTh
         }
     }
 
-	if len(extractedMentionUsernames) > 0 && h.userClient != nil {
-		for _, username := range extractedMentionUsernames {
-			userResp, err := h.userClient.GetUserByUsername(ctx, &userpb.GetUserByUsernameRequest{Username: username})
-			if err == nil && userResp != nil {
-				if userResp.GetId() != req.UserId {
-                    mentionedUserIDs = append(mentionedUserIDs, userResp.GetId())
-                }
-			} else {
-				log.Printf("CreateThread: Could not resolve mention for username @%s: %v", username, err)
-			}
-		}
-	}
-
-	err := h.repo.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	err = h.repo.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		tempRepo := postgres.NewThreadRepositoryWithTx(tx)
 		if err := tempRepo.CreateThread(ctx, thread); err != nil {
 			return err
 		}
+		if poll != nil {
+			poll.ThreadID = thread.ID
+			if err := tempRepo.CreatePoll(ctx, poll); err != nil {
+				return err
+			}
+		}
 		if len(extractedHashtags) > 0 {
 			if err := tempRepo.AddHashtags(ctx, thread.ID, extractedHashtags); err != nil {
 				return fmt.Errorf("failed to add hashtags: %w", err)
@@ -129,26 +274,98 @@ func (h *ThreadHandler) CreateThread(ctx context.Context, req *threadpb.CreateTh
 				return fmt.Errorf("failed to add mentions: %w", err)
 			}
 		}
+		if inTx != nil {
+			return inTx(tempRepo, thread)
+		}
 		return nil
 	})
 
 	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		log.Printf("Failed to create thread with hashtags/mentions for user %d: %v", req.UserId, err)
 		return nil, status.Errorf(codes.Internal, "Could not create thread")
 	}
 
+	h.queueLinkPreview(thread.LinkPreviewURL)
+
+	// Scheduled threads defer their side effects until the scheduler publishes them
+	if thread.Status == postgres.ThreadStatusPublished {
+		h.publishThreadSideEffects(ctx, thread, mentionedUserIDs, mentionerUsername, extractedHashtags)
+		h.publishReplyAndQuoteEvents(ctx, thread, mentionerUsername)
+		h.fanOut(timeline.Entry{ThreadID: thread.ID, SourceUserID: thread.UserID, At: thread.PostedAt}, false)
+	} else {
+		log.Printf("Thread %d scheduled for %v; deferring mention and hashtag events", thread.ID, thread.PostedAt)
+	}
+
+	// Map DB model back to proto response
+	tProto := mapThreadToProto(thread)
+	if poll != nil {
+		tProto.Poll = mapPollToProto(poll, nil, time.Now())
+	}
+	return tProto, nil
+}
+
+// resolveMentionedUserIDs looks up the users @mentioned in content, skipping the author, unknown usernames
+// and users whose mention setting doesn't allow the author to mention them. Skipped @handles stay plain text.
+func (h *ThreadHandler) resolveMentionedUserIDs(ctx context.Context, content string, authorID uint32) []uint32 {
+	var mentionedUserIDs []uint32
+	extractedMentionUsernames := utils.ExtractMentions(content)
+	if len(extractedMentionUsernames) == 0 || h.userClient == nil {
+		return mentionedUserIDs
+	}
+	for _, username := range extractedMentionUsernames {
+		userResp, err := h.userClient.GetUserByUsername(ctx, &userpb.GetUserByUsernameRequest{Username: username})
+		if err == nil && userResp != nil {
+			if userResp.GetId() != authorID && h.canMention(ctx, userResp.GetId(), authorID) {
+				mentionedUserIDs = append(mentionedUserIDs, userResp.GetId())
+			}
+		} else {
+			log.Printf("Could not resolve mention for username @%s: %v", username, err)
+		}
+	}
+	return mentionedUserIDs
+}
+
+// canMention applies the mentioned user's mention setting to the author. The setting is private, so it is
+// read through GetContentPreferences. "following" means the mentioned user follows the author. Errors deny the mention.
+func (h *ThreadHandler) canMention(ctx context.Context, mentionedID, authorID uint32) bool {
+	prefs, err := h.userClient.GetContentPreferences(ctx, &userpb.GetContentPreferencesRequest{UserId: mentionedID})
+	if err != nil {
+		log.Printf("Could not load mention setting of user %d: %v", mentionedID, err)
+		return false
+	}
+	switch prefs.GetMentionPermission() {
+	case "none":
+		return false
+	case "following":
+		resp, err := h.userClient.IsFollowing(ctx, &userpb.FollowCheckRequest{FollowerId: mentionedID, FollowedId: authorID})
+		if err != nil {
+			log.Printf("Could not check whether user %d follows %d for a mention: %v", mentionedID, authorID, err)
+			return false
+		}
+		return resp.GetIsTrue()
+	default:
+		return true
+	}
+}
+
+// publishThreadSideEffects fires the events that should only happen once a thread is publicly visible.
+// Edits call it with just the mentions and hashtags they added; followers' timelines are only pushed to
+// where a thread goes live, since an edit doesn't change its place in them.
+func (h *ThreadHandler) publishThreadSideEffects(ctx context.Context, thread *postgres.Thread, mentionedUserIDs []uint32, mentionerUsername string, hashtags []string) {
 	// Publish MentionEvents
     if len(mentionedUserIDs) > 0 && thread != nil {
-        contentSnippet := req.Content
-        if len(contentSnippet) > 100 { contentSnippet = contentSnippet[:97] + "..." }
+        snippet := contentSnippet(thread.Content)
 
         for _, mentionedUID := range mentionedUserIDs {
             eventPayload := MentionEventPayload{
                 ThreadID:             uint32(thread.ID),
                 MentionedUserID:      mentionedUID,
-                MentioningUserID:     req.UserId,
+                MentioningUserID:     uint32(thread.UserID),
                 MentioningUsername:   mentionerUsername,
-                ThreadContentSnippet: contentSnippet,
+                ThreadContentSnippet: snippet,
             }
             go func(payload MentionEventPayload) {
                 errPub := utils.PublishEvent(context.Background(), "thread_events", "thread.mentioned", payload)
@@ -158,45 +375,256 @@ func (h *ThreadHandler) CreateThread(ctx context.Context, req *threadpb.CreateTh
     }
 
     // Increment hashtag counts for trending (after successful thread creation)
-    if len(extractedHashtags) > 0 {
-        log.Printf("Thread %d created with hashtags: %v. Search service should process these.", thread.ID, extractedHashtags)
-        _, errSearch := h.searchClient.IncrementHashtagCounts(ctx, &searchpb.IncrementHashtagCountsRequest{Hashtags: extractedHashtags})
+    if len(hashtags) > 0 {
+        log.Printf("Thread %d published with hashtags: %v. Search service should process these.", thread.ID, hashtags)
+        _, errSearch := h.searchClient.IncrementHashtagCounts(ctx, &searchpb.IncrementHashtagCountsRequest{Hashtags: hashtags})
         if errSearch != nil { log.Printf("Error calling SearchService to increment hashtags: %v", errSearch)}
     }
+}
 
-	// Map DB model back to proto response
-	return mapThreadToProto(thread), nil
+// PublishScheduledThread runs the deferred side effects for a thread the scheduler has just published.
+func (h *ThreadHandler) PublishScheduledThread(ctx context.Context, thread *postgres.Thread) error {
+	mentionedIDs, err := h.repo.GetMentionedUserIDs(ctx, thread.ID)
+	if err != nil {
+		return err
+	}
+
+	mentionerUsername := "Someone"
+	if h.userClient != nil {
+		profile, errUser := h.userClient.GetUserProfile(ctx, &userpb.GetUserProfileRequest{UserIdToView: uint32(thread.UserID)})
+		if errUser == nil && profile != nil && profile.User != nil {
+			mentionerUsername = profile.User.Username
+		}
+	}
+
+	mentionedUserIDs := make([]uint32, len(mentionedIDs))
+	for i, id := range mentionedIDs {
+		mentionedUserIDs[i] = uint32(id)
+	}
+	h.publishThreadSideEffects(ctx, thread, mentionedUserIDs, mentionerUsername, utils.ExtractHashtags(thread.Content))
+	h.publishReplyAndQuoteEvents(ctx, thread, mentionerUsername)
+	h.fanOut(timeline.Entry{ThreadID: thread.ID, SourceUserID: thread.UserID, At: thread.PostedAt}, false)
+	return nil
+}
+
+// publishReplyAndQuoteEvents tells the authors a new thread replies to or quotes. Unlike publishThreadSideEffects
+// it only runs when a thread goes live, never on edits, so nobody hears about the same reply twice.
+func (h *ThreadHandler) publishReplyAndQuoteEvents(ctx context.Context, thread *postgres.Thread, authorUsername string) {
+	snippet := contentSnippet(thread.Content)
+
+	if thread.ParentThreadID != nil {
+		// The parent is last in the chain, or missing if it was deleted before a scheduled reply went live
+		ancestors, err := h.repo.GetAncestors(ctx, thread.ID, maxReplyParticipants+1, nil)
+		if err != nil {
+			log.Printf("Could not get reply chain of thread %d for reply notifications: %v", thread.ID, err)
+		} else if len(ancestors) > 0 && ancestors[len(ancestors)-1].ID == *thread.ParentThreadID {
+			parent := ancestors[len(ancestors)-1]
+			seen := map[uint]bool{thread.UserID: true, parent.UserID: true}
+			var participantIDs []uint32
+			for i := len(ancestors) - 2; i >= 0; i-- {
+				if !seen[ancestors[i].UserID] {
+					seen[ancestors[i].UserID] = true
+					participantIDs = append(participantIDs, uint32(ancestors[i].UserID))
+				}
+			}
+			// Self-replies such as the posts of a thread chain have nobody to tell
+			if parent.UserID != thread.UserID || len(participantIDs) > 0 {
+				payload := ThreadRepliedEventPayload{
+					ThreadID:             uint32(thread.ID),
+					ParentThreadID:       uint32(parent.ID),
+					ParentAuthorID:       uint32(parent.UserID),
+					ReplyAuthorID:        uint32(thread.UserID),
+					ReplyAuthorUsername:  authorUsername,
+					ParticipantIDs:       participantIDs,
+					ThreadContentSnippet: snippet,
+				}
+				go func() {
+					if errPub := utils.PublishEvent(context.Background(), "thread_events", "thread.replied", payload); errPub != nil {
+						log.Printf("ERROR publishing ThreadRepliedEvent: %v", errPub)
+					}
+				}()
+			}
+		}
+	}
+
+	if thread.QuotedThreadID != nil {
+		quoted, err := h.repo.GetThreadByID(ctx, *thread.QuotedThreadID)
+		if err != nil {
+			if err.Error() != "thread not found" {
+				log.Printf("Could not get quoted thread %d for quote notification: %v", *thread.QuotedThreadID, err)
+			}
+			return
+		}
+		if quoted.Status != postgres.ThreadStatusPublished {
+			return
+		}
+		payload := ThreadQuotedEventPayload{
+			ThreadID:             uint32(thread.ID),
+			QuotedThreadID:       uint32(quoted.ID),
+			QuotedAuthorID:       uint32(quoted.UserID),
+			QuotingUserID:        uint32(thread.UserID),
+			QuotingUsername:      authorUsername,
+			ThreadContentSnippet: snippet,
+		}
+		go func() {
+			if errPub := utils.PublishEvent(context.Background(), "thread_events", "thread.quoted", payload); errPub != nil {
+				log.Printf("ERROR publishing ThreadQuotedEvent: %v", errPub)
+			}
+		}()
+	}
+}
+
+// contentSnippet shortens thread content for notification messages.
+func contentSnippet(content string) string {
+	if len(content) > 100 { return content[:97] + "..." }
+	return content
+}
+
+// canReply reports whether userID may reply to thread under its reply restriction.
+// The author can always reply to their own thread.
+func (h *ThreadHandler) canReply(ctx context.Context, thread *postgres.Thread, userID uint32) (bool, error) {
+	if userID == 0 {
+		return false, nil
+	}
+	if uint(userID) == thread.UserID {
+		return true, nil
+	}
+
+	switch mapStringToReplyRestriction(thread.ReplyRestriction) {
+	case threadpb.ReplyRestriction_FOLLOWING:
+		// Only accounts the author follows may reply
+		resp, err := h.userClient.IsFollowing(ctx, &userpb.FollowCheckRequest{FollowerId: uint32(thread.UserID), FollowedId: userID})
+		if err != nil {
+			return false, fmt.Errorf("failed to check follow status: %w", err)
+		}
+		return resp.GetIsTrue(), nil
+	case threadpb.ReplyRestriction_VERIFIED:
+		resp, err := h.userClient.GetUserProfile(ctx, &userpb.GetUserProfileRequest{UserIdToView: userID})
+		if err != nil {
+			return false, fmt.Errorf("failed to get replier profile: %w", err)
+		}
+		return resp.GetUser().GetIsVerified(), nil
+	default:
+		return true, nil
+	}
 }
 
-func (h *ThreadHandler) hydrateThreadInteractions(ctx context.Context, tProto *threadpb.Thread, currentUserID uint32) {
-	if tProto == nil {
-		return
+// hydrateThreads maps threads to protos with their counters from thread_stats, the requester's own
+// interactions and, for quote threads, the quoted thread embedded one level deep. Sensitive threads are
+// masked unless the requester opted into seeing them.
+func (h *ThreadHandler) hydrateThreads(ctx context.Context, dbThreads []postgres.Thread, requesterID uint32) []*threadpb.Thread {
+	protoThreads := make([]*threadpb.Thread, 0, len(dbThreads))
+	if len(dbThreads) == 0 {
+		return protoThreads
+	}
+
+	threadIDs := make([]uint, len(dbThreads))
+	var quotedIDs, parentIDs []uint
+	var previewURLs []string
+	for i, t := range dbThreads {
+		threadIDs[i] = t.ID
+		if t.LinkPreviewURL != nil {
+			previewURLs = append(previewURLs, *t.LinkPreviewURL)
+		}
+		if t.QuotedThreadID != nil {
+			quotedIDs = append(quotedIDs, *t.QuotedThreadID)
+		}
+		if t.ParentThreadID != nil {
+			parentIDs = append(parentIDs, *t.ParentThreadID)
+		}
 	}
 
-	likeCount, bookmarkCount, err := h.repo.GetInteractionCountsForThread(ctx, uint(tProto.Id))
+	deletedParents, err := h.repo.GetDeletedThreadIDs(ctx, parentIDs)
 	if err != nil {
-		log.Printf("Error getting interaction counts for thread %d: %v", tProto.Id, err)
-	} else {
-		tProto.LikeCount = int32(likeCount)
-		tProto.BookmarkCount = int32(bookmarkCount)
-		// TODO: Populate reply_count, repost_count when implemented
+		log.Printf("Error checking for deleted parent threads: %v", err)
 	}
 
-	if currentUserID != 0 {
-		isLiked, err := h.repo.CheckUserInteraction(ctx, uint(currentUserID), uint(tProto.Id), "like")
+	statsMap, err := h.repo.GetThreadStatsForMultipleThreads(ctx, threadIDs)
+	if err != nil {
+		log.Printf("Error fetching batch thread stats: %v", err)
+	}
+
+	pollsMap, err := h.repo.GetPollsForThreads(ctx, threadIDs)
+	if err != nil {
+		log.Printf("Error fetching batch polls: %v", err)
+	}
+	pollVotesMap := make(map[uint]uint)
+	if requesterID != 0 && len(pollsMap) > 0 {
+		pollIDs := make([]uint, 0, len(pollsMap))
+		for _, p := range pollsMap {
+			pollIDs = append(pollIDs, p.ID)
+		}
+		pollVotesMap, err = h.repo.GetUserPollVotes(ctx, uint(requesterID), pollIDs)
 		if err != nil {
-			log.Printf("Error checking like status for user %d, thread %d: %v", currentUserID, tProto.Id, err)
-		} else {
-			tProto.IsLikedByCurrentUser = isLiked
+			log.Printf("Error fetching batch poll votes: %v", err)
 		}
+	}
+	now := time.Now()
 
-		isBookmarked, err := h.repo.CheckUserInteraction(ctx, uint(currentUserID), uint(tProto.Id), "bookmark")
+	userInteractionsMap := make(map[uint]map[string]bool)
+	if requesterID != 0 {
+		userInteractionsMap, err = h.repo.CheckUserInteractionsForMultipleThreads(ctx, uint(requesterID), threadIDs)
 		if err != nil {
-			log.Printf("Error checking bookmark status for user %d, thread %d: %v", currentUserID, tProto.Id, err)
-		} else {
-			tProto.IsBookmarkedByCurrentUser = isBookmarked
+			log.Printf("Error fetching batch user interactions: %v", err)
+		}
+	}
+
+	quotedThreads, err := h.repo.GetThreadsByIDs(ctx, quotedIDs)
+	if err != nil {
+		log.Printf("Error fetching quoted threads: %v", err)
+	}
+
+	mentionsMap, err := h.repo.GetMentionedUserIDsForThreads(ctx, threadIDs)
+	if err != nil {
+		log.Printf("Error fetching batch mentions: %v", err)
+	}
+
+	previewsMap, err := h.repo.GetLinkPreviewsByURLs(ctx, previewURLs)
+	if err != nil {
+		log.Printf("Error fetching batch link previews: %v", err)
+	}
+
+	for i := range dbThreads {
+		tProto := mapThreadToProto(&dbThreads[i])
+		if dbThreads[i].ParentThreadID != nil && deletedParents[*dbThreads[i].ParentThreadID] {
+			tProto.ParentDeleted = true
+		}
+		for _, id := range mentionsMap[dbThreads[i].ID] {
+			tProto.MentionedUserIds = append(tProto.MentionedUserIds, uint32(id))
+		}
+		if url := dbThreads[i].LinkPreviewURL; url != nil {
+			if preview, ok := previewsMap[*url]; ok {
+				tProto.LinkPreview = mapLinkPreviewToProto(&preview)
+			}
+		}
+		if poll, ok := pollsMap[dbThreads[i].ID]; ok {
+			var votedOptionID *uint
+			if optionID, voted := pollVotesMap[poll.ID]; voted {
+				votedOptionID = &optionID
+			}
+			tProto.Poll = mapPollToProto(&poll, votedOptionID, now)
+		}
+		if stats, ok := statsMap[dbThreads[i].ID]; ok {
+			tProto.LikeCount = int32(stats.LikeCount)
+			tProto.ReplyCount = int32(stats.ReplyCount)
+			tProto.RepostCount = int32(stats.RepostCount)
+			tProto.BookmarkCount = int32(stats.BookmarkCount)
+			tProto.QuoteCount = int32(stats.QuoteCount)
+		}
+		if userThreadInteractions, ok := userInteractionsMap[dbThreads[i].ID]; ok {
+			tProto.IsLikedByCurrentUser = userThreadInteractions["like"]
+			tProto.IsBookmarkedByCurrentUser = userThreadInteractions["bookmark"]
+			tProto.IsRepostedByCurrentUser = userThreadInteractions["repost"]
 		}
+		if dbThreads[i].QuotedThreadID != nil {
+			if quoted, ok := quotedThreads[*dbThreads[i].QuotedThreadID]; ok {
+				tProto.QuotedThread = mapThreadToProto(&quoted)
+			}
+		}
+		protoThreads = append(protoThreads, tProto)
 	}
+	h.maskSensitiveThreads(ctx, protoThreads, requesterID)
+	return protoThreads
 }
 
 
@@ -211,8 +639,20 @@ func (h *ThreadHandler) GetThread(ctx context.Context, req *threadpb.GetThreadRe
          return nil, status.Errorf(codes.Internal, "Failed to retrieve thread")
      }
      
-     tProto := mapThreadToProto(thread)
-     h.hydrateThreadInteractions(ctx, tProto, *req.CurrentUserId)
+     // Scheduled threads are only visible to their author
+     if thread.Status != postgres.ThreadStatusPublished && thread.UserID != uint(req.GetCurrentUserId()) {
+         return nil, status.Errorf(codes.NotFound, "Thread not found")
+     }
+
+     tProto := h.hydrateThreads(ctx, []postgres.Thread{*thread}, req.GetCurrentUserId())[0]
+
+     if req.GetCurrentUserId() != 0 {
+         canReply, err := h.canReply(ctx, thread, req.GetCurrentUserId())
+         if err != nil {
+             log.Printf("GetThread: Could not evaluate reply restriction on thread %d for user %d: %v", thread.ID, req.GetCurrentUserId(), err)
+         }
+         tProto.CanReply = canReply
+     }
 
      return tProto, nil
 }
@@ -236,21 +676,185 @@ func (h *ThreadHandler) DeleteThread(ctx context.Context, req *threadpb.DeleteTh
          return nil, status.Errorf(codes.PermissionDenied, "You do not have permission to delete this thread")
     }
 
-     // 3. Perform soft delete (GORM handles this if DeletedAt field exists)
-     err = h.repo.PerformSoftDelete(ctx, uint(req.ThreadId))
+     // 3. Soft delete and clean up the thread's own rows in one transaction
+     deletion, err := h.repo.PerformSoftDelete(ctx, uint(req.ThreadId))
      if err != nil {
 		log.Printf("Failed to delete thread %d: %v", req.ThreadId, err)
-        if errors.Is(err, errors.New("thread not found")) { // Check error from repo delete
+        if err.Error() == "thread not found" { // Deleted concurrently
             return nil, status.Errorf(codes.NotFound, "Thread not found for deletion")
         }
 		return nil, status.Errorf(codes.Internal, "Failed to delete thread")
 	}
 
+     // 4. Let other services clean up what they own (trending counts, notifications)
+     eventPayload := ThreadDeletedEventPayload{
+         ThreadID:       uint32(deletion.Thread.ID),
+         ThreadAuthorID: uint32(deletion.Thread.UserID),
+         WasPublished:   deletion.Thread.Status == postgres.ThreadStatusPublished,
+         DeletedAt:      deletion.Thread.DeletedAt.Time,
+     }
+     if deletion.Thread.ParentThreadID != nil { val := uint32(*deletion.Thread.ParentThreadID); eventPayload.ParentThreadID = &val }
+     // Only published threads were ever counted towards trending
+     if eventPayload.WasPublished { eventPayload.Hashtags = deletion.Hashtags }
+     go func() {
+         errPub := utils.PublishEvent(context.Background(), "thread_events", "thread.deleted", eventPayload)
+         if errPub != nil { log.Printf("ERROR publishing ThreadDeletedEvent for thread %d: %v", eventPayload.ThreadID, errPub) }
+     }()
+
      log.Printf("Thread %d soft deleted successfully by user %d", req.ThreadId, req.UserId)
      return &emptypb.Empty{}, nil
 }
 
 
+// --- Editing ---
+
+func (h *ThreadHandler) EditThread(ctx context.Context, req *threadpb.EditThreadRequest) (*threadpb.Thread, error) {
+	log.Printf("Received EditThread request for Thread %d by User %d", req.ThreadId, req.UserId)
+	if req.ThreadId == 0 || req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread ID and User ID are required")
+	}
+	if req.Content == "" && len(req.MediaIds) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread must have content or media")
+	}
+
+	newHashtags := utils.ExtractHashtags(req.Content)
+	newMentionIDs := h.resolveMentionedUserIDs(ctx, req.Content, req.UserId)
+	// Users who removed their mention stay removed
+	removedMentions, err := h.repo.GetRemovedMentionUserIDs(ctx, uint(req.ThreadId))
+	if err != nil {
+		log.Printf("Failed to get removed mentions of thread %d: %v", req.ThreadId, err)
+		return nil, status.Errorf(codes.Internal, "Could not edit thread")
+	}
+	keptMentionIDs := newMentionIDs[:0]
+	for _, id := range newMentionIDs {
+		if !removedMentions[uint(id)] {
+			keptMentionIDs = append(keptMentionIDs, id)
+		}
+	}
+	newMentionIDs = keptMentionIDs
+
+	var thread *postgres.Thread
+	var addedHashtags, removedHashtags []string
+	var addedMentionIDs []uint32
+	err = h.repo.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		tempRepo := postgres.NewThreadRepositoryWithTx(tx)
+		var err error
+		thread, err = tempRepo.GetThreadByIDForUpdate(ctx, uint(req.ThreadId))
+		if err != nil {
+			if err.Error() == "thread not found" { return status.Errorf(codes.NotFound, "Thread not found") }
+			return err
+		}
+		if thread.UserID != uint(req.UserId) {
+			return status.Errorf(codes.PermissionDenied, "You do not have permission to edit this thread")
+		}
+
+		// Scheduled threads haven't been seen by anyone yet, so they can be edited freely without keeping a revision
+		var editedAt *time.Time
+		if thread.Status == postgres.ThreadStatusPublished {
+			now := time.Now().UTC()
+			if now.Sub(thread.PostedAt) > h.editWindow {
+				return status.Errorf(codes.FailedPrecondition, "Threads can only be edited within %v of posting", h.editWindow)
+			}
+			editedAt = &now
+		}
+
+		newMedia := uint32SliceToInt64Array(req.MediaIds)
+		if thread.Content == req.Content && int64ArraysEqual(thread.MediaIDs, newMedia) {
+			return nil // Nothing changed
+		}
+		if err := tempRepo.UpdateThreadContent(ctx, thread, req.Content, newMedia, firstPreviewURL(req.Content), editedAt); err != nil {
+			return err
+		}
+
+		oldHashtags, err := tempRepo.GetHashtagsForThread(ctx, thread.ID)
+		if err != nil {
+			return err
+		}
+		addedHashtags, removedHashtags = utils.DiffHashtags(oldHashtags, newHashtags)
+		if err := tempRepo.RemoveHashtags(ctx, thread.ID, removedHashtags); err != nil {
+			return err
+		}
+		if err := tempRepo.AddHashtags(ctx, thread.ID, addedHashtags); err != nil {
+			return fmt.Errorf("failed to add hashtags: %w", err)
+		}
+
+		oldMentionIDs, err := tempRepo.GetMentionedUserIDs(ctx, thread.ID)
+		if err != nil {
+			return err
+		}
+		var removedMentionIDs []uint
+		addedMentionIDs, removedMentionIDs = diffMentionIDs(oldMentionIDs, newMentionIDs)
+		if err := tempRepo.RemoveMentions(ctx, thread.ID, removedMentionIDs); err != nil {
+			return err
+		}
+		if err := tempRepo.AddMentions(ctx, thread.ID, thread.UserID, uint32SliceToUint(addedMentionIDs)); err != nil {
+			return fmt.Errorf("failed to add mentions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to edit thread %d: %v", req.ThreadId, err)
+		return nil, status.Errorf(codes.Internal, "Could not edit thread")
+	}
+
+	h.queueLinkPreview(thread.LinkPreviewURL)
+
+	// Only newly mentioned users are notified and only new hashtags count towards trending.
+	// Scheduled threads get these when the scheduler publishes them.
+	if thread.Status == postgres.ThreadStatusPublished {
+		mentionerUsername := "Someone"
+		if len(addedMentionIDs) > 0 && h.userClient != nil {
+			profile, errUser := h.userClient.GetUserProfile(ctx, &userpb.GetUserProfileRequest{UserIdToView: req.UserId})
+			if errUser == nil && profile != nil && profile.User != nil {
+				mentionerUsername = profile.User.Username
+			}
+		}
+		h.publishThreadSideEffects(ctx, thread, addedMentionIDs, mentionerUsername, addedHashtags)
+		if len(removedHashtags) > 0 {
+			_, errSearch := h.searchClient.DecrementHashtagCounts(ctx, &searchpb.DecrementHashtagCountsRequest{Hashtags: removedHashtags})
+			if errSearch != nil { log.Printf("Error calling SearchService to decrement hashtags: %v", errSearch) }
+		}
+	}
+
+	return h.hydrateThreads(ctx, []postgres.Thread{*thread}, req.UserId)[0], nil
+}
+
+func (h *ThreadHandler) GetThreadRevisions(ctx context.Context, req *threadpb.GetThreadRevisionsRequest) (*threadpb.GetThreadRevisionsResponse, error) {
+	log.Printf("Received GetThreadRevisions request for Thread %d", req.ThreadId)
+	if req.ThreadId == 0 { return nil, status.Errorf(codes.InvalidArgument, "Thread ID is required") }
+
+	thread, err := h.repo.GetThreadByID(ctx, uint(req.ThreadId))
+	if err != nil {
+		if err.Error() == "thread not found" { return nil, status.Errorf(codes.NotFound, "Thread not found") }
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve thread")
+	}
+	if thread.Status != postgres.ThreadStatusPublished && thread.UserID != uint(req.GetRequesterUserId()) {
+		return nil, status.Errorf(codes.NotFound, "Thread not found")
+	}
+
+	revisions, err := h.repo.GetThreadRevisions(ctx, thread.ID)
+	if err != nil {
+		log.Printf("Failed to get revisions for thread %d: %v", thread.ID, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve thread revisions")
+	}
+
+	protoRevisions := make([]*threadpb.ThreadRevision, 0, len(revisions))
+	for _, rev := range revisions {
+		protoRevisions = append(protoRevisions, &threadpb.ThreadRevision{
+			Id:        uint32(rev.ID),
+			ThreadId:  uint32(rev.ThreadID),
+			Content:   rev.Content,
+			MediaIds:  int64ArrayToUint32Slice(rev.MediaIDs),
+			CreatedAt: timestamppb.New(rev.CreatedAt),
+		})
+	}
+	h.maskRevisions(ctx, thread, protoRevisions, req.GetRequesterUserId())
+	return &threadpb.GetThreadRevisionsResponse{Revisions: protoRevisions}, nil
+}
+
 // --- Interaction Handlers ---
 
 func (h *ThreadHandler) LikeThread(ctx context.Context, req *threadpb.InteractThreadRequest) (*emptypb.Empty, error) {
@@ -265,6 +869,9 @@ func (h *ThreadHandler) LikeThread(ctx context.Context, req *threadpb.InteractTh
         }
         return nil, status.Errorf(codes.Internal, "Failed to retrieve thread for like")
     }
+    if thread.Status != postgres.ThreadStatusPublished {
+        return nil, status.Errorf(codes.NotFound, "Cannot like thread: thread not found")
+    }
 
     err = h.repo.AddInteraction(ctx, uint(req.UserId), uint(req.ThreadId), "like")
      if err != nil {
@@ -315,7 +922,15 @@ func (h *ThreadHandler) UnlikeThread(ctx context.Context, req *threadpb.Interact
  func (h *ThreadHandler) BookmarkThread(ctx context.Context, req *threadpb.InteractThreadRequest) (*emptypb.Empty, error) {
      log.Printf("Received BookmarkThread request for Thread %d by User %d", req.ThreadId, req.UserId)
      if req.ThreadId == 0 || req.UserId == 0 { return nil, status.Errorf(codes.InvalidArgument, "Thread ID and User ID required") }
-     err := h.repo.AddInteraction(ctx, uint(req.UserId), uint(req.ThreadId), "bookmark")
+     thread, err := h.repo.GetThreadByID(ctx, uint(req.ThreadId))
+     if err != nil {
+         if err.Error() == "thread not found" { return nil, status.Errorf(codes.NotFound, "Cannot bookmark thread: thread not found") }
+         return nil, status.Errorf(codes.Internal, "Failed to retrieve thread for bookmark")
+     }
+     if thread.Status != postgres.ThreadStatusPublished {
+         return nil, status.Errorf(codes.NotFound, "Cannot bookmark thread: thread not found")
+     }
+     err = h.repo.AddInteraction(ctx, uint(req.UserId), uint(req.ThreadId), "bookmark")
       if err != nil {
         if err.Error() == "interaction already exists" { return &emptypb.Empty{}, nil }
         if err.Error() == "user or thread not found for interaction" { return nil, status.Errorf(codes.NotFound, "Cannot bookmark thread: user or thread not found") }
@@ -337,16 +952,133 @@ func (h *ThreadHandler) UnlikeThread(ctx context.Context, req *threadpb.Interact
       return &emptypb.Empty{}, nil
  }
 
- func (h *ThreadHandler) GetFeedThreads(ctx context.Context, req *threadpb.GetFeedThreadsRequest) (*threadpb.GetFeedThreadsResponse, error) {
+func (h *ThreadHandler) Repost(ctx context.Context, req *threadpb.InteractThreadRequest) (*emptypb.Empty, error) {
+	log.Printf("Received Repost request for Thread %d by User %d", req.ThreadId, req.UserId)
+	if req.ThreadId == 0 || req.UserId == 0 { return nil, status.Errorf(codes.InvalidArgument, "Thread ID and User ID required") }
+
+	thread, err := h.repo.GetThreadByID(ctx, uint(req.ThreadId))
+	if err != nil {
+		if err.Error() == "thread not found" { return nil, status.Errorf(codes.NotFound, "Cannot repost thread: thread not found") }
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve thread for repost")
+	}
+	if thread.Status != postgres.ThreadStatusPublished {
+		return nil, status.Errorf(codes.NotFound, "Cannot repost thread: thread not found")
+	}
+
+	err = h.repo.AddInteraction(ctx, uint(req.UserId), uint(req.ThreadId), "repost")
+	if err != nil {
+		if err.Error() == "interaction already exists" { return &emptypb.Empty{}, nil } // Idempotent repost
+		if err.Error() == "user or thread not found for interaction" { return nil, status.Errorf(codes.NotFound, "Cannot repost thread: user or thread not found") }
+		log.Printf("Failed to add repost interaction: %v", err)
+		return nil, status.Errorf(codes.Internal, "Failed to process repost")
+	}
+	h.fanOut(timeline.Entry{ThreadID: uint(req.ThreadId), SourceUserID: uint(req.UserId), Repost: true, At: time.Now().UTC()}, false)
+
+	// Publish ThreadRepostedEvent
+	if thread.UserID != uint(req.UserId) {
+		reposterUsername := "Someone"
+		reposterProfile, errUser := h.userClient.GetUserProfile(ctx, &userpb.GetUserProfileRequest{UserIdToView: req.UserId})
+		if errUser == nil && reposterProfile != nil && reposterProfile.User != nil {
+			reposterUsername = reposterProfile.User.Username
+		} else {
+			log.Printf("Repost: Could not get profile for reposter %d to publish event: %v", req.UserId, errUser)
+		}
+
+		eventPayload := ThreadRepostedEventPayload{
+			ThreadID:           req.ThreadId,
+			ThreadAuthorID:     uint32(thread.UserID),
+			RepostedByUserID:   req.UserId,
+			RepostedByUsername: reposterUsername,
+		}
+		go func() {
+			errPub := utils.PublishEvent(context.Background(), "thread_events", "thread.reposted", eventPayload)
+			if errPub != nil { log.Printf("ERROR publishing ThreadRepostedEvent: %v", errPub) }
+		}()
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ThreadHandler) Unrepost(ctx context.Context, req *threadpb.InteractThreadRequest) (*emptypb.Empty, error) {
+	log.Printf("Received Unrepost request for Thread %d by User %d", req.ThreadId, req.UserId)
+	if req.ThreadId == 0 || req.UserId == 0 { return nil, status.Errorf(codes.InvalidArgument, "Thread ID and User ID required") }
+
+	err := h.repo.RemoveInteraction(ctx, uint(req.UserId), uint(req.ThreadId), "repost")
+	if err != nil {
+		if err.Error() == "interaction not found" { return &emptypb.Empty{}, nil } // Idempotent unrepost
+		log.Printf("Failed to remove repost interaction: %v", err)
+		return nil, status.Errorf(codes.Internal, "Failed to process unrepost")
+	}
+	h.fanOut(timeline.Entry{ThreadID: uint(req.ThreadId), SourceUserID: uint(req.UserId), Repost: true}, true)
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ThreadHandler) GetFeedThreads(ctx context.Context, req *threadpb.GetFeedThreadsRequest) (*threadpb.GetFeedThreadsResponse, error) {
 	log.Printf("ThreadSvc: GetFeedThreads. Requester: %d, Type: %s, Exclude: %v, Include: %v",
 		req.GetCurrentUserId(), req.GetFeedType(), req.GetExcludeUserIds(), req.GetIncludeOnlyUserIds())
 
+	mutes := h.loadMuteFilter(ctx, req.GetCurrentUserId(), mute.ScopeHome)
+	req.ExcludeUserIds = mutes.withExcluded(req.GetExcludeUserIds())
+
+	resp, err := h.getMutedOrganicFeed(ctx, req, mutes)
+	if err != nil {
+		return nil, err
+	}
+	resp.Threads = h.insertAds(ctx, req, resp.Threads, mutes)
+	return resp, nil
+}
+
+// mutedFillRounds is how many organic pages are read to fill one feed page when muted phrases filter threads out
+const mutedFillRounds = 3
+
+// getMutedOrganicFeed is getOrganicFeed without the threads mutes hides. A page that filtering leaves short
+// is topped up from the following pages, and the cursor continues after the last page read.
+func (h *ThreadHandler) getMutedOrganicFeed(ctx context.Context, req *threadpb.GetFeedThreadsRequest, mutes muteFilter) (*threadpb.GetFeedThreadsResponse, error) {
+	resp, err := h.getOrganicFeed(ctx, req)
+	if err != nil || mutes.empty() {
+		return resp, err
+	}
+	limit, _ := getLimitOffset(req.Page, req.Limit)
+	threads := mutes.apply(resp.Threads)
+	for round := 1; round < mutedFillRounds && len(threads) < limit && resp.HasMore && resp.NextCursor != ""; round++ {
+		nextReq := proto.Clone(req).(*threadpb.GetFeedThreadsRequest)
+		nextReq.Cursor = resp.NextCursor
+		nextReq.Limit = int32(limit - len(threads))
+		resp, err = h.getOrganicFeed(ctx, nextReq)
+		if err != nil {
+			return nil, err
+		}
+		threads = append(threads, mutes.apply(resp.Threads)...)
+	}
+	resp.Threads = threads
+	return resp, nil
+}
+
+// getOrganicFeed builds a feed page before any ads are inserted.
+func (h *ThreadHandler) getOrganicFeed(ctx context.Context, req *threadpb.GetFeedThreadsRequest) (*threadpb.GetFeedThreadsResponse, error) {
 
 	limit, offset := getLimitOffset(req.Page, req.Limit)
+	after, err := parsePageCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	// The following feed also carries reposts made by the followed accounts.
+	// Callers that pass the followed IDs themselves get it straight from Postgres.
+	if req.GetFeedType() == "following" {
+		if len(req.GetIncludeOnlyUserIds()) > 0 {
+			return h.getFollowingFeed(ctx, req, uint32SliceToUint(req.GetIncludeOnlyUserIds()), limit, offset, after)
+		}
+		return h.getTimelineFeed(ctx, req, limit, offset, after)
+	}
+	if req.GetFeedType() == "foryou" {
+		return h.getForYouFeed(ctx, req, limit, offset, after)
+	}
 
 	params := postgres.GetThreadsParams{
 		Limit:              limit,
 		Offset:             offset,
+		After:              after,
 		ExcludeUserIDs:     uint32SliceToUint(req.GetExcludeUserIds()),
 		IncludeOnlyUserIDs: uint32SliceToUint(req.GetIncludeOnlyUserIds()),
 	}
@@ -357,42 +1089,7 @@ func (h *ThreadHandler) UnlikeThread(ctx context.Context, req *threadpb.Interact
 		return nil, status.Errorf(codes.Internal, "Could not retrieve feed")
 	}
 
-	protoThreads := make([]*threadpb.Thread, 0, len(dbThreads))
-	if len(dbThreads) > 0 {
-		threadIDs := make([]uint, len(dbThreads))
-		for i, t := range dbThreads {
-			threadIDs[i] = t.ID
-		}
-
-		countsMap, errCounts := h.repo.GetInteractionCountsForMultipleThreads(ctx, threadIDs)
-		if errCounts != nil {
-			log.Printf("Error fetching batch interaction counts: %v", errCounts)
-		}
-
-		userInteractionsMap := make(map[uint]map[string]bool)
-		if req.GetCurrentUserId() != 0 {
-			userInteractionsMap, err = h.repo.CheckUserInteractionsForMultipleThreads(ctx, uint(req.GetCurrentUserId()), threadIDs)
-			if err != nil {
-				log.Printf("Error fetching batch user interactions: %v", err)
-			}
-		}
-
-		for i := range dbThreads {
-			tProto := mapThreadToProto(&dbThreads[i])
-
-			if threadCounts, ok := countsMap[dbThreads[i].ID]; ok {
-				tProto.LikeCount = int32(threadCounts["like"])
-				tProto.BookmarkCount = int32(threadCounts["bookmark"])
-				// Populate other counts (reply, repost) when available
-			}
-
-			if userThreadInteractions, ok := userInteractionsMap[dbThreads[i].ID]; ok {
-				tProto.IsLikedByCurrentUser = userThreadInteractions["like"]
-				tProto.IsBookmarkedByCurrentUser = userThreadInteractions["bookmark"]
-			}
-			protoThreads = append(protoThreads, tProto)
-		}
-	}
+	protoThreads := h.hydrateThreads(ctx, dbThreads, req.GetCurrentUserId())
 
 	hasMore := len(dbThreads) == limit
 	log.Printf("Returning %d hydrated threads for feed request.", len(protoThreads))
@@ -400,11 +1097,180 @@ func (h *ThreadHandler) UnlikeThread(ctx context.Context, req *threadpb.Interact
 	return &threadpb.GetFeedThreadsResponse{
 		Threads: protoThreads,
 		HasMore: hasMore,
+		NextCursor: nextThreadCursor(dbThreads, limit),
 	}, nil
 }
 
-func (h *ThreadHandler) GetUserThreads(ctx context.Context, req *threadpb.GetUserThreadsRequest) (*threadpb.GetUserThreadsResponse, error) {
-	log.Printf("ThreadSvc: GetUserThreads. Target: %d, Requester: %d, Type: %s, Exclude: %v",
+func (h *ThreadHandler) getFollowingFeed(ctx context.Context, req *threadpb.GetFeedThreadsRequest, followedUserIDs []uint, limit, offset int, after *postgres.PageCursor) (*threadpb.GetFeedThreadsResponse, error) {
+	items, err := h.repo.GetFollowingFeed(ctx, followedUserIDs, uint32SliceToUint(req.GetExcludeUserIds()), limit, offset, after)
+	if err != nil {
+		log.Printf("Failed to get following feed from repo: %v", err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve feed")
+	}
+
+	dbThreads := make([]postgres.Thread, len(items))
+	for i := range items {
+		dbThreads[i] = items[i].Thread
+	}
+	protoThreads := h.hydrateThreads(ctx, dbThreads, req.GetCurrentUserId())
+	for i, tProto := range protoThreads {
+		if items[i].RepostedByUserID != nil {
+			reposterID := uint32(*items[i].RepostedByUserID)
+			tProto.RepostedByUserId = &reposterID
+			if items[i].RepostedAt != nil {
+				tProto.RepostedAt = timestamppb.New(*items[i].RepostedAt)
+			}
+		}
+	}
+
+	nextCursor := ""
+	if len(items) > 0 && len(items) == limit {
+		last := items[len(items)-1]
+		nextCursor = utils.EncodeCursor(last.ActivityAt, last.ID)
+	}
+
+	return &threadpb.GetFeedThreadsResponse{
+		Threads: protoThreads,
+		HasMore: len(items) == limit,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// getForYouFeed pages through the viewer's ranked snapshot for the current anchor.
+// The snapshot is built once per anchor so scrolling doesn't reshuffle as counters change.
+// Its cursor carries the snapshot anchor and the position reached, so later pages keep the first page's anchor.
+func (h *ThreadHandler) getForYouFeed(ctx context.Context, req *threadpb.GetFeedThreadsRequest, limit, offset int, after *postgres.PageCursor) (*threadpb.GetFeedThreadsResponse, error) {
+	now := time.Now().UTC()
+	anchor := ranking.Anchor(now, h.ranking)
+	if after != nil {
+		anchor, offset = after.At, int(after.ID)
+	}
+	viewerID := uint(req.GetCurrentUserId())
+
+	rankedIDs, ok := h.rankSnapshots.Get(viewerID, anchor, now)
+	if !ok {
+		var err error
+		rankedIDs, err = h.rankForYou(ctx, viewerID, uint32SliceToUint(req.GetExcludeUserIds()), anchor)
+		if err != nil {
+			log.Printf("Failed to rank foryou feed for user %d: %v", viewerID, err)
+			return nil, status.Errorf(codes.Internal, "Could not retrieve feed")
+		}
+		h.rankSnapshots.Put(viewerID, anchor, rankedIDs, now.Add(h.ranking.SnapshotTTL), now)
+	}
+
+	pageIDs, hasMore := ranking.Page(rankedIDs, limit, offset)
+	threadsMap, err := h.repo.GetThreadsByIDs(ctx, pageIDs)
+	if err != nil {
+		log.Printf("Failed to load foryou threads: %v", err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve feed")
+	}
+
+	// Threads deleted or authors blocked since the snapshot was taken are dropped from the page
+	excluded := make(map[uint]bool, len(req.GetExcludeUserIds()))
+	for _, id := range req.GetExcludeUserIds() {
+		excluded[uint(id)] = true
+	}
+	dbThreads := make([]postgres.Thread, 0, len(pageIDs))
+	for _, id := range pageIDs {
+		if t, ok := threadsMap[id]; ok && !excluded[t.UserID] {
+			dbThreads = append(dbThreads, t)
+		}
+	}
+
+	nextCursor := ""
+	if hasMore {
+		nextCursor = utils.EncodeCursor(anchor, uint(offset+len(pageIDs)))
+	}
+
+	return &threadpb.GetFeedThreadsResponse{
+		Threads: h.hydrateThreads(ctx, dbThreads, req.GetCurrentUserId()),
+		HasMore: hasMore,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (h *ThreadHandler) rankForYou(ctx context.Context, viewerID uint, excludeUserIDs []uint, anchor time.Time) ([]uint, error) {
+	dbCandidates, err := h.repo.GetRankingCandidates(ctx, anchor.Add(-h.ranking.Window), anchor, excludeUserIDs, h.ranking.PoolSize)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]ranking.Candidate, len(dbCandidates))
+	for i, c := range dbCandidates {
+		candidates[i] = ranking.Candidate{
+			ThreadID:      c.ID,
+			AuthorID:      c.UserID,
+			PostedAt:      c.PostedAt,
+			LikeCount:     c.LikeCount,
+			ReplyCount:    c.ReplyCount,
+			BookmarkCount: c.BookmarkCount,
+			Categories:    c.Categories,
+		}
+	}
+	return ranking.Rank(candidates, h.loadRankingViewer(ctx, viewerID), anchor, h.ranking), nil
+}
+
+// recentLikesForAffinity is how many of the viewer's latest likes feed category affinity
+const recentLikesForAffinity = 200
+
+// loadRankingViewer gathers the viewer's social graph and category affinity.
+// Failures degrade the ranking to engagement and recency instead of failing the feed.
+func (h *ThreadHandler) loadRankingViewer(ctx context.Context, viewerID uint) ranking.Viewer {
+	viewer := ranking.Viewer{}
+	if viewerID == 0 {
+		return viewer
+	}
+	userID := uint32(viewerID)
+
+	following, err := collectSocialIDs(func(page int32) (*userpb.UserIDListResponse, error) {
+		return h.userClient.GetFollowingIDs(ctx, &userpb.SocialListRequest{UserId: userID, Page: page, Limit: socialIDsPageSize})
+	})
+	if err != nil {
+		log.Printf("Ranking: Failed to get following IDs for user %d: %v", viewerID, err)
+	}
+	viewer.Following = following
+
+	followers, err := collectSocialIDs(func(page int32) (*userpb.UserIDListResponse, error) {
+		return h.userClient.GetFollowerIDs(ctx, &userpb.SocialListRequest{UserId: userID, Page: page, Limit: socialIDsPageSize})
+	})
+	if err != nil {
+		log.Printf("Ranking: Failed to get follower IDs for user %d: %v", viewerID, err)
+	}
+	viewer.Followers = followers
+
+	likeCounts, err := h.repo.GetLikedCategoryCounts(ctx, viewerID, recentLikesForAffinity)
+	if err != nil {
+		log.Printf("Ranking: Failed to get liked categories for user %d: %v", viewerID, err)
+	}
+	viewer.CategoryAffinity = ranking.CategoryAffinity(likeCounts)
+	return viewer
+}
+
+const (
+	socialIDsPageSize = 50 // user-service caps list pages at 50
+	maxSocialIDs      = 2000
+)
+
+// collectSocialIDs pages through a user-service ID list until it is exhausted or maxSocialIDs is reached.
+// Whatever was collected before an error is still returned.
+func collectSocialIDs(fetch func(page int32) (*userpb.UserIDListResponse, error)) (map[uint]bool, error) {
+	ids := make(map[uint]bool)
+	for page := int32(1); len(ids) < maxSocialIDs; page++ {
+		resp, err := fetch(page)
+		if err != nil {
+			return ids, err
+		}
+		for _, id := range resp.GetUserIds() {
+			ids[uint(id)] = true
+		}
+		if !resp.GetHasMore() {
+			break
+		}
+	}
+	return ids, nil
+}
+
+func (h *ThreadHandler) GetUserThreads(ctx context.Context, req *threadpb.GetUserThreadsRequest) (*threadpb.GetUserThreadsResponse, error) {
+	log.Printf("ThreadSvc: GetUserThreads. Target: %d, Requester: %d, Type: %s, Exclude: %v",
 		req.TargetUserId, req.GetRequesterUserId(), req.ThreadType, req.GetExcludeUserIds())
 	
 	if req.TargetUserId == 0 {
@@ -412,22 +1278,30 @@ func (h *ThreadHandler) GetUserThreads(ctx context.Context, req *threadpb.GetUse
 	}
 
 	limit, offset := getLimitOffset(req.Page, req.Limit)
+	after, err := parsePageCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
 	var dbThreads []postgres.Thread
-	var err error
+	var nextCursor string
 
 	// Fetch threads based on type
 	if req.ThreadType == "likes" {
-		dbThreads, err = h.repo.GetLikedThreadsByUser(ctx, uint(req.TargetUserId), limit, offset)
+		var liked []postgres.InteractedThread
+		liked, err = h.repo.GetLikedThreadsByUser(ctx, uint(req.TargetUserId), limit, offset, after)
+		dbThreads, nextCursor = unwrapInteractedThreads(liked, limit)
 	} else {
 		// For posts, replies, media
 		params := postgres.GetThreadsParams{
 			Limit:       limit,
 			Offset:      offset,
+			After:       after,
 			ByUserID:    pointToUint(uint(req.TargetUserId)),
 			FeedTabType: req.ThreadType,
 			ExcludeUserIDs: uint32SliceToUint(req.GetExcludeUserIds()),
 		}
 		dbThreads, err = h.repo.GetThreads(ctx, params)
+		nextCursor = nextThreadCursor(dbThreads, limit)
 	}
 
 	if err != nil {
@@ -435,33 +1309,7 @@ func (h *ThreadHandler) GetUserThreads(ctx context.Context, req *threadpb.GetUse
 		return nil, status.Errorf(codes.Internal, "Could not retrieve user threads")
 	}
 
-	protoThreads := make([]*threadpb.Thread, 0, len(dbThreads))
-	if len(dbThreads) > 0 {
-		threadIDs := make([]uint, len(dbThreads))
-		for i, t := range dbThreads { threadIDs[i] = t.ID }
-
-		countsMap, errCounts := h.repo.GetInteractionCountsForMultipleThreads(ctx, threadIDs)
-		if errCounts != nil { log.Printf("Error fetching batch interaction counts for user threads: %v", errCounts) }
-
-		userInteractionsMap := make(map[uint]map[string]bool)
-		if req.GetRequesterUserId() != 0 {
-			userInteractionsMap, err = h.repo.CheckUserInteractionsForMultipleThreads(ctx, uint(req.GetRequesterUserId()), threadIDs)
-			if err != nil { log.Printf("Error fetching batch user interactions for user threads: %v", err) }
-		}
-
-		for i := range dbThreads {
-			tProto := mapThreadToProto(&dbThreads[i])
-			if threadCounts, ok := countsMap[dbThreads[i].ID]; ok {
-				tProto.LikeCount = int32(threadCounts["like"])
-				tProto.BookmarkCount = int32(threadCounts["bookmark"])
-			}
-			if userThreadInteractions, ok := userInteractionsMap[dbThreads[i].ID]; ok {
-				tProto.IsLikedByCurrentUser = userThreadInteractions["like"]
-				tProto.IsBookmarkedByCurrentUser = userThreadInteractions["bookmark"]
-			}
-			protoThreads = append(protoThreads, tProto)
-		}
-	}
+	protoThreads := h.hydrateThreads(ctx, dbThreads, req.GetRequesterUserId())
 
 	hasMore := len(dbThreads) == limit
 	log.Printf("Returning %d hydrated threads for GetUserThreads request.", len(protoThreads))
@@ -469,6 +1317,7 @@ func (h *ThreadHandler) GetUserThreads(ctx context.Context, req *threadpb.GetUse
 	return &threadpb.GetUserThreadsResponse{
 		Threads: protoThreads,
 		HasMore: hasMore,
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -481,15 +1330,20 @@ func (h *ThreadHandler) GetCommunityThreads(ctx context.Context, req *threadpb.G
     }
 
     limit, offset := getLimitOffset(req.Page, req.Limit)
+    after, err := parsePageCursor(req.GetCursor())
+    if err != nil {
+        return nil, err
+    }
     var dbThreads []postgres.Thread
-    var err error
+    mutes := h.loadMuteFilter(ctx, req.GetRequesterUserId(), mute.ScopeHome)
 
     // Prepare params for repo
     params := postgres.GetThreadsParams{
         Limit:          limit,
         Offset:         offset,
+        After:          after,
         ForCommunityID:    pointToUint(uint(req.CommunityId)),
-        ExcludeUserIDs: uint32SliceToUint(req.GetExcludeUserIds()),
+        ExcludeUserIDs: uint32SliceToUint(mutes.withExcluded(req.GetExcludeUserIds())),
     }
 
     dbThreads, err = h.repo.GetThreads(ctx, params)
@@ -498,39 +1352,7 @@ func (h *ThreadHandler) GetCommunityThreads(ctx context.Context, req *threadpb.G
         return nil, status.Errorf(codes.Internal, "Could not retrieve community threads")
     }
 
-    protoThreads := make([]*threadpb.Thread, 0, len(dbThreads))
-    if len(dbThreads) > 0 {
-        threadIDs := make([]uint, len(dbThreads))
-        for i, t := range dbThreads {
-            threadIDs[i] = t.ID
-        }
-
-        countsMap, errCounts := h.repo.GetInteractionCountsForMultipleThreads(ctx, threadIDs)
-        if errCounts != nil {
-            log.Printf("Error fetching batch interaction counts for community threads: %v", errCounts)
-        }
-
-        userInteractionsMap := make(map[uint]map[string]bool)
-        if req.GetRequesterUserId() != 0 {
-            userInteractionsMap, err = h.repo.CheckUserInteractionsForMultipleThreads(ctx, uint(req.GetRequesterUserId()), threadIDs)
-            if err != nil {
-                log.Printf("Error fetching batch user interactions for community threads: %v", err)
-            }
-        }
-
-        for i := range dbThreads {
-            tProto := mapThreadToProto(&dbThreads[i])
-            if threadCounts, ok := countsMap[dbThreads[i].ID]; ok {
-                tProto.LikeCount = int32(threadCounts["like"])
-                tProto.BookmarkCount = int32(threadCounts["bookmark"])
-            }
-            if userThreadInteractions, ok := userInteractionsMap[dbThreads[i].ID]; ok {
-                tProto.IsLikedByCurrentUser = userThreadInteractions["like"]
-                tProto.IsBookmarkedByCurrentUser = userThreadInteractions["bookmark"]
-            }
-            protoThreads = append(protoThreads, tProto)
-        }
-    }
+    protoThreads := mutes.apply(h.hydrateThreads(ctx, dbThreads, req.GetRequesterUserId()))
 
     hasMore := len(dbThreads) == limit
     log.Printf("Returning %d hydrated threads for GetCommunityThreads request.", len(protoThreads))
@@ -538,6 +1360,7 @@ func (h *ThreadHandler) GetCommunityThreads(ctx context.Context, req *threadpb.G
     return &threadpb.GetCommunityThreadsResponse{
         Threads: protoThreads,
         HasMore: hasMore,
+        NextCursor: nextThreadCursor(dbThreads, limit),
     }, nil
 }
 
@@ -549,52 +1372,23 @@ func (h *ThreadHandler) GetBookmarkedThreads(ctx context.Context, req *threadpb.
 		return nil, status.Errorf(codes.InvalidArgument, "User ID for bookmarks is required")
 	}
 	limit, offset := getLimitOffset(req.Page, req.Limit)
+	after, err := parsePageCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
 
-	dbThreads, err := h.repo.GetBookmarkedThreadsByUser(ctx, uint(req.UserId), limit, offset)
+	bookmarked, err := h.repo.GetBookmarkedThreadsByUser(ctx, uint(req.UserId), limit, offset, after)
 	if err != nil {
 		log.Printf("ThreadSvc: Failed to get bookmarked threads from repo for user %d: %v", req.UserId, err)
 		return nil, status.Errorf(codes.Internal, "Could not retrieve bookmarked threads")
 	}
+	dbThreads, nextCursor := unwrapInteractedThreads(bookmarked, limit)
 
-	protoThreads := make([]*threadpb.Thread, 0, len(dbThreads))
-	if len(dbThreads) > 0 {
-		threadIDs := make([]uint, len(dbThreads))
-		for i, t := range dbThreads {
-			threadIDs[i] = t.ID
-		}
-
-		countsMap, errCounts := h.repo.GetInteractionCountsForMultipleThreads(ctx, threadIDs)
-		if errCounts != nil {
-			log.Printf("Error fetching batch interaction counts: %v", errCounts)
-		}
-
-		userInteractionsMap := make(map[uint]map[string]bool)
-		if req.GetRequesterUserId() != 0 {
-			userInteractionsMap, err = h.repo.CheckUserInteractionsForMultipleThreads(ctx, uint(req.GetRequesterUserId()), threadIDs)
-			if err != nil {
-				log.Printf("Error fetching batch user interactions: %v", err)
-			}
-		}
-
-		for i := range dbThreads {
-			tProto := mapThreadToProto(&dbThreads[i])
-
-			if threadCounts, ok := countsMap[dbThreads[i].ID]; ok {
-				tProto.LikeCount = int32(threadCounts["like"])
-				tProto.BookmarkCount = int32(threadCounts["bookmark"])
-			}
-
-			if userThreadInteractions, ok := userInteractionsMap[dbThreads[i].ID]; ok {
-				tProto.IsLikedByCurrentUser = userThreadInteractions["like"]
-				tProto.IsBookmarkedByCurrentUser = userThreadInteractions["bookmark"]
-			}
-			protoThreads = append(protoThreads, tProto)
-		}
-	}
+	protoThreads := h.hydrateThreads(ctx, dbThreads, req.GetRequesterUserId())
 
 	hasMore := len(dbThreads) == limit
 	log.Printf("ThreadSvc: Returning %d hydrated bookmarked threads.", len(protoThreads))
-	return &threadpb.GetBookmarkedThreadsResponse{Threads: protoThreads, HasMore: hasMore}, nil
+	return &threadpb.GetBookmarkedThreadsResponse{Threads: protoThreads, HasMore: hasMore, NextCursor: nextCursor}, nil
 }
 
 func (h *ThreadHandler) GetReplies(ctx context.Context, req *threadpb.GetRepliesRequest) (*threadpb.GetRepliesResponse, error) {
@@ -605,56 +1399,593 @@ func (h *ThreadHandler) GetReplies(ctx context.Context, req *threadpb.GetReplies
 		return nil, status.Errorf(codes.InvalidArgument, "Parent Thread ID is required")
 	}
 	limit, offset := getLimitOffset(req.Page, req.Limit) // Use existing helper
+	after, err := parsePageCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	mutes := h.loadMuteFilter(ctx, req.GetRequesterUserId(), mute.ScopeReplies)
 
 	dbReplies, err := h.repo.GetRepliesForThread(
         ctx,
         uint(req.ParentThreadId),
         limit,
         offset,
-        uint32SliceToUint(req.GetExcludeUserIds()), // Pass exclude IDs
+        after,
+        uint32SliceToUint(mutes.withExcluded(req.GetExcludeUserIds())), // Pass exclude IDs
     )
 	if err != nil {
 		log.Printf("ThreadSvc: Failed to get replies from repo for parent %d: %v", req.ParentThreadId, err)
 		return nil, status.Errorf(codes.Internal, "Could not retrieve replies")
 	}
 
-	protoReplies := make([]*threadpb.Thread, 0, len(dbReplies))
-	if len(dbReplies) > 0 {
-		replyIDs := make([]uint, len(dbReplies))
-		for i, t := range dbReplies {
-			replyIDs[i] = t.ID
+	protoReplies := mutes.apply(h.hydrateThreads(ctx, dbReplies, req.GetRequesterUserId()))
+
+	hasMore := len(dbReplies) == limit
+	log.Printf("ThreadSvc: Returning %d hydrated replies for parent thread %d.", len(protoReplies), req.ParentThreadId)
+	return &threadpb.GetRepliesResponse{Threads: protoReplies, HasMore: hasMore, NextCursor: nextThreadCursor(dbReplies, limit)}, nil
+}
+
+func (h *ThreadHandler) GetQuotes(ctx context.Context, req *threadpb.GetQuotesRequest) (*threadpb.GetQuotesResponse, error) {
+	log.Printf("ThreadSvc: GetQuotes for ThreadID: %d, Requester: %d, Page: %d",
+		req.ThreadId, req.GetRequesterUserId(), req.Page)
+
+	if req.ThreadId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread ID is required")
+	}
+	limit, offset := getLimitOffset(req.Page, req.Limit)
+	after, err := parsePageCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	mutes := h.loadMuteFilter(ctx, req.GetRequesterUserId(), mute.ScopeReplies)
+
+	dbQuotes, err := h.repo.GetQuotesForThread(ctx, uint(req.ThreadId), limit, offset, after, uint32SliceToUint(mutes.withExcluded(req.GetExcludeUserIds())))
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get quotes from repo for thread %d: %v", req.ThreadId, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve quotes")
+	}
+
+	protoQuotes := mutes.apply(h.hydrateThreads(ctx, dbQuotes, req.GetRequesterUserId()))
+
+	hasMore := len(dbQuotes) == limit
+	return &threadpb.GetQuotesResponse{Threads: protoQuotes, HasMore: hasMore, NextCursor: nextThreadCursor(dbQuotes, limit)}, nil
+}
+
+// GetThreadsByHashtag lists the published threads carrying a hashtag.
+// "latest" pages by (posted_at, id). "top" ranks by engagement and pages by position;
+// its cursor carries the time of the first page so threads posted since don't shift later pages.
+func (h *ThreadHandler) GetThreadsByHashtag(ctx context.Context, req *threadpb.GetThreadsByHashtagRequest) (*threadpb.GetThreadsByHashtagResponse, error) {
+	log.Printf("ThreadSvc: GetThreadsByHashtag. Tag: %s, Requester: %d, Sort: %s",
+		req.GetHashtag(), req.GetRequesterUserId(), req.GetSortType())
+
+	tag, ok := utils.NormalizeHashtag(req.GetHashtag())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid hashtag")
+	}
+	limit, offset := getLimitOffset(req.Page, req.Limit)
+	after, err := parsePageCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+	mutes := h.loadMuteFilter(ctx, req.GetRequesterUserId(), mute.ScopeHome)
+	excludeUserIDs := uint32SliceToUint(mutes.withExcluded(req.GetExcludeUserIds()))
+
+	var dbThreads []postgres.Thread
+	nextCursor := ""
+	switch req.GetSortType() {
+	case "top":
+		postedUntil := time.Now().UTC()
+		if after != nil {
+			postedUntil, offset = after.At, int(after.ID)
+		}
+		dbThreads, err = h.repo.GetTopThreadsByHashtag(ctx, tag, postedUntil, limit, offset, excludeUserIDs)
+		if err == nil && len(dbThreads) == limit {
+			nextCursor = utils.EncodeCursor(postedUntil, uint(offset+limit))
+		}
+	case "", "latest":
+		dbThreads, err = h.repo.GetLatestThreadsByHashtag(ctx, tag, limit, offset, after, excludeUserIDs)
+		nextCursor = nextThreadCursor(dbThreads, limit)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Sort type must be 'latest' or 'top'")
+	}
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get threads for #%s: %v", tag, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve hashtag threads")
+	}
+
+	return &threadpb.GetThreadsByHashtagResponse{
+		Threads: mutes.apply(h.hydrateThreads(ctx, dbThreads, req.GetRequesterUserId())),
+		HasMore: len(dbThreads) == limit,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// GetHashtagStats returns how many threads use a hashtag and which tags most often appear alongside it.
+func (h *ThreadHandler) GetHashtagStats(ctx context.Context, req *threadpb.GetHashtagStatsRequest) (*threadpb.GetHashtagStatsResponse, error) {
+	tag, ok := utils.NormalizeHashtag(req.GetHashtag())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid hashtag")
+	}
+	relatedLimit := clampLimit(int(req.GetRelatedLimit()), 10, 30)
+
+	count, err := h.repo.CountThreadsByHashtag(ctx, tag)
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to count threads for #%s: %v", tag, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve hashtag stats")
+	}
+	related, err := h.repo.GetRelatedHashtags(ctx, tag, relatedLimit)
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get related hashtags for #%s: %v", tag, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve hashtag stats")
+	}
+
+	resp := &threadpb.GetHashtagStatsResponse{Hashtag: tag, ThreadCount: count}
+	for _, r := range related {
+		resp.Related = append(resp.Related, &threadpb.RelatedHashtag{Tag: r.Tag, CoOccurrences: r.CoOccurrences})
+	}
+	return resp, nil
+}
+
+// GetMentions lists the published threads that mention a user, newest first.
+func (h *ThreadHandler) GetMentions(ctx context.Context, req *threadpb.GetMentionsRequest) (*threadpb.GetMentionsResponse, error) {
+	log.Printf("ThreadSvc: GetMentions for UserID: %d, Requester: %d", req.UserId, req.GetRequesterUserId())
+
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "User ID is required")
+	}
+	limit, offset := getLimitOffset(req.Page, req.Limit)
+	after, err := parsePageCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	mutes := h.loadMuteFilter(ctx, req.GetRequesterUserId(), mute.ScopeNotifications)
+
+	dbThreads, err := h.repo.GetThreadsMentioningUser(ctx, uint(req.UserId), limit, offset, after, uint32SliceToUint(mutes.withExcluded(req.GetExcludeUserIds())))
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get mentions of user %d: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve mentions")
+	}
+
+	return &threadpb.GetMentionsResponse{
+		Threads: mutes.apply(h.hydrateThreads(ctx, dbThreads, req.GetRequesterUserId())),
+		HasMore: len(dbThreads) == limit,
+		NextCursor: nextThreadCursor(dbThreads, limit),
+	}, nil
+}
+
+// Category time windows, in hours.
+const (
+	defaultCategoryTopWindowHours   = 7 * 24
+	defaultCategoryStatsWindowHours = 24
+	maxCategoryWindowHours          = 30 * 24
+)
+
+// GetThreadsByCategory lists a category's threads, newest first or, for "top", most engaging within a time window.
+func (h *ThreadHandler) GetThreadsByCategory(ctx context.Context, req *threadpb.GetThreadsByCategoryRequest) (*threadpb.GetThreadsByCategoryResponse, error) {
+	log.Printf("ThreadSvc: GetThreadsByCategory. Category: %s, Requester: %d, Sort: %s",
+		req.GetCategory(), req.GetRequesterUserId(), req.GetSortType())
+
+	category, ok := utils.NormalizeCategory(req.GetCategory())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown category")
+	}
+	limit, offset := getLimitOffset(req.Page, req.Limit)
+	after, err := parsePageCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+	mutes := h.loadMuteFilter(ctx, req.GetRequesterUserId(), mute.ScopeHome)
+	excludeUserIDs := uint32SliceToUint(mutes.withExcluded(req.GetExcludeUserIds()))
+
+	var dbThreads []postgres.Thread
+	nextCursor := ""
+	switch req.GetSortType() {
+	case "top":
+		window := time.Duration(clampLimit(int(req.GetWindowHours()), defaultCategoryTopWindowHours, maxCategoryWindowHours)) * time.Hour
+		postedUntil := time.Now().UTC()
+		if after != nil {
+			postedUntil, offset = after.At, int(after.ID)
+		}
+		dbThreads, err = h.repo.GetTopThreadsByCategory(ctx, category, postedUntil.Add(-window), postedUntil, limit, offset, excludeUserIDs)
+		if err == nil && len(dbThreads) == limit {
+			nextCursor = utils.EncodeCursor(postedUntil, uint(offset+limit))
 		}
+	case "", "latest":
+		dbThreads, err = h.repo.GetLatestThreadsByCategory(ctx, category, limit, offset, after, excludeUserIDs)
+		nextCursor = nextThreadCursor(dbThreads, limit)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Sort type must be 'latest' or 'top'")
+	}
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get threads for category %s: %v", category, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve category threads")
+	}
+
+	return &threadpb.GetThreadsByCategoryResponse{
+		Threads: mutes.apply(h.hydrateThreads(ctx, dbThreads, req.GetRequesterUserId())),
+		HasMore: len(dbThreads) == limit,
+		NextCursor: nextCursor,
+	}, nil
+}
 
-		countsMap, errCounts := h.repo.GetInteractionCountsForMultipleThreads(ctx, replyIDs)
-		if errCounts != nil {
-			log.Printf("Error fetching batch interaction counts for replies: %v", errCounts)
+// GetCategoryStats counts each category's posts in the latest window and in the window before it.
+func (h *ThreadHandler) GetCategoryStats(ctx context.Context, req *threadpb.GetCategoryStatsRequest) (*threadpb.GetCategoryStatsResponse, error) {
+	windowHours := clampLimit(int(req.GetWindowHours()), defaultCategoryStatsWindowHours, maxCategoryWindowHours)
+	window := time.Duration(windowHours) * time.Hour
+	now := time.Now().UTC()
+
+	current, err := h.repo.CountThreadsPerCategory(ctx, now.Add(-window), now)
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to count category posts: %v", err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve category stats")
+	}
+	previous, err := h.repo.CountThreadsPerCategory(ctx, now.Add(-2*window), now.Add(-window))
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to count previous category posts: %v", err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve category stats")
+	}
+
+	statsByCategory := make(map[string]*threadpb.CategoryStat, len(utils.Categories))
+	resp := &threadpb.GetCategoryStatsResponse{WindowHours: int32(windowHours)}
+	for _, category := range utils.Categories {
+		stat := &threadpb.CategoryStat{Category: category}
+		statsByCategory[category] = stat
+		resp.Stats = append(resp.Stats, stat)
+	}
+	// Threads stored before categories were normalized may carry other values; those are ignored
+	for _, c := range current {
+		if stat, ok := statsByCategory[c.Category]; ok { stat.PostCount = c.Count }
+	}
+	for _, c := range previous {
+		if stat, ok := statsByCategory[c.Category]; ok { stat.PreviousPostCount = c.Count }
+	}
+	sort.SliceStable(resp.Stats, func(i, j int) bool { return resp.Stats[i].PostCount > resp.Stats[j].PostCount })
+	return resp, nil
+}
+
+// Conversation size limits; requests may ask for less but never more.
+const (
+	defaultConversationDepth = 3
+	maxConversationDepth     = 10
+	defaultConversationFanOut = 5
+	maxConversationFanOut     = 20
+	maxConversationAncestors  = 100
+)
+
+// GetConversation returns a thread with its ancestor chain and a bounded tree of replies below it,
+// so a client can render a whole conversation in one round trip.
+func (h *ThreadHandler) GetConversation(ctx context.Context, req *threadpb.GetConversationRequest) (*threadpb.GetConversationResponse, error) {
+	log.Printf("ThreadSvc: GetConversation for ThreadID: %d, Requester: %d, Depth: %d, FanOut: %d",
+		req.ThreadId, req.GetRequesterUserId(), req.MaxDepth, req.FanOut)
+
+	if req.ThreadId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread ID is required")
+	}
+	depth := clampLimit(int(req.MaxDepth), defaultConversationDepth, maxConversationDepth)
+	fanOut := clampLimit(int(req.FanOut), defaultConversationFanOut, maxConversationFanOut)
+	excludeUserIDs := uint32SliceToUint(req.GetExcludeUserIds())
+
+	focus, err := h.repo.GetThreadByID(ctx, uint(req.ThreadId))
+	if err != nil {
+		if err.Error() == "thread not found" { return nil, status.Errorf(codes.NotFound, "Thread not found") }
+		log.Printf("GetConversation: Failed to load thread %d: %v", req.ThreadId, err)
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve conversation")
+	}
+	if focus.Status != postgres.ThreadStatusPublished {
+		return nil, status.Errorf(codes.NotFound, "Thread not found")
+	}
+	for _, id := range excludeUserIDs {
+		if id == focus.UserID {
+			return nil, status.Errorf(codes.NotFound, "Thread not found")
 		}
+	}
 
-		userInteractionsMap := make(map[uint]map[string]bool)
-		if req.GetRequesterUserId() != 0 {
-			userInteractionsMap, err = h.repo.CheckUserInteractionsForMultipleThreads(ctx, uint(req.GetRequesterUserId()), replyIDs)
-			if err != nil {
-				log.Printf("Error fetching batch user interactions for replies: %v", err)
-			}
+	ancestors, err := h.repo.GetAncestors(ctx, focus.ID, maxConversationAncestors, excludeUserIDs)
+	if err != nil {
+		log.Printf("GetConversation: Failed to get ancestors of thread %d: %v", focus.ID, err)
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve conversation")
+	}
+	replies, err := h.repo.GetReplyTree(ctx, focus.ID, depth, fanOut, excludeUserIDs)
+	if err != nil {
+		log.Printf("GetConversation: Failed to get reply tree of thread %d: %v", focus.ID, err)
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve conversation")
+	}
+
+	// Hydrate everything in one batch; the rows past the fan-out only mark that a parent has more replies
+	dbThreads := make([]postgres.Thread, 0, len(ancestors)+1+len(replies))
+	dbThreads = append(dbThreads, ancestors...)
+	dbThreads = append(dbThreads, *focus)
+	for _, reply := range replies {
+		if reply.SiblingRank <= fanOut {
+			dbThreads = append(dbThreads, reply.Thread)
 		}
+	}
+	protoThreads := h.hydrateThreads(ctx, dbThreads, req.GetRequesterUserId())
+
+	// Muted replies are pruned, but the focus and its ancestors are shown so the thread still reads
+	mutes := h.loadMuteFilter(ctx, req.GetRequesterUserId(), mute.ScopeReplies)
+	resp := &threadpb.GetConversationResponse{Ancestors: protoThreads[:len(ancestors)]}
+	resp.Focus = &threadpb.ConversationNode{Thread: protoThreads[len(ancestors)]}
+	if req.GetRequesterUserId() != 0 {
+		canReply, err := h.canReply(ctx, focus, req.GetRequesterUserId())
+		if err != nil {
+			log.Printf("GetConversation: Could not evaluate reply restriction on thread %d for user %d: %v", focus.ID, req.GetRequesterUserId(), err)
+		}
+		resp.Focus.Thread.CanReply = canReply
+	}
 
-		for i := range dbReplies {
-			tProto := mapThreadToProto(&dbReplies[i])
-			if threadCounts, ok := countsMap[dbReplies[i].ID]; ok {
-				tProto.LikeCount = int32(threadCounts["like"])
-				tProto.BookmarkCount = int32(threadCounts["bookmark"])
-			}
-			if userThreadInteractions, ok := userInteractionsMap[dbReplies[i].ID]; ok {
-				tProto.IsLikedByCurrentUser = userThreadInteractions["like"]
-				tProto.IsBookmarkedByCurrentUser = userThreadInteractions["bookmark"]
+	nodes := map[uint]*threadpb.ConversationNode{focus.ID: resp.Focus}
+	lastChild := make(map[uint]*postgres.Thread)
+	next := len(ancestors) + 1
+	for i := range replies {
+		reply := &replies[i]
+		if reply.ParentThreadID == nil {
+			continue
+		}
+		parentID := *reply.ParentThreadID
+		parent, ok := nodes[parentID]
+		if !ok {
+			continue
+		}
+		if reply.SiblingRank > fanOut {
+			if last := lastChild[parentID]; last != nil {
+				parent.MoreRepliesCursor = utils.EncodeCursor(last.PostedAt, last.ID)
 			}
-			protoReplies = append(protoReplies, tProto)
+			continue
+		}
+		node := &threadpb.ConversationNode{Thread: protoThreads[next]}
+		next++
+		lastChild[parentID] = &reply.Thread
+		if mutes.hides(node.Thread) {
+			continue // its own replies are dropped with it
 		}
+		parent.Replies = append(parent.Replies, node)
+		nodes[reply.ID] = node
 	}
 
-	hasMore := len(dbReplies) == limit
-	log.Printf("ThreadSvc: Returning %d hydrated replies for parent thread %d.", len(protoReplies), req.ParentThreadId)
-	return &threadpb.GetRepliesResponse{Threads: protoReplies, HasMore: hasMore}, nil
+	return resp, nil
+}
+
+// clampLimit applies a default to a non-positive requested size and caps it at max.
+func clampLimit(requested, def, max int) int {
+	if requested <= 0 { return def }
+	if requested > max { return max }
+	return requested
+}
+
+// --- Polls ---
+
+func (h *ThreadHandler) VotePoll(ctx context.Context, req *threadpb.VotePollRequest) (*threadpb.Poll, error) {
+	log.Printf("ThreadSvc: VotePoll on Thread %d by User %d, Option %d", req.ThreadId, req.UserId, req.OptionId)
+	if req.ThreadId == 0 || req.UserId == 0 || req.OptionId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread ID, User ID and Option ID are required")
+	}
+
+	poll, err := h.getVisiblePoll(ctx, req.ThreadId)
+	if err != nil {
+		return nil, err
+	}
+	if !time.Now().Before(poll.ClosesAt) {
+		return nil, status.Errorf(codes.FailedPrecondition, "Poll is closed")
+	}
+
+	err = h.repo.AddPollVote(ctx, poll.ID, uint(req.OptionId), uint(req.UserId))
+	if err != nil {
+		if err.Error() == "already voted" { return nil, status.Errorf(codes.AlreadyExists, "You have already voted in this poll") }
+		if err.Error() == "poll option not found" { return nil, status.Errorf(codes.InvalidArgument, "Option does not belong to this poll") }
+		log.Printf("ThreadSvc: Failed to record vote on poll %d: %v", poll.ID, err)
+		return nil, status.Errorf(codes.Internal, "Failed to record vote")
+	}
+
+	poll, err = h.repo.GetPollByThreadID(ctx, uint(req.ThreadId))
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to reload poll for thread %d after vote: %v", req.ThreadId, err)
+		return nil, status.Errorf(codes.Internal, "Failed to load poll results")
+	}
+	votedOptionID := uint(req.OptionId)
+	return mapPollToProto(poll, &votedOptionID, time.Now()), nil
+}
+
+func (h *ThreadHandler) GetPollResults(ctx context.Context, req *threadpb.GetPollResultsRequest) (*threadpb.Poll, error) {
+	if req.ThreadId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread ID is required")
+	}
+	poll, err := h.getVisiblePoll(ctx, req.ThreadId)
+	if err != nil {
+		return nil, err
+	}
+
+	var votedOptionID *uint
+	if req.GetRequesterUserId() != 0 {
+		votes, err := h.repo.GetUserPollVotes(ctx, uint(req.GetRequesterUserId()), []uint{poll.ID})
+		if err != nil {
+			log.Printf("ThreadSvc: Failed to get vote of user %d on poll %d: %v", req.GetRequesterUserId(), poll.ID, err)
+			return nil, status.Errorf(codes.Internal, "Failed to load poll results")
+		}
+		if optionID, ok := votes[poll.ID]; ok {
+			votedOptionID = &optionID
+		}
+	}
+	return mapPollToProto(poll, votedOptionID, time.Now()), nil
+}
+
+// getVisiblePoll loads the poll of a published thread.
+func (h *ThreadHandler) getVisiblePoll(ctx context.Context, threadID uint32) (*postgres.Poll, error) {
+	thread, err := h.repo.GetThreadByID(ctx, uint(threadID))
+	if err != nil {
+		if err.Error() == "thread not found" { return nil, status.Errorf(codes.NotFound, "Thread not found") }
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve thread")
+	}
+	if thread.Status != postgres.ThreadStatusPublished {
+		return nil, status.Errorf(codes.NotFound, "Thread not found")
+	}
+	poll, err := h.repo.GetPollByThreadID(ctx, thread.ID)
+	if err != nil {
+		if err.Error() == "poll not found" { return nil, status.Errorf(codes.NotFound, "Thread has no poll") }
+		log.Printf("ThreadSvc: Failed to get poll for thread %d: %v", threadID, err)
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve poll")
+	}
+	return poll, nil
+}
+
+// PublishPollClosed tells the thread's author their poll has ended. Run by the poll closer.
+func (h *ThreadHandler) PublishPollClosed(ctx context.Context, poll *postgres.Poll) error {
+	thread, err := h.repo.GetThreadByID(ctx, poll.ThreadID)
+	if err != nil {
+		if err.Error() == "thread not found" { return nil } // Thread deleted; nobody to tell
+		return err
+	}
+
+	eventPayload := ThreadPollClosedEventPayload{
+		ThreadID:       uint32(thread.ID),
+		ThreadAuthorID: uint32(thread.UserID),
+		PollID:         uint32(poll.ID),
+		TotalVotes:     poll.TotalVotes(),
+		WinningOption:  pollWinner(poll),
+	}
+	return utils.PublishEvent(context.Background(), "thread_events", "thread.poll_closed", eventPayload)
+}
+
+// pollWinner returns the text of the option with the most votes, or "" if nobody voted or first place is tied.
+func pollWinner(poll *postgres.Poll) string {
+	var winner string
+	var best int64
+	tied := false
+	for _, o := range poll.Options {
+		switch {
+		case o.VoteCount > best:
+			winner, best, tied = o.Text, o.VoteCount, false
+		case o.VoteCount == best && best > 0:
+			tied = true
+		}
+	}
+	if tied {
+		return ""
+	}
+	return winner
+}
+
+// mapPollToProto hides per-option counts until the viewer has voted or the poll has closed.
+func mapPollToProto(poll *postgres.Poll, votedOptionID *uint, now time.Time) *threadpb.Poll {
+	closed := !now.Before(poll.ClosesAt)
+	pProto := &threadpb.Poll{
+		Id:             uint32(poll.ID),
+		ClosesAt:       timestamppb.New(poll.ClosesAt),
+		IsClosed:       closed,
+		ResultsVisible: closed || votedOptionID != nil,
+		TotalVotes:     int32(poll.TotalVotes()),
+	}
+	if votedOptionID != nil {
+		optionID := uint32(*votedOptionID)
+		pProto.VotedOptionId = &optionID
+	}
+	for _, o := range poll.Options {
+		oProto := &threadpb.PollOption{Id: uint32(o.ID), Text: o.Text}
+		if pProto.ResultsVisible {
+			oProto.VoteCount = int32(o.VoteCount)
+		}
+		pProto.Options = append(pProto.Options, oProto)
+	}
+	return pProto
+}
+
+// --- Scheduled Thread Handlers ---
+
+func (h *ThreadHandler) GetScheduledThreads(ctx context.Context, req *threadpb.GetScheduledThreadsRequest) (*threadpb.GetScheduledThreadsResponse, error) {
+	log.Printf("ThreadSvc: GetScheduledThreads for UserID: %d, Page: %d", req.UserId, req.Page)
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "User ID is required")
+	}
+	limit, offset := getLimitOffset(req.Page, req.Limit)
+	after, err := parsePageCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	dbThreads, err := h.repo.GetScheduledThreadsByUser(ctx, uint(req.UserId), limit, offset, after)
+	if err != nil {
+		log.Printf("ThreadSvc: Failed to get scheduled threads for user %d: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve scheduled threads")
+	}
+
+	// Scheduled threads can't have interactions yet, so no hydration is needed
+	protoThreads := make([]*threadpb.Thread, 0, len(dbThreads))
+	for i := range dbThreads {
+		protoThreads = append(protoThreads, mapThreadToProto(&dbThreads[i]))
+	}
+
+	hasMore := len(dbThreads) == limit
+	nextCursor := ""
+	if hasMore && dbThreads[len(dbThreads)-1].ScheduledAt != nil {
+		last := dbThreads[len(dbThreads)-1]
+		nextCursor = utils.EncodeCursor(*last.ScheduledAt, last.ID)
+	}
+	return &threadpb.GetScheduledThreadsResponse{Threads: protoThreads, HasMore: hasMore, NextCursor: nextCursor}, nil
+}
+
+func (h *ThreadHandler) RescheduleThread(ctx context.Context, req *threadpb.RescheduleThreadRequest) (*threadpb.Thread, error) {
+	log.Printf("ThreadSvc: RescheduleThread %d by User %d", req.ThreadId, req.UserId)
+	if req.ThreadId == 0 || req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread ID and User ID are required")
+	}
+	if req.ScheduledAt == nil || !req.ScheduledAt.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "A valid scheduled time is required")
+	}
+	scheduledAt := req.ScheduledAt.AsTime()
+	if !scheduledAt.After(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "Scheduled time must be in the future")
+	}
+
+	thread, err := h.getOwnScheduledThread(ctx, req.ThreadId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	thread, err = h.repo.RescheduleThread(ctx, thread.ID, scheduledAt.UTC())
+	if err != nil {
+		// The scheduler may have published it in the meantime
+		if err.Error() == "thread not found" { return nil, status.Errorf(codes.FailedPrecondition, "Thread is no longer scheduled") }
+		log.Printf("ThreadSvc: Failed to reschedule thread %d: %v", req.ThreadId, err)
+		return nil, status.Errorf(codes.Internal, "Failed to reschedule thread")
+	}
+	return mapThreadToProto(thread), nil
+}
+
+func (h *ThreadHandler) CancelScheduledThread(ctx context.Context, req *threadpb.CancelScheduledThreadRequest) (*emptypb.Empty, error) {
+	log.Printf("ThreadSvc: CancelScheduledThread %d by User %d", req.ThreadId, req.UserId)
+	if req.ThreadId == 0 || req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Thread ID and User ID are required")
+	}
+
+	if _, err := h.getOwnScheduledThread(ctx, req.ThreadId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	// Never published, so nothing outside this service refers to it yet
+	if _, err := h.repo.PerformSoftDelete(ctx, uint(req.ThreadId)); err != nil {
+		if err.Error() == "thread not found" { return nil, status.Errorf(codes.NotFound, "Thread not found") }
+		log.Printf("ThreadSvc: Failed to cancel scheduled thread %d: %v", req.ThreadId, err)
+		return nil, status.Errorf(codes.Internal, "Failed to cancel scheduled thread")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ThreadHandler) getOwnScheduledThread(ctx context.Context, threadID, userID uint32) (*postgres.Thread, error) {
+	thread, err := h.repo.GetThreadByID(ctx, uint(threadID))
+	if err != nil {
+		if err.Error() == "thread not found" { return nil, status.Errorf(codes.NotFound, "Thread not found") }
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve thread")
+	}
+	if thread.UserID != uint(userID) {
+		return nil, status.Errorf(codes.PermissionDenied, "You do not have permission to modify this thread")
+	}
+	if thread.Status != postgres.ThreadStatusScheduled {
+		return nil, status.Errorf(codes.FailedPrecondition, "Thread is no longer scheduled")
+	}
+	return thread, nil
 }
 
 // --- Helper Functions ---
@@ -677,6 +2008,10 @@ func mapThreadToProto(t *postgres.Thread) *threadpb.Thread {
         parentID := uint32(*t.ParentThreadID)
 		protoThread.ParentThreadId = &parentID
     }
+    if t.QuotedThreadID != nil {
+        quotedID := uint32(*t.QuotedThreadID)
+        protoThread.QuotedThreadId = &quotedID
+    }
     if t.CommunityID != nil {
         communityID := uint32(*t.CommunityID)
         protoThread.CommunityId = &communityID
@@ -684,11 +2019,25 @@ func mapThreadToProto(t *postgres.Thread) *threadpb.Thread {
      if t.ScheduledAt != nil {
          protoThread.ScheduledAt = timestamppb.New(*t.ScheduledAt)
      }
+    if t.EditedAt != nil {
+        protoThread.EditedAt = timestamppb.New(*t.EditedAt)
+    }
+    protoThread.IsHidden = t.HiddenAt != nil
+    protoThread.ContentWarning = t.ContentWarning
+    protoThread.IsSensitive = t.IsSensitive
     return protoThread
 }
 
+func mapReplyRestrictionToString(r threadpb.ReplyRestriction) string {
+    switch r {
+    case threadpb.ReplyRestriction_FOLLOWING: return "following"
+    case threadpb.ReplyRestriction_VERIFIED: return "verified"
+    default:                                 return "everyone"
+    }
+}
+
 func mapStringToReplyRestriction(s string) threadpb.ReplyRestriction {
-    switch s {
+    switch strings.ToLower(s) {
     case "following": return threadpb.ReplyRestriction_FOLLOWING
     case "verified": return threadpb.ReplyRestriction_VERIFIED
     case "everyone": fallthrough
@@ -714,10 +2063,70 @@ func uint32SliceToInt64Array(slice []uint32) pq.Int64Array {
     return res
 }
 
+func int64ArraysEqual(a, b pq.Int64Array) bool {
+	if len(a) != len(b) { return false }
+	for i := range a {
+		if a[i] != b[i] { return false }
+	}
+	return true
+}
+
+// diffMentionIDs returns the users mentioned only in the new version and those no longer mentioned.
+func diffMentionIDs(oldIDs []uint, newIDs []uint32) (added []uint32, removed []uint) {
+	oldSet := make(map[uint]bool, len(oldIDs))
+	for _, id := range oldIDs { oldSet[id] = true }
+	newSet := make(map[uint]bool, len(newIDs))
+	for _, id := range newIDs {
+		if !newSet[uint(id)] && !oldSet[uint(id)] { added = append(added, id) }
+		newSet[uint(id)] = true
+	}
+	for _, id := range oldIDs {
+		if !newSet[id] { removed = append(removed, id) }
+	}
+	return added, removed
+}
+
 func pointToUint(val uint) *uint {
 	return &val
 }
 
+// parsePageCursor decodes a request cursor. An empty cursor means the list is paged by page/limit instead.
+func parsePageCursor(cursor string) (*postgres.PageCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	at, id, err := utils.DecodeCursor(cursor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid cursor")
+	}
+	return &postgres.PageCursor{At: at, ID: id}, nil
+}
+
+// nextThreadCursor points after the last thread of a full page of threads ordered by (posted_at, id).
+// A short page means the list is exhausted, so no cursor is returned.
+func nextThreadCursor(threads []postgres.Thread, limit int) string {
+	if len(threads) == 0 || len(threads) < limit {
+		return ""
+	}
+	last := threads[len(threads)-1]
+	return utils.EncodeCursor(last.PostedAt, last.ID)
+}
+
+// unwrapInteractedThreads strips the interaction position off a page of liked or bookmarked threads,
+// turning the last one into the next cursor.
+func unwrapInteractedThreads(items []postgres.InteractedThread, limit int) ([]postgres.Thread, string) {
+	threads := make([]postgres.Thread, len(items))
+	for i := range items {
+		threads[i] = items[i].Thread
+	}
+	nextCursor := ""
+	if len(items) > 0 && len(items) == limit {
+		last := items[len(items)-1]
+		nextCursor = utils.EncodeCursor(last.InteractedAt, last.InteractionID)
+	}
+	return threads, nextCursor
+}
+
 func getLimitOffset(page, limit int32) (int, int) {
 	p := int(page); l := int(limit)
 	if l <= 0 || l > 50 { l = 20 }
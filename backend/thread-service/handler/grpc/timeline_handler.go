@@ -0,0 +1,325 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	threadpb "github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/timeline"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/utils"
+	userpb "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genproto/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	fanOutTimeout      = 2 * time.Minute // one fan-out pages through every follower of the author
+	fanOutBatchSize    = 500             // followers collected before pushing to Redis
+	timelineFillRounds = 3               // timeline pages read to fill one feed page when entries are filtered out
+)
+
+// getTimelineFeed serves the following feed from the viewer's Redis timeline, merging in threads
+// from the large accounts they follow. Without Redis, or when it fails, the feed is built in Postgres instead.
+func (h *ThreadHandler) getTimelineFeed(ctx context.Context, req *threadpb.GetFeedThreadsRequest, limit, offset int, after *postgres.PageCursor) (*threadpb.GetFeedThreadsResponse, error) {
+	viewerID := uint(req.GetCurrentUserId())
+	if viewerID == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Current user ID required for the following feed")
+	}
+	// Timelines are only paged by cursor
+	if h.timelines == nil || (after == nil && offset > 0) {
+		return h.getFollowingFeedFromPostgres(ctx, req, limit, offset, after)
+	}
+
+	resp, err := h.readTimeline(ctx, req, viewerID, limit, after)
+	if err != nil {
+		log.Printf("Timeline: Falling back to Postgres for user %d: %v", viewerID, err)
+		return h.getFollowingFeedFromPostgres(ctx, req, limit, offset, after)
+	}
+	return resp, nil
+}
+
+func (h *ThreadHandler) getFollowingFeedFromPostgres(ctx context.Context, req *threadpb.GetFeedThreadsRequest, limit, offset int, after *postgres.PageCursor) (*threadpb.GetFeedThreadsResponse, error) {
+	following, err := h.collectFollowingIDs(ctx, uint(req.GetCurrentUserId()))
+	if err != nil {
+		log.Printf("Failed to get following IDs for user %d: %v", req.GetCurrentUserId(), err)
+		return nil, status.Errorf(codes.Internal, "Could not retrieve feed")
+	}
+	return h.getFollowingFeed(ctx, req, following, limit, offset, after)
+}
+
+// collectFollowingIDs pages through everyone userID follows. Unlike collectSocialIDs it has no cap,
+// since a feed built from part of the list would silently leave accounts out.
+func (h *ThreadHandler) collectFollowingIDs(ctx context.Context, userID uint) ([]uint, error) {
+	var ids []uint
+	for page := int32(1); ; page++ {
+		resp, err := h.userClient.GetFollowingIDs(ctx, &userpb.SocialListRequest{UserId: uint32(userID), Page: page, Limit: socialIDsPageSize})
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, uint32SliceToUint(resp.GetUserIds())...)
+		if !resp.GetHasMore() || len(resp.GetUserIds()) == 0 {
+			return ids, nil
+		}
+	}
+}
+
+func (h *ThreadHandler) readTimeline(ctx context.Context, req *threadpb.GetFeedThreadsRequest, viewerID uint, limit int, after *postgres.PageCursor) (*threadpb.GetFeedThreadsResponse, error) {
+	built, err := h.timelines.IsBuilt(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	if !built {
+		if err := h.rebuildTimeline(ctx, viewerID); err != nil {
+			return nil, err
+		}
+	}
+
+	excluded := make(map[uint]bool, len(req.GetExcludeUserIds()))
+	for _, id := range req.GetExcludeUserIds() {
+		excluded[uint(id)] = true
+	}
+
+	// Large accounts are never pushed, so their threads are read from Postgres and merged in
+	pullAuthors, err := h.timelines.PullAuthors(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	next := func(at time.Time, id uint, n int) ([]timeline.Entry, bool, error) {
+		pushed, err := h.timelines.Page(ctx, viewerID, at, id, n)
+		if err != nil {
+			return nil, false, err
+		}
+		var cursor *postgres.PageCursor
+		if !at.IsZero() {
+			cursor = &postgres.PageCursor{At: at, ID: id}
+		}
+		pulledItems, err := h.repo.GetFollowingFeed(ctx, pullAuthors, uint32SliceToUint(req.GetExcludeUserIds()), n, 0, cursor)
+		if err != nil {
+			return nil, false, err
+		}
+		candidates := timeline.Merge(len(pushed)+len(pulledItems), pushed, feedThreadsToEntries(pulledItems))
+		exhausted := len(pushed) < n && len(pulledItems) < n && len(candidates) <= n
+		if len(candidates) > n {
+			candidates = candidates[:n]
+		}
+		return candidates, exhausted, nil
+	}
+
+	var dbThreads []postgres.Thread
+	seen := make(map[uint]bool)
+	// Deleted threads and blocked authors or reposters are dropped here rather than when pushed
+	keep := func(candidates []timeline.Entry) ([]timeline.Entry, error) {
+		ids := make([]uint, len(candidates))
+		for i, e := range candidates {
+			ids[i] = e.ThreadID
+		}
+		threadsMap, err := h.repo.GetThreadsByIDs(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		var kept []timeline.Entry
+		for _, e := range candidates {
+			t, ok := threadsMap[e.ThreadID]
+			if !ok || seen[e.ThreadID] || excluded[t.UserID] || excluded[e.SourceUserID] {
+				continue
+			}
+			seen[e.ThreadID] = true
+			kept = append(kept, e)
+			dbThreads = append(dbThreads, t)
+		}
+		return kept, nil
+	}
+
+	var cursorAt time.Time
+	var cursorID uint
+	if after != nil {
+		cursorAt, cursorID = after.At, after.ID
+	}
+	filled, err := timeline.Fill(limit, timelineFillRounds, cursorAt, cursorID, next, keep)
+	if err != nil {
+		return nil, err
+	}
+	page := filled.Entries
+
+	protoThreads := h.hydrateThreads(ctx, dbThreads, req.GetCurrentUserId())
+	for i, tProto := range protoThreads {
+		if page[i].Repost {
+			reposterID := uint32(page[i].SourceUserID)
+			tProto.RepostedByUserId = &reposterID
+			tProto.RepostedAt = timestamppb.New(page[i].At)
+		}
+	}
+
+	// A page shortened by filtering still continues after the last entry read, not the last one shown
+	nextCursor := ""
+	if filled.HasMore {
+		nextCursor = utils.EncodeCursor(filled.CursorAt, filled.CursorID)
+	}
+	return &threadpb.GetFeedThreadsResponse{
+		Threads:    protoThreads,
+		HasMore:    filled.HasMore,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// rebuildTimeline fills an expired or never built timeline from Postgres.
+func (h *ThreadHandler) rebuildTimeline(ctx context.Context, userID uint) error {
+	following, err := h.collectFollowingIDs(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get following IDs: %w", err)
+	}
+	large, err := h.timelines.LargeAuthorsAmong(ctx, following)
+	if err != nil {
+		return err
+	}
+	isLarge := make(map[uint]bool, len(large))
+	for _, id := range large {
+		isLarge[id] = true
+	}
+	regular := make([]uint, 0, len(following))
+	for _, id := range following {
+		if !isLarge[id] {
+			regular = append(regular, id)
+		}
+	}
+
+	items, err := h.repo.GetFollowingFeed(ctx, regular, nil, h.timelines.Config().MaxEntries, 0, nil)
+	if err != nil {
+		return err
+	}
+	log.Printf("Timeline: Rebuilt timeline of user %d with %d entries and %d large accounts", userID, len(items), len(large))
+	return h.timelines.Rebuild(ctx, userID, feedThreadsToEntries(items), large)
+}
+
+func feedThreadsToEntries(items []postgres.FeedThread) []timeline.Entry {
+	entries := make([]timeline.Entry, len(items))
+	for i, item := range items {
+		entries[i] = timeline.Entry{ThreadID: item.ID, SourceUserID: item.UserID, At: item.ActivityAt}
+		if item.RepostedByUserID != nil {
+			entries[i].SourceUserID = *item.RepostedByUserID
+			entries[i].Repost = true
+		}
+	}
+	return entries
+}
+
+// fanOut pushes e into the timelines of its source user's followers in the background,
+// or removes it again when remove is set (e.g. after an unrepost).
+func (h *ThreadHandler) fanOut(e timeline.Entry, remove bool) {
+	if h.timelines == nil {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), fanOutTimeout)
+		defer cancel()
+
+		large, err := h.isLargeAuthor(ctx, e.SourceUserID)
+		if err != nil {
+			log.Printf("Timeline: Failed to check follower count of user %d: %v", e.SourceUserID, err)
+			return
+		}
+		if large {
+			return // merged in by followers on read
+		}
+		apply := h.timelines.Push
+		if remove {
+			apply = h.timelines.Remove
+		}
+		err = h.forEachFollowerBatch(ctx, e.SourceUserID, func(followerIDs []uint) error {
+			return apply(ctx, followerIDs, e)
+		})
+		if err != nil {
+			log.Printf("Timeline: Failed to fan out thread %d from user %d: %v", e.ThreadID, e.SourceUserID, err)
+		}
+	}()
+}
+
+// isLargeAuthor reports whether authorID has outgrown fan-out on write. The first time an author crosses
+// the threshold, they are added to their followers' pull sets so existing timelines keep seeing them.
+func (h *ThreadHandler) isLargeAuthor(ctx context.Context, authorID uint) (bool, error) {
+	known, err := h.timelines.LargeAuthorsAmong(ctx, []uint{authorID})
+	if err != nil {
+		return false, err
+	}
+	if len(known) > 0 {
+		return true, nil
+	}
+
+	profile, err := h.userClient.GetUserProfile(ctx, &userpb.GetUserProfileRequest{UserIdToView: uint32(authorID)})
+	if err != nil {
+		return false, err
+	}
+	if int(profile.GetFollowerCount()) <= h.timelines.Config().LargeAccountFollowers {
+		return false, nil
+	}
+	added, err := h.timelines.MarkLargeAuthor(ctx, authorID)
+	if err != nil || !added {
+		return true, err
+	}
+	log.Printf("Timeline: User %d now has %d followers, switching to fan-out on read", authorID, profile.GetFollowerCount())
+	return true, h.forEachFollowerBatch(ctx, authorID, func(followerIDs []uint) error {
+		return h.timelines.AddPullAuthor(ctx, followerIDs, authorID)
+	})
+}
+
+// forEachFollowerBatch pages through all followers of userID, calling fn with up to fanOutBatchSize IDs at a time.
+func (h *ThreadHandler) forEachFollowerBatch(ctx context.Context, userID uint, fn func(followerIDs []uint) error) error {
+	batch := make([]uint, 0, fanOutBatchSize)
+	for page := int32(1); ; page++ {
+		resp, err := h.userClient.GetFollowerIDs(ctx, &userpb.SocialListRequest{UserId: uint32(userID), Page: page, Limit: socialIDsPageSize})
+		if err != nil {
+			return err
+		}
+		batch = append(batch, uint32SliceToUint(resp.GetUserIds())...)
+		if len(batch) >= fanOutBatchSize || !resp.GetHasMore() {
+			if len(batch) > 0 {
+				if err := fn(batch); err != nil {
+					return err
+				}
+			}
+			batch = batch[:0]
+		}
+		if !resp.GetHasMore() {
+			return nil
+		}
+	}
+}
+
+// ApplyFollow updates the follower's timeline after they follow someone: large accounts join the pull set,
+// anyone else has their recent threads copied in.
+func (h *ThreadHandler) ApplyFollow(ctx context.Context, followerID, followedID uint) error {
+	if h.timelines == nil {
+		return nil
+	}
+	large, err := h.timelines.LargeAuthorsAmong(ctx, []uint{followedID})
+	if err != nil {
+		return err
+	}
+	if len(large) > 0 {
+		return h.timelines.AddPullAuthor(ctx, []uint{followerID}, followedID)
+	}
+	built, err := h.timelines.IsBuilt(ctx, followerID)
+	if err != nil || !built {
+		return err
+	}
+	items, err := h.repo.GetFollowingFeed(ctx, []uint{followedID}, nil, h.timelines.Config().BackfillPerAuthor, 0, nil)
+	if err != nil {
+		return err
+	}
+	return h.timelines.Add(ctx, followerID, feedThreadsToEntries(items))
+}
+
+// ApplyUnfollow takes everything the unfollowed user put into the follower's timeline back out.
+func (h *ThreadHandler) ApplyUnfollow(ctx context.Context, followerID, followedID uint) error {
+	if h.timelines == nil {
+		return nil
+	}
+	if err := h.timelines.RemovePullAuthor(ctx, followerID, followedID); err != nil {
+		return err
+	}
+	return h.timelines.RemoveSource(ctx, followerID, followedID)
+}
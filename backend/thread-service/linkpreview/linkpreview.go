@@ -0,0 +1,171 @@
+// Package linkpreview builds preview cards for links in threads from their Open Graph and Twitter card metadata.
+// Fetching goes through the Fetcher interface; HTTPFetcher only talks to public addresses.
+package linkpreview
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+const (
+	maxTitleLength       = 200
+	maxDescriptionLength = 300
+	maxSiteNameLength    = 100
+)
+
+var ErrNoMetadata = errors.New("page has no preview metadata")
+
+// Preview is what a link preview card shows.
+type Preview struct {
+	Title       string
+	Description string
+	ImageURL    string
+	SiteName    string
+}
+
+// Fetcher loads the preview for a normalized URL.
+type Fetcher interface {
+	Fetch(ctx context.Context, pageURL string) (*Preview, error)
+}
+
+type Config struct {
+	Timeout      time.Duration // for the whole fetch, redirects included
+	MaxBodyBytes int64         // HTML read past this is ignored
+	MaxRedirects int
+	CacheTTL     time.Duration // how long a fetched preview is reused
+	FailureTTL   time.Duration // how long to wait before retrying a URL that failed
+	Workers      int
+	QueueSize    int
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Timeout:      5 * time.Second,
+		MaxBodyBytes: 512 * 1024,
+		MaxRedirects: 3,
+		CacheTTL:     7 * 24 * time.Hour,
+		FailureTTL:   time.Hour,
+		Workers:      2,
+		QueueSize:    256,
+	}
+}
+
+// ConfigFromEnv starts from DefaultConfig and applies LINK_PREVIEW_* overrides.
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig()
+	if v, err := strconv.Atoi(os.Getenv("LINK_PREVIEW_TIMEOUT_SECONDS")); err == nil && v > 0 {
+		cfg.Timeout = time.Duration(v) * time.Second
+	}
+	if v, err := strconv.Atoi(os.Getenv("LINK_PREVIEW_MAX_BODY_KB")); err == nil && v > 0 {
+		cfg.MaxBodyBytes = int64(v) * 1024
+	}
+	if v, err := strconv.Atoi(os.Getenv("LINK_PREVIEW_CACHE_TTL_HOURS")); err == nil && v > 0 {
+		cfg.CacheTTL = time.Duration(v) * time.Hour
+	}
+	if v, err := strconv.Atoi(os.Getenv("LINK_PREVIEW_WORKERS")); err == nil && v > 0 {
+		cfg.Workers = v
+	}
+	return cfg
+}
+
+// ParseHTML reads the preview metadata from the <head> of a page. Open Graph tags win over Twitter card
+// tags, which win over <title> and the description meta tag. Relative image URLs are resolved against pageURL.
+func ParseHTML(r io.Reader, pageURL *url.URL) (*Preview, error) {
+	meta := make(map[string]string)
+	var title string
+	z := html.NewTokenizer(r)
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return buildPreview(meta, title, pageURL)
+		case html.StartTagToken, html.SelfClosingTagToken:
+			token := z.Token()
+			switch token.Data {
+			case "body":
+				return buildPreview(meta, title, pageURL)
+			case "title":
+				if title == "" && z.Next() == html.TextToken {
+					title = z.Token().Data
+				}
+			case "meta":
+				var key, content string
+				for _, attr := range token.Attr {
+					switch attr.Key {
+					case "property", "name":
+						key = strings.ToLower(strings.TrimSpace(attr.Val))
+					case "content":
+						content = attr.Val
+					}
+				}
+				if _, ok := meta[key]; key != "" && !ok && strings.TrimSpace(content) != "" {
+					meta[key] = content
+				}
+			}
+		case html.EndTagToken:
+			if z.Token().Data == "head" {
+				return buildPreview(meta, title, pageURL)
+			}
+		}
+	}
+}
+
+func buildPreview(meta map[string]string, title string, pageURL *url.URL) (*Preview, error) {
+	first := func(keys ...string) string {
+		for _, key := range keys {
+			if v := strings.TrimSpace(meta[key]); v != "" {
+				return v
+			}
+		}
+		return ""
+	}
+	preview := &Preview{
+		Title:       truncate(first("og:title", "twitter:title"), maxTitleLength),
+		Description: truncate(first("og:description", "twitter:description", "description"), maxDescriptionLength),
+		SiteName:    truncate(first("og:site_name"), maxSiteNameLength),
+		ImageURL:    resolveImageURL(first("og:image:secure_url", "og:image", "og:image:url", "twitter:image", "twitter:image:src"), pageURL),
+	}
+	if preview.Title == "" {
+		preview.Title = truncate(title, maxTitleLength)
+	}
+	if preview.Title == "" && preview.Description == "" {
+		return nil, ErrNoMetadata
+	}
+	return preview, nil
+}
+
+// resolveImageURL makes an image URL absolute. Anything but http(s) is dropped.
+func resolveImageURL(rawURL string, pageURL *url.URL) string {
+	if rawURL == "" {
+		return ""
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	if pageURL != nil {
+		u = pageURL.ResolveReference(u)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ""
+	}
+	return u.String()
+}
+
+// truncate collapses whitespace and cuts s to at most max runes.
+func truncate(s string, max int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	runes := []rune(s)
+	return strings.TrimSpace(string(runes[:max-1])) + "…"
+}
@@ -0,0 +1,163 @@
+package linkpreview
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHTML(t *testing.T) {
+	pageURL, _ := url.Parse("https://example.com/articles/1")
+
+	t.Run("open graph wins", func(t *testing.T) {
+		page := `<html><head>
+			<title>Fallback title</title>
+			<meta name="twitter:title" content="Card title">
+			<meta property="og:title" content="OG &amp; title">
+			<meta property="og:description" content="  An   article ">
+			<meta property="og:image" content="/img/cover.png">
+			<meta property="og:site_name" content="Example">
+		</head><body><meta property="og:title" content="ignored"></body></html>`
+		preview, err := ParseHTML(strings.NewReader(page), pageURL)
+		require.NoError(t, err)
+		assert.Equal(t, &Preview{
+			Title:       "OG & title",
+			Description: "An article",
+			ImageURL:    "https://example.com/img/cover.png",
+			SiteName:    "Example",
+		}, preview)
+	})
+
+	t.Run("falls back to twitter card and title", func(t *testing.T) {
+		page := `<head><title>Page title</title><meta name="description" content="Plain description">
+			<meta name="twitter:image" content="javascript:alert(1)"></head>`
+		preview, err := ParseHTML(strings.NewReader(page), pageURL)
+		require.NoError(t, err)
+		assert.Equal(t, "Page title", preview.Title)
+		assert.Equal(t, "Plain description", preview.Description)
+		assert.Empty(t, preview.ImageURL, "non-http image URLs are dropped")
+	})
+
+	t.Run("no metadata", func(t *testing.T) {
+		_, err := ParseHTML(strings.NewReader(`<html><body>Hello</body></html>`), pageURL)
+		assert.ErrorIs(t, err, ErrNoMetadata)
+	})
+
+	t.Run("long titles are truncated", func(t *testing.T) {
+		page := `<meta property="og:title" content="` + strings.Repeat("a", 500) + `">`
+		preview, err := ParseHTML(strings.NewReader(page), pageURL)
+		require.NoError(t, err)
+		assert.Len(t, []rune(preview.Title), maxTitleLength)
+	})
+}
+
+func TestIsPublicIP(t *testing.T) {
+	testCases := []struct {
+		ip     string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"::1", false},
+		{"fd00::1", false},
+		{"fe80::1", false},
+		{"::ffff:10.0.0.1", false},
+		{"64:ff9b::a00:1", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.ip, func(t *testing.T) {
+			assert.Equal(t, tc.public, IsPublicIP(net.ParseIP(tc.ip)))
+		})
+	}
+}
+
+func TestHTTPFetcher_RefusesPrivateAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<meta property="og:title" content="internal">`))
+	}))
+	defer server.Close()
+
+	fetcher := NewHTTPFetcher(DefaultConfig())
+	_, err := fetcher.Fetch(context.Background(), server.URL)
+	assert.ErrorIs(t, err, ErrBlockedAddress)
+}
+
+type memStore struct {
+	entries map[string]Entry
+}
+
+func (s *memStore) GetLinkPreviewEntry(ctx context.Context, pageURL string) (*Entry, error) {
+	entry, ok := s.entries[pageURL]
+	if !ok {
+		return nil, nil
+	}
+	return &entry, nil
+}
+
+func (s *memStore) SaveLinkPreviewEntry(ctx context.Context, entry Entry) error {
+	s.entries[entry.URL] = entry
+	return nil
+}
+
+func TestWorker_CachesByURL(t *testing.T) {
+	fetcher := NewStubFetcher(map[string]Preview{"https://example.com/": {Title: "Example"}})
+	store := &memStore{entries: map[string]Entry{}}
+	worker := NewWorker(fetcher, store, DefaultConfig())
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	worker.now = func() time.Time { return now }
+
+	worker.process(context.Background(), "https://example.com/")
+	worker.process(context.Background(), "https://example.com/")
+	assert.Equal(t, []string{"https://example.com/"}, fetcher.Calls(), "a fresh preview is not fetched again")
+	assert.Equal(t, "Example", store.entries["https://example.com/"].Preview.Title)
+
+	now = now.Add(DefaultConfig().CacheTTL)
+	worker.process(context.Background(), "https://example.com/")
+	assert.Len(t, fetcher.Calls(), 2, "an expired preview is fetched again")
+}
+
+func TestWorker_RetriesFailuresAfterFailureTTL(t *testing.T) {
+	fetcher := NewStubFetcher(nil)
+	store := &memStore{entries: map[string]Entry{}}
+	worker := NewWorker(fetcher, store, DefaultConfig())
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	worker.now = func() time.Time { return now }
+
+	worker.process(context.Background(), "https://example.com/missing")
+	entry := store.entries["https://example.com/missing"]
+	assert.Nil(t, entry.Preview, "failures are cached so they aren't retried on every post")
+
+	now = now.Add(DefaultConfig().FailureTTL - time.Minute)
+	worker.process(context.Background(), "https://example.com/missing")
+	assert.Len(t, fetcher.Calls(), 1)
+
+	now = now.Add(time.Minute)
+	worker.process(context.Background(), "https://example.com/missing")
+	assert.Len(t, fetcher.Calls(), 2)
+}
+
+func TestWorker_EnqueueSkipsPendingURLs(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.QueueSize = 1
+	worker := NewWorker(NewStubFetcher(nil), &memStore{entries: map[string]Entry{}}, cfg)
+
+	assert.True(t, worker.Enqueue("https://example.com/a"))
+	assert.False(t, worker.Enqueue("https://example.com/a"), "already queued")
+	assert.False(t, worker.Enqueue("https://example.com/b"), "queue full")
+}
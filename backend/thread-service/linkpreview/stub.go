@@ -0,0 +1,41 @@
+package linkpreview
+
+import (
+	"context"
+	"sync"
+)
+
+// StubFetcher serves canned previews without touching the network, for tests and local development.
+// URLs it has no preview for fail with ErrNoMetadata.
+type StubFetcher struct {
+	Previews map[string]Preview
+
+	mu    sync.Mutex
+	calls []string
+}
+
+func NewStubFetcher(previews map[string]Preview) *StubFetcher {
+	return &StubFetcher{Previews: previews}
+}
+
+func (f *StubFetcher) Fetch(ctx context.Context, pageURL string) (*Preview, error) {
+	f.mu.Lock()
+	f.calls = append(f.calls, pageURL)
+	f.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	preview, ok := f.Previews[pageURL]
+	if !ok {
+		return nil, ErrNoMetadata
+	}
+	return &preview, nil
+}
+
+// Calls returns the URLs fetched so far, in order.
+func (f *StubFetcher) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.calls...)
+}
@@ -0,0 +1,133 @@
+package linkpreview
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Entry is a cached fetch result. Preview is nil when the fetch failed.
+type Entry struct {
+	URL       string
+	Preview   *Preview
+	FetchedAt time.Time
+}
+
+// Store caches fetch results by normalized URL. Get returns nil when the URL was never fetched.
+type Store interface {
+	GetLinkPreviewEntry(ctx context.Context, pageURL string) (*Entry, error)
+	SaveLinkPreviewEntry(ctx context.Context, entry Entry) error
+}
+
+// Worker fetches previews in the background so posting a thread never waits on someone else's website.
+// Each URL is fetched at most once per CacheTTL, or FailureTTL if the last fetch failed.
+type Worker struct {
+	fetcher Fetcher
+	store   Store
+	cfg     Config
+	now     func() time.Time
+	queue   chan string
+
+	mu      sync.Mutex
+	pending map[string]bool
+}
+
+func NewWorker(fetcher Fetcher, store Store, cfg Config) *Worker {
+	if cfg.Workers <= 0 {
+		cfg.Workers = 1
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 1
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultConfig().Timeout
+	}
+	return &Worker{
+		fetcher: fetcher,
+		store:   store,
+		cfg:     cfg,
+		now:     time.Now,
+		queue:   make(chan string, cfg.QueueSize),
+		pending: make(map[string]bool),
+	}
+}
+
+// Enqueue schedules a fetch of a normalized URL. It never blocks: URLs already queued are skipped,
+// and when the queue is full the URL is dropped and picked up again the next time it is posted.
+func (w *Worker) Enqueue(pageURL string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.pending[pageURL] {
+		return false
+	}
+	select {
+	case w.queue <- pageURL:
+		w.pending[pageURL] = true
+		return true
+	default:
+		log.Printf("Link preview queue full, dropping %s", pageURL)
+		return false
+	}
+}
+
+// Start blocks until ctx is cancelled, fetching queued URLs with cfg.Workers goroutines.
+func (w *Worker) Start(ctx context.Context) {
+	log.Printf("Link preview worker started (workers: %d, queue: %d)", w.cfg.Workers, w.cfg.QueueSize)
+	var wg sync.WaitGroup
+	for i := 0; i < w.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case pageURL := <-w.queue:
+					w.process(ctx, pageURL)
+					w.mu.Lock()
+					delete(w.pending, pageURL)
+					w.mu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	log.Println("Link preview worker stopped.")
+}
+
+func (w *Worker) process(ctx context.Context, pageURL string) {
+	entry, err := w.store.GetLinkPreviewEntry(ctx, pageURL)
+	if err != nil {
+		log.Printf("Link preview: Failed to read cache for %s: %v", pageURL, err)
+		return
+	}
+	if w.fresh(entry) {
+		return
+	}
+
+	fetchCtx, cancel := context.WithTimeout(ctx, w.cfg.Timeout)
+	preview, err := w.fetcher.Fetch(fetchCtx, pageURL)
+	cancel()
+	if err != nil {
+		log.Printf("Link preview: Failed to fetch %s: %v", pageURL, err)
+		if ctx.Err() != nil {
+			return // shutting down; don't cache the failure
+		}
+		preview = nil
+	}
+	if err := w.store.SaveLinkPreviewEntry(ctx, Entry{URL: pageURL, Preview: preview, FetchedAt: w.now().UTC()}); err != nil {
+		log.Printf("Link preview: Failed to cache %s: %v", pageURL, err)
+	}
+}
+
+func (w *Worker) fresh(entry *Entry) bool {
+	if entry == nil {
+		return false
+	}
+	ttl := w.cfg.CacheTTL
+	if entry.Preview == nil {
+		ttl = w.cfg.FailureTTL
+	}
+	return w.now().Sub(entry.FetchedAt) < ttl
+}
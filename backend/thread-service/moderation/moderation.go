@@ -0,0 +1,32 @@
+// Package moderation holds who may moderate other users' content site-wide.
+package moderation
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+type Config struct {
+	ModeratorUserIDs map[uint]bool // may change other users' threads, e.g. flag them as sensitive
+}
+
+func DefaultConfig() Config {
+	return Config{ModeratorUserIDs: map[uint]bool{}}
+}
+
+// ConfigFromEnv starts from DefaultConfig and adds the users listed in MODERATOR_USER_IDS.
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig()
+	for _, s := range strings.Split(os.Getenv("MODERATOR_USER_IDS"), ",") {
+		if id, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32); err == nil && id > 0 {
+			cfg.ModeratorUserIDs[uint(id)] = true
+		}
+	}
+	return cfg
+}
+
+// IsModerator reports whether userID is a site-wide moderator.
+func (c Config) IsModerator(userID uint) bool {
+	return c.ModeratorUserIDs[userID]
+}
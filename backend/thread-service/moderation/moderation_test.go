@@ -0,0 +1,23 @@
+package moderation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("MODERATOR_USER_IDS", "4, 12,x,0")
+	t.Setenv("ADMIN_USER_IDS", "7")
+
+	cfg := ConfigFromEnv()
+	assert.True(t, cfg.IsModerator(4))
+	assert.True(t, cfg.IsModerator(12))
+	assert.False(t, cfg.IsModerator(0))
+	assert.False(t, cfg.IsModerator(7), "ad admins aren't moderators")
+	assert.Len(t, cfg.ModeratorUserIDs, 2)
+}
+
+func TestDefaultConfigHasNoModerators(t *testing.T) {
+	assert.False(t, DefaultConfig().IsModerator(1))
+}
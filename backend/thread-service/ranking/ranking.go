@@ -0,0 +1,189 @@
+// Package ranking scores candidate threads for the "foryou" feed.
+// It has no database or network dependencies so the scoring can be tuned and tested in isolation.
+package ranking
+
+import (
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Weights control how much each signal adds to a thread's score before recency decay is applied.
+type Weights struct {
+	Like             float64
+	Reply            float64
+	Bookmark         float64
+	Following        float64 // viewer follows the author
+	Mutual           float64 // viewer and author follow each other; replaces Following
+	CategoryAffinity float64 // multiplied by the viewer's affinity (0-1) for the thread's best category
+}
+
+type Config struct {
+	Weights     Weights
+	HalfLife    time.Duration // age at which a thread's score is halved
+	Window      time.Duration // only threads posted within this long of the anchor are candidates
+	PoolSize    int           // maximum number of candidates scored per snapshot
+	SnapshotTTL time.Duration // how long a ranked snapshot is reused for pagination
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Weights: Weights{
+			Like:             1.0,
+			Reply:            1.5,
+			Bookmark:         2.0,
+			Following:        2.0,
+			Mutual:           3.0,
+			CategoryAffinity: 2.0,
+		},
+		HalfLife:    12 * time.Hour,
+		Window:      7 * 24 * time.Hour,
+		PoolSize:    1000,
+		SnapshotTTL: 10 * time.Minute,
+	}
+}
+
+// ConfigFromEnv starts from DefaultConfig and applies any FORYOU_* overrides that parse.
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig()
+	envFloat("FORYOU_WEIGHT_LIKE", &cfg.Weights.Like)
+	envFloat("FORYOU_WEIGHT_REPLY", &cfg.Weights.Reply)
+	envFloat("FORYOU_WEIGHT_BOOKMARK", &cfg.Weights.Bookmark)
+	envFloat("FORYOU_WEIGHT_FOLLOWING", &cfg.Weights.Following)
+	envFloat("FORYOU_WEIGHT_MUTUAL", &cfg.Weights.Mutual)
+	envFloat("FORYOU_WEIGHT_CATEGORY", &cfg.Weights.CategoryAffinity)
+	envHours("FORYOU_HALF_LIFE_HOURS", &cfg.HalfLife)
+	envHours("FORYOU_WINDOW_HOURS", &cfg.Window)
+	if v, err := strconv.Atoi(os.Getenv("FORYOU_POOL_SIZE")); err == nil && v > 0 {
+		cfg.PoolSize = v
+	}
+	if v, err := strconv.Atoi(os.Getenv("FORYOU_SNAPSHOT_TTL_MINUTES")); err == nil && v > 0 {
+		cfg.SnapshotTTL = time.Duration(v) * time.Minute
+	}
+	return cfg
+}
+
+func envFloat(key string, dst *float64) {
+	if v, err := strconv.ParseFloat(os.Getenv(key), 64); err == nil && v >= 0 {
+		*dst = v
+	}
+}
+
+func envHours(key string, dst *time.Duration) {
+	if v, err := strconv.ParseFloat(os.Getenv(key), 64); err == nil && v > 0 {
+		*dst = time.Duration(v * float64(time.Hour))
+	}
+}
+
+// Candidate is the subset of a thread the scorer needs.
+type Candidate struct {
+	ThreadID      uint
+	AuthorID      uint
+	PostedAt      time.Time
+	LikeCount     int64
+	ReplyCount    int64
+	BookmarkCount int64
+	Categories    []string
+}
+
+// Viewer describes who the feed is for. The zero value is an anonymous viewer.
+type Viewer struct {
+	Following        map[uint]bool      // authors the viewer follows
+	Followers        map[uint]bool      // users following the viewer
+	CategoryAffinity map[string]float64 // from CategoryAffinity
+}
+
+// Score combines engagement, relationship and category signals and decays the result by age.
+// Engagement counts are log-scaled so a handful of viral threads can't drown out everything else.
+func Score(c Candidate, v Viewer, now time.Time, cfg Config) float64 {
+	w := cfg.Weights
+	signal := 1.0 +
+		w.Like*math.Log1p(float64(c.LikeCount)) +
+		w.Reply*math.Log1p(float64(c.ReplyCount)) +
+		w.Bookmark*math.Log1p(float64(c.BookmarkCount))
+
+	if v.Following[c.AuthorID] {
+		if v.Followers[c.AuthorID] {
+			signal += w.Mutual
+		} else {
+			signal += w.Following
+		}
+	}
+
+	bestAffinity := 0.0
+	for _, category := range c.Categories {
+		if a := v.CategoryAffinity[category]; a > bestAffinity {
+			bestAffinity = a
+		}
+	}
+	signal += w.CategoryAffinity * bestAffinity
+
+	return signal * decay(now.Sub(c.PostedAt), cfg.HalfLife)
+}
+
+func decay(age, halfLife time.Duration) float64 {
+	if age <= 0 || halfLife <= 0 {
+		return 1
+	}
+	return math.Exp2(-float64(age) / float64(halfLife))
+}
+
+// Rank returns the candidates' thread IDs from best to worst.
+// Ties are broken by newer PostedAt, then higher ThreadID, so the same input always gives the same order.
+func Rank(candidates []Candidate, v Viewer, now time.Time, cfg Config) []uint {
+	type scored struct {
+		c     Candidate
+		score float64
+	}
+	items := make([]scored, len(candidates))
+	for i, c := range candidates {
+		items[i] = scored{c: c, score: Score(c, v, now, cfg)}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].score != items[j].score {
+			return items[i].score > items[j].score
+		}
+		if !items[i].c.PostedAt.Equal(items[j].c.PostedAt) {
+			return items[i].c.PostedAt.After(items[j].c.PostedAt)
+		}
+		return items[i].c.ThreadID > items[j].c.ThreadID
+	})
+
+	ids := make([]uint, len(items))
+	for i, it := range items {
+		ids[i] = it.c.ThreadID
+	}
+	return ids
+}
+
+// CategoryAffinity turns per-category like counts into affinities between 0 and 1,
+// relative to the viewer's most liked category.
+func CategoryAffinity(likeCounts map[string]int64) map[string]float64 {
+	var max int64
+	for _, n := range likeCounts {
+		if n > max {
+			max = n
+		}
+	}
+	affinity := make(map[string]float64, len(likeCounts))
+	if max == 0 {
+		return affinity
+	}
+	for category, n := range likeCounts {
+		if n > 0 {
+			affinity[category] = float64(n) / float64(max)
+		}
+	}
+	return affinity
+}
+
+// Anchor is the reference time for a snapshot. Every request within the same SnapshotTTL bucket
+// shares an anchor, so candidates and decay are computed identically across pages.
+func Anchor(now time.Time, cfg Config) time.Time {
+	if cfg.SnapshotTTL <= 0 {
+		return now
+	}
+	return now.Truncate(cfg.SnapshotTTL)
+}
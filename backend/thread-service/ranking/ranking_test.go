@@ -0,0 +1,117 @@
+package ranking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func TestScore(t *testing.T) {
+	cfg := DefaultConfig()
+	base := Candidate{ThreadID: 1, AuthorID: 10, PostedAt: testNow.Add(-time.Hour)}
+
+	t.Run("newer beats older with equal signals", func(t *testing.T) {
+		older := base
+		older.PostedAt = testNow.Add(-24 * time.Hour)
+		assert.Greater(t, Score(base, Viewer{}, testNow, cfg), Score(older, Viewer{}, testNow, cfg))
+	})
+
+	t.Run("score halves after one half-life", func(t *testing.T) {
+		fresh := base
+		fresh.PostedAt = testNow
+		aged := base
+		aged.PostedAt = testNow.Add(-cfg.HalfLife)
+		assert.InDelta(t, Score(fresh, Viewer{}, testNow, cfg)/2, Score(aged, Viewer{}, testNow, cfg), 1e-9)
+	})
+
+	t.Run("engagement raises score", func(t *testing.T) {
+		engaged := base
+		engaged.LikeCount, engaged.ReplyCount, engaged.BookmarkCount = 5, 2, 1
+		assert.Greater(t, Score(engaged, Viewer{}, testNow, cfg), Score(base, Viewer{}, testNow, cfg))
+	})
+
+	t.Run("mutual beats following beats stranger", func(t *testing.T) {
+		following := Viewer{Following: map[uint]bool{10: true}}
+		mutual := Viewer{Following: map[uint]bool{10: true}, Followers: map[uint]bool{10: true}}
+		follower := Viewer{Followers: map[uint]bool{10: true}}
+		assert.Greater(t, Score(base, mutual, testNow, cfg), Score(base, following, testNow, cfg))
+		assert.Greater(t, Score(base, following, testNow, cfg), Score(base, Viewer{}, testNow, cfg))
+		assert.Equal(t, Score(base, Viewer{}, testNow, cfg), Score(base, follower, testNow, cfg), "being followed alone is not a signal")
+	})
+
+	t.Run("category affinity uses best matching category", func(t *testing.T) {
+		categorized := base
+		categorized.Categories = []string{"sports", "music"}
+		viewer := Viewer{CategoryAffinity: map[string]float64{"music": 1, "sports": 0.5}}
+		expected := Score(base, Viewer{}, testNow, cfg) + cfg.Weights.CategoryAffinity*decay(time.Hour, cfg.HalfLife)
+		assert.InDelta(t, expected, Score(categorized, viewer, testNow, cfg), 1e-9)
+	})
+
+	t.Run("zero weights ignore signals", func(t *testing.T) {
+		flat := cfg
+		flat.Weights = Weights{}
+		engaged := base
+		engaged.LikeCount = 100
+		viewer := Viewer{Following: map[uint]bool{10: true}}
+		assert.Equal(t, Score(base, Viewer{}, testNow, flat), Score(engaged, viewer, testNow, flat))
+	})
+}
+
+func TestRankIsDeterministic(t *testing.T) {
+	cfg := DefaultConfig()
+	posted := testNow.Add(-time.Hour)
+	candidates := []Candidate{
+		{ThreadID: 1, AuthorID: 1, PostedAt: posted},
+		{ThreadID: 3, AuthorID: 1, PostedAt: posted},
+		{ThreadID: 2, AuthorID: 1, PostedAt: posted.Add(time.Minute)},
+		{ThreadID: 4, AuthorID: 2, PostedAt: posted, LikeCount: 10},
+	}
+	expected := []uint{4, 2, 3, 1}
+
+	assert.Equal(t, expected, Rank(candidates, Viewer{}, testNow, cfg))
+	reversed := []Candidate{candidates[3], candidates[2], candidates[1], candidates[0]}
+	assert.Equal(t, expected, Rank(reversed, Viewer{}, testNow, cfg), "input order must not affect ranking")
+}
+
+func TestCategoryAffinity(t *testing.T) {
+	affinity := CategoryAffinity(map[string]int64{"music": 4, "sports": 1, "news": 0})
+	assert.Equal(t, map[string]float64{"music": 1, "sports": 0.25}, affinity)
+	assert.Empty(t, CategoryAffinity(nil))
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("FORYOU_WEIGHT_LIKE", "3.5")
+	t.Setenv("FORYOU_HALF_LIFE_HOURS", "6")
+	t.Setenv("FORYOU_WEIGHT_REPLY", "not-a-number")
+
+	cfg := ConfigFromEnv()
+	assert.Equal(t, 3.5, cfg.Weights.Like)
+	assert.Equal(t, 6*time.Hour, cfg.HalfLife)
+	assert.Equal(t, DefaultConfig().Weights.Reply, cfg.Weights.Reply)
+}
+
+func TestSnapshotPagination(t *testing.T) {
+	cfg := DefaultConfig()
+	anchor := Anchor(testNow.Add(3*time.Minute), cfg)
+	assert.Equal(t, anchor, Anchor(testNow.Add(7*time.Minute), cfg), "requests in the same bucket share an anchor")
+
+	cache := NewSnapshotCache()
+	cache.Put(7, anchor, []uint{5, 4, 3, 2, 1}, anchor.Add(cfg.SnapshotTTL), testNow)
+
+	ids, ok := cache.Get(7, anchor, testNow.Add(time.Minute))
+	assert.True(t, ok)
+	page, more := Page(ids, 2, 2)
+	assert.Equal(t, []uint{3, 2}, page)
+	assert.True(t, more)
+	page, more = Page(ids, 2, 4)
+	assert.Equal(t, []uint{1}, page)
+	assert.False(t, more)
+
+	_, ok = cache.Get(7, anchor, anchor.Add(cfg.SnapshotTTL))
+	assert.False(t, ok, "snapshot expires with its bucket")
+	_, ok = cache.Get(8, anchor, testNow)
+	assert.False(t, ok)
+}
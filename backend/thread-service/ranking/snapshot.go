@@ -0,0 +1,68 @@
+package ranking
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// maxSnapshots bounds memory use; the cache is cleared if it is still full after pruning.
+const maxSnapshots = 10000
+
+// SnapshotCache keeps each viewer's ranked thread IDs for the current anchor so later pages slice the
+// same order instead of re-ranking against counters that moved in the meantime.
+type SnapshotCache struct {
+	mu      sync.Mutex
+	entries map[string]snapshot
+}
+
+type snapshot struct {
+	ids     []uint
+	expires time.Time
+}
+
+func NewSnapshotCache() *SnapshotCache {
+	return &SnapshotCache{entries: make(map[string]snapshot)}
+}
+
+func snapshotKey(viewerID uint, anchor time.Time) string {
+	return fmt.Sprintf("%d:%d", viewerID, anchor.Unix())
+}
+
+func (c *SnapshotCache) Get(viewerID uint, anchor, now time.Time) ([]uint, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, ok := c.entries[snapshotKey(viewerID, anchor)]
+	if !ok || !now.Before(s.expires) {
+		return nil, false
+	}
+	return s.ids, true
+}
+
+func (c *SnapshotCache) Put(viewerID uint, anchor time.Time, ids []uint, expires, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= maxSnapshots {
+		for k, s := range c.entries {
+			if !now.Before(s.expires) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= maxSnapshots {
+			c.entries = make(map[string]snapshot)
+		}
+	}
+	c.entries[snapshotKey(viewerID, anchor)] = snapshot{ids: ids, expires: expires}
+}
+
+// Page returns the IDs for one page of a ranked list and whether more follow.
+func Page(ids []uint, limit, offset int) ([]uint, bool) {
+	if offset >= len(ids) {
+		return nil, false
+	}
+	end := offset + limit
+	if end > len(ids) {
+		end = len(ids)
+	}
+	return ids[offset:end], end < len(ids)
+}
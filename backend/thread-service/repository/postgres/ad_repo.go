@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AdCampaign promotes one thread into other users' feeds until its budget or schedule runs out.
+type AdCampaign struct {
+	ID                      uint           `gorm:"primaryKey"`
+	ThreadID                uint           `gorm:"not null;uniqueIndex"`
+	AdvertiserID            uint           `gorm:"not null;index"`
+	BudgetCents             int64          `gorm:"not null"`
+	SpentCents              int64          `gorm:"not null;default:0"`
+	StartsAt                time.Time      `gorm:"not null"`
+	EndsAt                  time.Time      `gorm:"not null;index"`
+	TargetCategories        pq.StringArray `gorm:"type:text[]"`
+	TargetFollowerOfUserIDs pq.Int64Array  `gorm:"type:bigint[]"`
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
+}
+
+// AdImpression is one time a campaign was served to a viewer. The session is the viewer's
+// browsing session, so a campaign is served at most once per session.
+type AdImpression struct {
+	ID         uint      `gorm:"primaryKey"`
+	CampaignID uint      `gorm:"not null;index:idx_ad_impression_session"`
+	SessionID  string    `gorm:"type:varchar(64);not null;index:idx_ad_impression_session"`
+	UserID     uint      `gorm:"not null"` // 0 for anonymous viewers
+	CreatedAt  time.Time `gorm:"default:current_timestamp"`
+}
+
+// AdClick is a served ad the viewer opened. Repeat clicks in a session count once.
+type AdClick struct {
+	ID         uint      `gorm:"primaryKey"`
+	CampaignID uint      `gorm:"not null;uniqueIndex:idx_ad_click_session"`
+	SessionID  string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_ad_click_session"`
+	UserID     uint      `gorm:"not null"`
+	CreatedAt  time.Time `gorm:"default:current_timestamp"`
+}
+
+func (AdCampaign) TableName() string   { return "ad_campaigns" }
+func (AdImpression) TableName() string { return "ad_impressions" }
+func (AdClick) TableName() string      { return "ad_clicks" }
+
+// AdCampaignReport is a campaign with its delivery so far.
+type AdCampaignReport struct {
+	AdCampaign
+	Impressions int64
+	Clicks      int64
+}
+
+// CreateAdCampaign stores the campaign and marks its thread as an advertisement.
+func (r *ThreadRepository) CreateAdCampaign(ctx context.Context, campaign *AdCampaign) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(campaign).Error; err != nil {
+			return fmt.Errorf("failed to create ad campaign for thread %d: %w", campaign.ThreadID, err)
+		}
+		if err := tx.Model(&Thread{}).Where("id = ?", campaign.ThreadID).Update("is_advertisement", true).Error; err != nil {
+			return fmt.Errorf("failed to mark thread %d as an advertisement: %w", campaign.ThreadID, err)
+		}
+		return nil
+	})
+}
+
+// GetServableAdCampaigns returns campaigns running at now with budget left whose thread is still published,
+// skipping the given advertisers.
+func (r *ThreadRepository) GetServableAdCampaigns(ctx context.Context, now time.Time, excludeAdvertiserIDs []uint, limit int) ([]AdCampaign, error) {
+	var campaigns []AdCampaign
+	query := r.db.WithContext(ctx).
+		Select("ad_campaigns.*").
+		Joins("JOIN threads ON threads.id = ad_campaigns.thread_id AND threads.deleted_at IS NULL AND threads.status = ?", ThreadStatusPublished).
+		Where("ad_campaigns.starts_at <= ? AND ad_campaigns.ends_at > ?", now, now).
+		Where("ad_campaigns.spent_cents < ad_campaigns.budget_cents").
+		Order("ad_campaigns.id ASC").
+		Limit(limit)
+	if len(excludeAdvertiserIDs) > 0 {
+		query = query.Where("ad_campaigns.advertiser_id NOT IN ?", excludeAdvertiserIDs)
+	}
+	if err := query.Find(&campaigns).Error; err != nil {
+		return nil, fmt.Errorf("failed to get servable ad campaigns: %w", err)
+	}
+	return campaigns, nil
+}
+
+// GetSessionAdCampaignIDs returns the campaigns already served in a session.
+func (r *ThreadRepository) GetSessionAdCampaignIDs(ctx context.Context, sessionID string) (map[uint]bool, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).Model(&AdImpression{}).
+		Where("session_id = ?", sessionID).
+		Distinct().Pluck("campaign_id", &ids).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ads served in session: %w", err)
+	}
+	seen := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		seen[id] = true
+	}
+	return seen, nil
+}
+
+// ChargeAdImpressions charges each campaign costCents and records an impression for the session.
+// Campaigns that can no longer afford it are skipped; the IDs actually charged are returned.
+func (r *ThreadRepository) ChargeAdImpressions(ctx context.Context, campaignIDs []uint, userID uint, sessionID string, costCents int64) ([]uint, error) {
+	var charged []uint
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, id := range campaignIDs {
+			result := tx.Model(&AdCampaign{}).
+				Where("id = ? AND spent_cents + ? <= budget_cents", id, costCents).
+				Update("spent_cents", gorm.Expr("spent_cents + ?", costCents))
+			if result.Error != nil {
+				return fmt.Errorf("failed to charge ad campaign %d: %w", id, result.Error)
+			}
+			if result.RowsAffected == 0 {
+				continue // budget ran out since the campaign was selected
+			}
+			if err := tx.Create(&AdImpression{CampaignID: id, SessionID: sessionID, UserID: userID}).Error; err != nil {
+				return fmt.Errorf("failed to record impression for ad campaign %d: %w", id, err)
+			}
+			charged = append(charged, id)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return charged, nil
+}
+
+// RecordAdClick records a click on an ad served in the session.
+func (r *ThreadRepository) RecordAdClick(ctx context.Context, campaignID, userID uint, sessionID string) error {
+	var served int64
+	err := r.db.WithContext(ctx).Model(&AdImpression{}).
+		Where("campaign_id = ? AND session_id = ?", campaignID, sessionID).
+		Count(&served).Error
+	if err != nil {
+		return fmt.Errorf("failed to check ad impression: %w", err)
+	}
+	if served == 0 {
+		return errors.New("ad impression not found")
+	}
+	click := AdClick{CampaignID: campaignID, SessionID: sessionID, UserID: userID}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&click).Error; err != nil {
+		return fmt.Errorf("failed to record click on ad campaign %d: %w", campaignID, err)
+	}
+	return nil
+}
+
+// GetAdCampaignReports returns an advertiser's campaigns, newest first, with impression and click counts.
+func (r *ThreadRepository) GetAdCampaignReports(ctx context.Context, advertiserID uint) ([]AdCampaignReport, error) {
+	var reports []AdCampaignReport
+	err := r.db.WithContext(ctx).Model(&AdCampaign{}).
+		Select("ad_campaigns.*, "+
+			"(SELECT COUNT(*) FROM ad_impressions WHERE ad_impressions.campaign_id = ad_campaigns.id) AS impressions, "+
+			"(SELECT COUNT(*) FROM ad_clicks WHERE ad_clicks.campaign_id = ad_campaigns.id) AS clicks").
+		Where("ad_campaigns.advertiser_id = ?", advertiserID).
+		Order("ad_campaigns.created_at DESC, ad_campaigns.id DESC").
+		Scan(&reports).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ad campaigns for advertiser %d: %w", advertiserID, err)
+	}
+	return reports, nil
+}
@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ThreadViewEvent is a raw impression, detail view or profile click. DedupeKey is unique, so a viewer
+// counts once per thread, kind and hour however many times the gateway reports them.
+type ThreadViewEvent struct {
+	ID           uint      `gorm:"primaryKey"`
+	ThreadID     uint      `gorm:"not null;index"`
+	ViewerKey    string    `gorm:"type:varchar(80);not null"`
+	Kind         string    `gorm:"type:varchar(20);not null"`
+	FromFollower bool      `gorm:"not null;default:false"`
+	OccurredAt   time.Time `gorm:"not null;index"`
+	DedupeKey    string    `gorm:"type:varchar(160);not null;uniqueIndex"`
+}
+
+// ThreadAnalyticsHour holds one thread's counts for the hour starting at Hour. Rows are rebuilt from
+// thread_view_events by the analytics aggregator and never incremented in place.
+type ThreadAnalyticsHour struct {
+	ThreadID               uint      `gorm:"primaryKey;autoIncrement:false"`
+	Hour                   time.Time `gorm:"primaryKey"`
+	Impressions            int64     `gorm:"not null;default:0"`
+	FollowerImpressions    int64     `gorm:"not null;default:0"`
+	NonFollowerImpressions int64     `gorm:"not null;default:0"`
+	DetailViews            int64     `gorm:"not null;default:0"`
+	ProfileClicks          int64     `gorm:"not null;default:0"`
+}
+
+func (ThreadAnalyticsHour) TableName() string { return "thread_analytics_hourly" }
+
+// RecordThreadViewEvents stores events, skipping those whose DedupeKey is already stored.
+func (r *ThreadRepository) RecordThreadViewEvents(ctx context.Context, events []ThreadViewEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "dedupe_key"}},
+		DoNothing: true,
+	}).CreateInBatches(&events, 200).Error
+	if err != nil {
+		return fmt.Errorf("failed to record thread view events: %w", err)
+	}
+	return nil
+}
+
+// GetThreadViewEventsBetween returns the events that occurred in [from, to).
+func (r *ThreadRepository) GetThreadViewEventsBetween(ctx context.Context, from, to time.Time) ([]ThreadViewEvent, error) {
+	var events []ThreadViewEvent
+	err := r.db.WithContext(ctx).
+		Where("occurred_at >= ? AND occurred_at < ?", from, to).
+		Find(&events).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get thread view events: %w", err)
+	}
+	return events, nil
+}
+
+// ReplaceThreadAnalyticsHours swaps every bucket with an hour in [from, to) for rows, in one transaction.
+func (r *ThreadRepository) ReplaceThreadAnalyticsHours(ctx context.Context, from, to time.Time, rows []ThreadAnalyticsHour) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("hour >= ? AND hour < ?", from, to).Delete(&ThreadAnalyticsHour{}).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(&rows, 200).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to replace thread analytics: %w", err)
+	}
+	return nil
+}
+
+// GetThreadAnalyticsHours returns a thread's buckets from the hour since onwards, oldest first.
+func (r *ThreadRepository) GetThreadAnalyticsHours(ctx context.Context, threadID uint, since time.Time) ([]ThreadAnalyticsHour, error) {
+	var rows []ThreadAnalyticsHour
+	err := r.db.WithContext(ctx).
+		Where("thread_id = ? AND hour >= ?", threadID, since).
+		Order("hour ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get thread analytics: %w", err)
+	}
+	return rows, nil
+}
+
+// GetThreadAnalyticsTotals sums all of a thread's buckets. Hour is left zero.
+func (r *ThreadRepository) GetThreadAnalyticsTotals(ctx context.Context, threadID uint) (*ThreadAnalyticsHour, error) {
+	totals := ThreadAnalyticsHour{ThreadID: threadID}
+	err := r.db.WithContext(ctx).Model(&ThreadAnalyticsHour{}).
+		Select("COALESCE(SUM(impressions), 0) AS impressions, "+
+			"COALESCE(SUM(follower_impressions), 0) AS follower_impressions, "+
+			"COALESCE(SUM(non_follower_impressions), 0) AS non_follower_impressions, "+
+			"COALESCE(SUM(detail_views), 0) AS detail_views, "+
+			"COALESCE(SUM(profile_clicks), 0) AS profile_clicks").
+		Where("thread_id = ?", threadID).
+		Scan(&totals).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get thread analytics totals: %w", err)
+	}
+	totals.ThreadID = threadID
+	return &totals, nil
+}
+
+// GetThreadReach counts the distinct viewers a thread was shown to, split by whether they followed the author.
+func (r *ThreadRepository) GetThreadReach(ctx context.Context, threadID uint, impressionKind string) (followers, nonFollowers int64, err error) {
+	var rows []struct {
+		FromFollower bool
+		Viewers      int64
+	}
+	err = r.db.WithContext(ctx).Model(&ThreadViewEvent{}).
+		Select("from_follower, COUNT(DISTINCT viewer_key) AS viewers").
+		Where("thread_id = ? AND kind = ?", threadID, impressionKind).
+		Group("from_follower").
+		Scan(&rows).Error
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get thread reach: %w", err)
+	}
+	for _, row := range rows {
+		if row.FromFollower {
+			followers = row.Viewers
+		} else {
+			nonFollowers = row.Viewers
+		}
+	}
+	return followers, nonFollowers, nil
+}
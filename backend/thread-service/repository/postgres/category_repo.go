@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// CategoryCount is the number of published threads in a category.
+type CategoryCount struct {
+	Category string
+	Count    int64
+}
+
+// GetLatestThreadsByCategory returns the published threads in category, newest first.
+// The containment filter is served by the GIN index on threads.categories.
+func (r *ThreadRepository) GetLatestThreadsByCategory(ctx context.Context, category string, limit, offset int, after *PageCursor, excludeUserIDs []uint) ([]Thread, error) {
+	var threads []Thread
+	query := r.db.WithContext(ctx).
+		Where("threads.categories @> ?", pq.StringArray{category}).
+		Where("threads.status = ?", ThreadStatusPublished).
+		Order("threads.posted_at DESC, threads.id DESC").
+		Limit(limit)
+
+	if after != nil {
+		query = query.Where("(threads.posted_at, threads.id) < (?, ?)", after.At, after.ID)
+	} else {
+		query = query.Offset(offset)
+	}
+	if len(excludeUserIDs) > 0 {
+		query = query.Where("threads.user_id NOT IN ?", excludeUserIDs)
+	}
+
+	if err := query.Find(&threads).Error; err != nil {
+		return nil, fmt.Errorf("failed to get latest threads for category %s: %w", category, err)
+	}
+	return threads, nil
+}
+
+// GetTopThreadsByCategory returns the published threads in category posted within [postedSince, postedUntil],
+// most engaging first.
+func (r *ThreadRepository) GetTopThreadsByCategory(ctx context.Context, category string, postedSince, postedUntil time.Time, limit, offset int, excludeUserIDs []uint) ([]Thread, error) {
+	var threads []Thread
+	query := r.db.WithContext(ctx).
+		Select("threads.*").
+		Joins("LEFT JOIN thread_stats ON thread_stats.thread_id = threads.id").
+		Where("threads.categories @> ?", pq.StringArray{category}).
+		Where("threads.status = ? AND threads.posted_at >= ? AND threads.posted_at <= ?", ThreadStatusPublished, postedSince, postedUntil).
+		Order(topEngagementScore + " DESC, threads.posted_at DESC, threads.id DESC").
+		Limit(limit).
+		Offset(offset)
+
+	if len(excludeUserIDs) > 0 {
+		query = query.Where("threads.user_id NOT IN ?", excludeUserIDs)
+	}
+
+	if err := query.Find(&threads).Error; err != nil {
+		return nil, fmt.Errorf("failed to get top threads for category %s: %w", category, err)
+	}
+	return threads, nil
+}
+
+// CountThreadsPerCategory counts the published threads posted in [since, until) for each category that has any.
+func (r *ThreadRepository) CountThreadsPerCategory(ctx context.Context, since, until time.Time) ([]CategoryCount, error) {
+	var counts []CategoryCount
+	query := `
+		SELECT category, COUNT(*) AS count
+		FROM threads, unnest(threads.categories) AS category
+		WHERE threads.deleted_at IS NULL AND threads.status = ?
+			AND threads.posted_at >= ? AND threads.posted_at < ?
+		GROUP BY category`
+	if err := r.db.WithContext(ctx).Raw(query, ThreadStatusPublished, since, until).Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("failed to count threads per category: %w", err)
+	}
+	return counts, nil
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GetDeletedThreadsByUser lists a user's published threads deleted at or after deletedSince, most recently deleted first.
+// Cancelled scheduled threads are left out; they were never public and can't be restored.
+func (r *ThreadRepository) GetDeletedThreadsByUser(ctx context.Context, userID uint, deletedSince time.Time, limit, offset int, after *PageCursor) ([]Thread, error) {
+	var threads []Thread
+	query := r.db.WithContext(ctx).Unscoped().
+		Where("user_id = ? AND status = ? AND deleted_at >= ?", userID, ThreadStatusPublished, deletedSince).
+		Order("deleted_at DESC, id DESC").
+		Limit(limit)
+
+	if after != nil {
+		query = query.Where("(deleted_at, id) < (?, ?)", after.At, after.ID)
+	} else {
+		query = query.Offset(offset)
+	}
+
+	if err := query.Find(&threads).Error; err != nil {
+		return nil, fmt.Errorf("failed to get deleted threads for user %d: %w", userID, err)
+	}
+	return threads, nil
+}
+
+// GetDeletedThreadByID returns a soft-deleted thread that hasn't been purged yet.
+func (r *ThreadRepository) GetDeletedThreadByID(ctx context.Context, id uint) (*Thread, error) {
+	var thread Thread
+	err := r.db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").First(&thread, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("thread not found")
+		}
+		return nil, fmt.Errorf("failed to get deleted thread %d: %w", id, err)
+	}
+	return &thread, nil
+}
+
+// RestoreThread undoes PerformSoftDelete: it clears deleted_at, adds back the hashtags and mentions resolved
+// from the thread's content, rebuilds its counters and counts it on its parent and quoted thread again.
+func (r *ThreadRepository) RestoreThread(ctx context.Context, threadID uint, hashtags []string, mentionedUserIDs []uint) (*Thread, error) {
+	var thread Thread
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("deleted_at IS NOT NULL").
+			First(&thread, threadID).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("thread not found")
+			}
+			return fmt.Errorf("failed to load thread %d for restoring: %w", threadID, err)
+		}
+		if err := tx.Unscoped().Model(&thread).Update("deleted_at", nil).Error; err != nil {
+			return fmt.Errorf("failed to restore thread %d: %w", threadID, err)
+		}
+
+		txRepo := NewThreadRepositoryWithTx(tx)
+		if err := txRepo.AddHashtags(ctx, threadID, hashtags); err != nil {
+			return fmt.Errorf("failed to restore hashtags of thread %d: %w", threadID, err)
+		}
+		if err := txRepo.AddMentions(ctx, threadID, thread.UserID, mentionedUserIDs); err != nil {
+			return fmt.Errorf("failed to restore mentions of thread %d: %w", threadID, err)
+		}
+		// Interactions were kept, but replies and quotes may have come and gone while it was deleted
+		if _, err := txRepo.rebuildThreadStats(ctx, []uint{threadID}); err != nil {
+			return err
+		}
+		if thread.Status == ThreadStatusPublished {
+			return txRepo.adjustParentStats(ctx, &thread, 1)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &thread, nil
+}
+
+// PurgeDeletedThreads hard deletes up to batchSize threads soft deleted before deletedBefore, together with every
+// row that only exists for them. It returns the purged IDs. Replies to purged threads are kept and still render
+// with the deleted-parent placeholder. Ad campaigns and their impressions are billing records and are kept.
+func (r *ThreadRepository) PurgeDeletedThreads(ctx context.Context, deletedBefore time.Time, batchSize int) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Model(&Thread{}).
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore).
+			Order("deleted_at ASC").
+			Limit(batchSize).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Pluck("id", &ids).Error
+		if err != nil {
+			return fmt.Errorf("failed to find threads to purge: %w", err)
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		for _, model := range []interface{}{
+			&ThreadInteraction{}, &Hashtag{}, &Mention{}, &ThreadStats{}, &ThreadRevision{},
+			&ModerationAction{}, &RemovedMention{}, &ThreadViewEvent{}, &ThreadAnalyticsHour{},
+		} {
+			if err := tx.Where("thread_id IN ?", ids).Delete(model).Error; err != nil {
+				return fmt.Errorf("failed to purge %T rows of threads %v: %w", model, ids, err)
+			}
+		}
+		if err := NewThreadRepositoryWithTx(tx).deletePollsForThreads(ctx, ids); err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("id IN ?", ids).Delete(&Thread{}).Error; err != nil {
+			return fmt.Errorf("failed to purge threads %v: %w", ids, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
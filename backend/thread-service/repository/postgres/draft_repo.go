@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+// ThreadDraft is an unfinished thread or reply saved from the composer. The media it lists stay
+// referenced while the draft exists, so they must not be treated as orphaned uploads.
+type ThreadDraft struct {
+	ID               uint           `gorm:"primaryKey"`
+	UserID           uint           `gorm:"not null;index"`
+	Content          string         `gorm:"type:text"`
+	MediaIDs         pq.Int64Array  `gorm:"type:bigint[]"`
+	Categories       pq.StringArray `gorm:"type:text[]"`
+	ParentThreadID   *uint          // set for reply drafts
+	CommunityID      *uint
+	ReplyRestriction string `gorm:"type:varchar(20);default:'everyone';not null"`
+	ContentWarning   string `gorm:"type:varchar(100);default:'';not null"`
+	IsSensitive      bool   `gorm:"default:false;not null"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+func (r *ThreadRepository) CreateDraft(ctx context.Context, draft *ThreadDraft) error {
+	if err := r.db.WithContext(ctx).Create(draft).Error; err != nil {
+		return fmt.Errorf("failed to create draft: %w", err)
+	}
+	return nil
+}
+
+// GetDraft returns a draft of userID. Other users' drafts are reported as not found.
+func (r *ThreadRepository) GetDraft(ctx context.Context, draftID, userID uint) (*ThreadDraft, error) {
+	var draft ThreadDraft
+	if err := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", draftID, userID).First(&draft).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("draft not found")
+		}
+		return nil, fmt.Errorf("failed to get draft: %w", err)
+	}
+	return &draft, nil
+}
+
+// UpdateDraft overwrites every editable field of an existing draft of draft.UserID.
+func (r *ThreadRepository) UpdateDraft(ctx context.Context, draft *ThreadDraft) error {
+	result := r.db.WithContext(ctx).Model(draft).
+		Where("user_id = ?", draft.UserID).
+		Select("content", "media_ids", "categories", "parent_thread_id", "community_id", "reply_restriction", "content_warning", "is_sensitive", "updated_at").
+		Updates(draft)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update draft: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("draft not found")
+	}
+	return nil
+}
+
+// GetDrafts lists a user's drafts, most recently edited first.
+func (r *ThreadRepository) GetDrafts(ctx context.Context, userID uint, limit, offset int) ([]ThreadDraft, error) {
+	var drafts []ThreadDraft
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("updated_at DESC, id DESC").
+		Limit(limit).Offset(offset).
+		Find(&drafts).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get drafts: %w", err)
+	}
+	return drafts, nil
+}
+
+func (r *ThreadRepository) CountDrafts(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&ThreadDraft{}).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count drafts: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteDraft removes a draft of userID. It returns "draft not found" if there is none, which also
+// stops a draft from being published twice when two requests race.
+func (r *ThreadRepository) DeleteDraft(ctx context.Context, draftID, userID uint) error {
+	result := r.db.WithContext(ctx).Where("id = ? AND user_id = ?", draftID, userID).Delete(&ThreadDraft{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete draft: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("draft not found")
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RelatedHashtag is a tag used together with another one, with the number of threads carrying both.
+type RelatedHashtag struct {
+	Tag           string
+	CoOccurrences int64
+}
+
+// GetLatestThreadsByHashtag returns the published threads tagged with tag, newest first.
+func (r *ThreadRepository) GetLatestThreadsByHashtag(ctx context.Context, tag string, limit, offset int, after *PageCursor, excludeUserIDs []uint) ([]Thread, error) {
+	var threads []Thread
+	query := r.db.WithContext(ctx).
+		Joins("JOIN hashtags ON hashtags.thread_id = threads.id AND hashtags.tag_name = ?", tag).
+		Where("threads.status = ?", ThreadStatusPublished).
+		Order("threads.posted_at DESC, threads.id DESC").
+		Limit(limit)
+
+	if after != nil {
+		query = query.Where("(threads.posted_at, threads.id) < (?, ?)", after.At, after.ID)
+	} else {
+		query = query.Offset(offset)
+	}
+	if len(excludeUserIDs) > 0 {
+		query = query.Where("threads.user_id NOT IN ?", excludeUserIDs)
+	}
+
+	if err := query.Find(&threads).Error; err != nil {
+		return nil, fmt.Errorf("failed to get latest threads for #%s: %w", tag, err)
+	}
+	return threads, nil
+}
+
+// GetTopThreadsByHashtag returns the published threads tagged with tag that were posted up to postedUntil,
+// most engaging first. Pinning postedUntil keeps new threads from shifting later pages.
+func (r *ThreadRepository) GetTopThreadsByHashtag(ctx context.Context, tag string, postedUntil time.Time, limit, offset int, excludeUserIDs []uint) ([]Thread, error) {
+	var threads []Thread
+	query := r.db.WithContext(ctx).
+		Select("threads.*").
+		Joins("JOIN hashtags ON hashtags.thread_id = threads.id AND hashtags.tag_name = ?", tag).
+		Joins("LEFT JOIN thread_stats ON thread_stats.thread_id = threads.id").
+		Where("threads.status = ? AND threads.posted_at <= ?", ThreadStatusPublished, postedUntil).
+		Order(topEngagementScore + " DESC, threads.posted_at DESC, threads.id DESC").
+		Limit(limit).
+		Offset(offset)
+
+	if len(excludeUserIDs) > 0 {
+		query = query.Where("threads.user_id NOT IN ?", excludeUserIDs)
+	}
+
+	if err := query.Find(&threads).Error; err != nil {
+		return nil, fmt.Errorf("failed to get top threads for #%s: %w", tag, err)
+	}
+	return threads, nil
+}
+
+// CountThreadsByHashtag counts the published threads tagged with tag.
+func (r *ThreadRepository) CountThreadsByHashtag(ctx context.Context, tag string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&Thread{}).
+		Joins("JOIN hashtags ON hashtags.thread_id = threads.id AND hashtags.tag_name = ?", tag).
+		Where("threads.status = ?", ThreadStatusPublished).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count threads for #%s: %w", tag, err)
+	}
+	return count, nil
+}
+
+// GetRelatedHashtags returns the tags that most often appear on the same published threads as tag.
+func (r *ThreadRepository) GetRelatedHashtags(ctx context.Context, tag string, limit int) ([]RelatedHashtag, error) {
+	var related []RelatedHashtag
+	query := `
+		SELECT other.tag_name AS tag, COUNT(*) AS co_occurrences
+		FROM hashtags tagged
+		JOIN hashtags other ON other.thread_id = tagged.thread_id AND other.tag_name <> tagged.tag_name
+		JOIN threads ON threads.id = tagged.thread_id
+		WHERE tagged.tag_name = ? AND threads.deleted_at IS NULL AND threads.status = ?
+		GROUP BY other.tag_name
+		ORDER BY co_occurrences DESC, tag ASC
+		LIMIT ?`
+	if err := r.db.WithContext(ctx).Raw(query, tag, ThreadStatusPublished, limit).Scan(&related).Error; err != nil {
+		return nil, fmt.Errorf("failed to get hashtags related to #%s: %w", tag, err)
+	}
+	return related, nil
+}
@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LinkPreview caches the preview card of a normalized URL. Failed fetches are cached too, with Failed set,
+// so a broken link isn't fetched again on every post.
+type LinkPreview struct {
+	URL         string `gorm:"primaryKey;type:varchar(2048)"`
+	Title       string `gorm:"type:varchar(255)"`
+	Description string `gorm:"type:text"`
+	ImageURL    string `gorm:"type:varchar(2048)"`
+	SiteName    string `gorm:"type:varchar(100)"`
+	Failed      bool   `gorm:"default:false;not null"`
+	FetchedAt   time.Time
+}
+
+// GetLinkPreview returns the cached preview for url, or nil if it was never fetched.
+func (r *ThreadRepository) GetLinkPreview(ctx context.Context, url string) (*LinkPreview, error) {
+	var preview LinkPreview
+	if err := r.db.WithContext(ctx).First(&preview, "url = ?", url).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get link preview: %w", err)
+	}
+	return &preview, nil
+}
+
+func (r *ThreadRepository) SaveLinkPreview(ctx context.Context, preview *LinkPreview) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "url"}},
+		DoUpdates: clause.AssignmentColumns([]string{"title", "description", "image_url", "site_name", "failed", "fetched_at"}),
+	}).Create(preview).Error
+	if err != nil {
+		return fmt.Errorf("failed to save link preview: %w", err)
+	}
+	return nil
+}
+
+// GetLinkPreviewsByURLs returns the successfully fetched previews among urls, keyed by URL.
+func (r *ThreadRepository) GetLinkPreviewsByURLs(ctx context.Context, urls []string) (map[string]LinkPreview, error) {
+	previews := make(map[string]LinkPreview)
+	if len(urls) == 0 {
+		return previews, nil
+	}
+	var rows []LinkPreview
+	if err := r.db.WithContext(ctx).Where("url IN ? AND failed = ?", urls, false).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get link previews: %w", err)
+	}
+	for _, row := range rows {
+		previews[row.URL] = row
+	}
+	return previews, nil
+}
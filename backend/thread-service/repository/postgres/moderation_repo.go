@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ModerationAction is the audit trail of what authors, mentioned users and moderators changed on a thread after posting.
+type ModerationAction struct {
+	ID             uint      `gorm:"primaryKey"`
+	ThreadID       uint      `gorm:"not null;index"` // the thread whose conversation was moderated
+	ActorID        uint      `gorm:"not null;index"`
+	Action         string    `gorm:"type:varchar(30);not null"`
+	TargetThreadID *uint     // the hidden or unhidden reply
+	TargetUserID   *uint     // the author of that reply, or of the thread when a moderator changed it
+	Detail         string    `gorm:"type:varchar(100)"`
+	CreatedAt      time.Time `gorm:"default:current_timestamp"`
+}
+
+const (
+	ModerationHideReply              = "hide_reply"
+	ModerationUnhideReply            = "unhide_reply"
+	ModerationRemoveMention          = "remove_mention"
+	ModerationChangeReplyRestriction = "change_reply_restriction"
+	ModerationMarkSensitive          = "mark_sensitive"
+	ModerationUnmarkSensitive        = "unmark_sensitive"
+)
+
+// RemovedMention remembers a user who took their @mention off a thread, so editing the thread doesn't add it back.
+type RemovedMention struct {
+	ThreadID  uint      `gorm:"primaryKey;autoIncrement:false"`
+	UserID    uint      `gorm:"primaryKey;autoIncrement:false"`
+	CreatedAt time.Time `gorm:"default:current_timestamp"`
+}
+
+// SetReplyHidden hides or unhides a reply on behalf of actorID and records it. It is a no-op if the reply is already in that state.
+func (r *ThreadRepository) SetReplyHidden(ctx context.Context, reply *Thread, actorID uint, hidden bool) error {
+	if (reply.HiddenAt != nil) == hidden {
+		return nil
+	}
+	var hiddenAt *time.Time
+	action := ModerationUnhideReply
+	if hidden {
+		now := time.Now().UTC()
+		hiddenAt = &now
+		action = ModerationHideReply
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(reply).Update("hidden_at", hiddenAt).Error; err != nil {
+			return err
+		}
+		return tx.Create(&ModerationAction{
+			ThreadID:       *reply.ParentThreadID,
+			ActorID:        actorID,
+			Action:         action,
+			TargetThreadID: &reply.ID,
+			TargetUserID:   &reply.UserID,
+		}).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to change visibility of reply %d: %w", reply.ID, err)
+	}
+	reply.HiddenAt = hiddenAt
+	return nil
+}
+
+// GetHiddenReplies pages through the hidden replies to a thread, oldest first like GetRepliesForThread.
+func (r *ThreadRepository) GetHiddenReplies(ctx context.Context, parentThreadID uint, limit, offset int, after *PageCursor, excludeUserIDs []uint) ([]Thread, error) {
+	var threads []Thread
+	query := r.db.WithContext(ctx).
+		Where("threads.parent_thread_id = ? AND threads.status = ? AND threads.hidden_at IS NOT NULL", parentThreadID, ThreadStatusPublished).
+		Order("threads.posted_at ASC, threads.id ASC").
+		Limit(limit)
+	if after != nil {
+		query = query.Where("(threads.posted_at, threads.id) > (?, ?)", after.At, after.ID)
+	} else {
+		query = query.Offset(offset)
+	}
+	if len(excludeUserIDs) > 0 {
+		query = query.Where("threads.user_id NOT IN ?", excludeUserIDs)
+	}
+	if err := query.Find(&threads).Error; err != nil {
+		return nil, fmt.Errorf("failed to get hidden replies for thread %d: %w", parentThreadID, err)
+	}
+	return threads, nil
+}
+
+// RemoveOwnMention deletes userID's mention from a thread and keeps it from coming back on edits.
+func (r *ThreadRepository) RemoveOwnMention(ctx context.Context, threadID, userID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("thread_id = ? AND mentioned_user_id = ?", threadID, userID).Delete(&Mention{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to remove mention of user %d from thread %d: %w", userID, threadID, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("mention not found")
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&RemovedMention{ThreadID: threadID, UserID: userID}).Error; err != nil {
+			return fmt.Errorf("failed to record removed mention: %w", err)
+		}
+		return tx.Create(&ModerationAction{ThreadID: threadID, ActorID: userID, Action: ModerationRemoveMention}).Error
+	})
+}
+
+// GetRemovedMentionUserIDs returns the users who removed their mention from a thread.
+func (r *ThreadRepository) GetRemovedMentionUserIDs(ctx context.Context, threadID uint) (map[uint]bool, error) {
+	var userIDs []uint
+	err := r.db.WithContext(ctx).Model(&RemovedMention{}).Where("thread_id = ?", threadID).Pluck("user_id", &userIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get removed mentions of thread %d: %w", threadID, err)
+	}
+	removed := make(map[uint]bool, len(userIDs))
+	for _, id := range userIDs {
+		removed[id] = true
+	}
+	return removed, nil
+}
+
+// UpdateReplyRestriction changes who may reply to a thread and records the change. Existing replies stay.
+func (r *ThreadRepository) UpdateReplyRestriction(ctx context.Context, thread *Thread, actorID uint, restriction string) error {
+	if thread.ReplyRestriction == restriction {
+		return nil
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(thread).Update("reply_restriction", restriction).Error; err != nil {
+			return err
+		}
+		return tx.Create(&ModerationAction{
+			ThreadID: thread.ID,
+			ActorID:  actorID,
+			Action:   ModerationChangeReplyRestriction,
+			Detail:   thread.ReplyRestriction + " -> " + restriction,
+		}).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update reply restriction of thread %d: %w", thread.ID, err)
+	}
+	thread.ReplyRestriction = restriction
+	return nil
+}
+
+// UpdateSensitivity replaces a thread's sensitive flag and content warning and records the change.
+// It is a no-op if neither changes.
+func (r *ThreadRepository) UpdateSensitivity(ctx context.Context, thread *Thread, actorID uint, sensitive bool, contentWarning string) error {
+	if thread.IsSensitive == sensitive && thread.ContentWarning == contentWarning {
+		return nil
+	}
+	action := ModerationUnmarkSensitive
+	if sensitive || contentWarning != "" {
+		action = ModerationMarkSensitive
+	}
+	record := ModerationAction{ThreadID: thread.ID, ActorID: actorID, Action: action, Detail: contentWarning}
+	if actorID != thread.UserID {
+		record.TargetUserID = &thread.UserID
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		updates := map[string]interface{}{"is_sensitive": sensitive, "content_warning": contentWarning}
+		if err := tx.Model(thread).Updates(updates).Error; err != nil {
+			return err
+		}
+		return tx.Create(&record).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update sensitivity of thread %d: %w", thread.ID, err)
+	}
+	thread.IsSensitive = sensitive
+	thread.ContentWarning = contentWarning
+	return nil
+}
+
+// GetModerationActions returns a thread's audit trail, newest first.
+func (r *ThreadRepository) GetModerationActions(ctx context.Context, threadID uint, limit, offset int) ([]ModerationAction, error) {
+	var actions []ModerationAction
+	err := r.db.WithContext(ctx).
+		Where("thread_id = ?", threadID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).Offset(offset).
+		Find(&actions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get moderation actions of thread %d: %w", threadID, err)
+	}
+	return actions, nil
+}
@@ -0,0 +1,194 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Poll is attached to at most one thread. ClosedAt is set by the poll closer once the
+// closing event has been sent, so each poll is announced exactly once.
+type Poll struct {
+	ID        uint      `gorm:"primaryKey"`
+	ThreadID  uint      `gorm:"not null;uniqueIndex"`
+	ClosesAt  time.Time `gorm:"not null;index"`
+	ClosedAt  *time.Time
+	CreatedAt time.Time
+	Options   []PollOption `gorm:"foreignKey:PollID"`
+}
+
+// PollOption keeps its own vote count, updated in the same transaction as the vote.
+type PollOption struct {
+	ID        uint   `gorm:"primaryKey"`
+	PollID    uint   `gorm:"not null;index"`
+	Position  int    `gorm:"not null"`
+	Text      string `gorm:"type:varchar(50);not null"`
+	VoteCount int64  `gorm:"not null;default:0"`
+}
+
+type PollVote struct {
+	ID        uint      `gorm:"primaryKey"`
+	PollID    uint      `gorm:"not null;uniqueIndex:idx_poll_vote_user"`
+	UserID    uint      `gorm:"not null;uniqueIndex:idx_poll_vote_user"`
+	OptionID  uint      `gorm:"not null;index"`
+	CreatedAt time.Time `gorm:"default:current_timestamp"`
+}
+
+func (Poll) TableName() string       { return "polls" }
+func (PollOption) TableName() string { return "poll_options" }
+func (PollVote) TableName() string   { return "poll_votes" }
+
+// TotalVotes sums the option counts.
+func (p *Poll) TotalVotes() int64 {
+	var total int64
+	for _, o := range p.Options {
+		total += o.VoteCount
+	}
+	return total
+}
+
+func (r *ThreadRepository) CreatePoll(ctx context.Context, poll *Poll) error {
+	if err := r.db.WithContext(ctx).Create(poll).Error; err != nil {
+		return fmt.Errorf("failed to create poll for thread %d: %w", poll.ThreadID, err)
+	}
+	return nil
+}
+
+func preloadPollOptions(db *gorm.DB) *gorm.DB {
+	return db.Order("poll_options.position ASC")
+}
+
+func (r *ThreadRepository) GetPollByThreadID(ctx context.Context, threadID uint) (*Poll, error) {
+	var poll Poll
+	err := r.db.WithContext(ctx).Preload("Options", preloadPollOptions).Where("thread_id = ?", threadID).First(&poll).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("poll not found")
+		}
+		return nil, fmt.Errorf("failed to get poll for thread %d: %w", threadID, err)
+	}
+	return &poll, nil
+}
+
+// GetPollsForThreads returns the polls attached to any of threadIDs, keyed by thread ID.
+func (r *ThreadRepository) GetPollsForThreads(ctx context.Context, threadIDs []uint) (map[uint]Poll, error) {
+	pollsMap := make(map[uint]Poll)
+	if len(threadIDs) == 0 {
+		return pollsMap, nil
+	}
+	var polls []Poll
+	if err := r.db.WithContext(ctx).Preload("Options", preloadPollOptions).Where("thread_id IN ?", threadIDs).Find(&polls).Error; err != nil {
+		return nil, fmt.Errorf("failed to get polls for threads: %w", err)
+	}
+	for _, p := range polls {
+		pollsMap[p.ThreadID] = p
+	}
+	return pollsMap, nil
+}
+
+// GetUserPollVotes returns the option a user picked in each of pollIDs they voted in, keyed by poll ID.
+func (r *ThreadRepository) GetUserPollVotes(ctx context.Context, userID uint, pollIDs []uint) (map[uint]uint, error) {
+	votesMap := make(map[uint]uint)
+	if len(pollIDs) == 0 {
+		return votesMap, nil
+	}
+	var votes []PollVote
+	if err := r.db.WithContext(ctx).Where("user_id = ? AND poll_id IN ?", userID, pollIDs).Find(&votes).Error; err != nil {
+		return nil, fmt.Errorf("failed to get poll votes for user %d: %w", userID, err)
+	}
+	for _, v := range votes {
+		votesMap[v.PollID] = v.OptionID
+	}
+	return votesMap, nil
+}
+
+// AddPollVote records a user's single vote and bumps the option's count.
+func (r *ThreadRepository) AddPollVote(ctx context.Context, pollID, optionID, userID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&PollOption{}).
+			Where("id = ? AND poll_id = ?", optionID, pollID).
+			Update("vote_count", gorm.Expr("vote_count + 1"))
+		if result.Error != nil {
+			return fmt.Errorf("failed to count poll vote: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("poll option not found")
+		}
+
+		vote := PollVote{PollID: pollID, OptionID: optionID, UserID: userID}
+		if err := tx.Create(&vote).Error; err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return errors.New("already voted")
+			}
+			return fmt.Errorf("failed to add poll vote: %w", err)
+		}
+		return nil
+	})
+}
+
+// deletePollsForThreads removes the threads' polls along with their options and votes.
+func (r *ThreadRepository) deletePollsForThreads(ctx context.Context, threadIDs []uint) error {
+	var pollIDs []uint
+	if err := r.db.WithContext(ctx).Model(&Poll{}).Where("thread_id IN ?", threadIDs).Pluck("id", &pollIDs).Error; err != nil {
+		return fmt.Errorf("failed to find polls of threads %v: %w", threadIDs, err)
+	}
+	if len(pollIDs) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Where("poll_id IN ?", pollIDs).Delete(&PollVote{}).Error; err != nil {
+		return fmt.Errorf("failed to remove poll votes of threads %v: %w", threadIDs, err)
+	}
+	if err := r.db.WithContext(ctx).Where("poll_id IN ?", pollIDs).Delete(&PollOption{}).Error; err != nil {
+		return fmt.Errorf("failed to remove poll options of threads %v: %w", threadIDs, err)
+	}
+	if err := r.db.WithContext(ctx).Where("id IN ?", pollIDs).Delete(&Poll{}).Error; err != nil {
+		return fmt.Errorf("failed to remove polls of threads %v: %w", threadIDs, err)
+	}
+	return nil
+}
+
+// ClaimClosedPolls marks up to batchSize polls whose closing time has passed as closed and returns them
+// with their options. Locked rows are skipped so concurrent closers never claim the same poll.
+func (r *ThreadRepository) ClaimClosedPolls(ctx context.Context, now time.Time, batchSize int) ([]Poll, error) {
+	var polls []Poll
+	dueIDs := r.db.Model(&Poll{}).
+		Select("id").
+		Where("closes_at <= ? AND closed_at IS NULL", now).
+		Order("closes_at ASC").
+		Limit(batchSize).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+
+	result := r.db.WithContext(ctx).Model(&polls).
+		Clauses(clause.Returning{}).
+		Where("id IN (?)", dueIDs).
+		Update("closed_at", now)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to claim closed polls: %w", result.Error)
+	}
+	if len(polls) == 0 {
+		return polls, nil
+	}
+
+	pollIDs := make([]uint, len(polls))
+	for i, p := range polls {
+		pollIDs[i] = p.ID
+	}
+	var options []PollOption
+	if err := r.db.WithContext(ctx).Where("poll_id IN ?", pollIDs).Order("position ASC").Find(&options).Error; err != nil {
+		return nil, fmt.Errorf("failed to load options of closed polls: %w", err)
+	}
+	byPoll := make(map[uint][]PollOption)
+	for _, o := range options {
+		byPoll[o.PollID] = append(byPoll[o.PollID], o)
+	}
+	for i := range polls {
+		polls[i].Options = byPoll[polls[i].ID]
+	}
+	return polls, nil
+}
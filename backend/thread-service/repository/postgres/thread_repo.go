@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -21,18 +22,31 @@ type Thread struct {
     UserID           uint           `gorm:"not null;index"`
     Content          string         `gorm:"type:text"`      
     ParentThreadID   *uint          `gorm:"index"`          
+    QuotedThreadID   *uint          `gorm:"index"`
     ReplyRestriction string         `gorm:"type:varchar(20);default:'everyone';not null"` // everyone, following, verified
     ScheduledAt      *time.Time     
-    PostedAt         time.Time      `gorm:"not null;default:current_timestamp"`
+    PostedAt         time.Time      `gorm:"not null;default:current_timestamp;index"`
+    Status           string         `gorm:"type:varchar(20);default:'published';not null;index"` // scheduled, published
     CommunityID      *uint          `gorm:"index"` 
     IsAdvertisement  bool           `gorm:"default:false;not null"`
     MediaIDs         pq.Int64Array  `gorm:"type:bigint[]"`
-	Categories		 pq.StringArray `gorm:"type:text[]"`
+	Categories		 pq.StringArray `gorm:"type:text[];index:idx_threads_categories,type:gin"`
+    EditedAt         *time.Time
+    LinkPreviewURL   *string        `gorm:"type:varchar(2048)"` // normalized URL of the link_previews row shown on the thread
+    HiddenAt         *time.Time     // set when the parent thread's author hid this reply
+    ContentWarning   string         `gorm:"type:varchar(100);default:'';not null"`
+    IsSensitive      bool           `gorm:"default:false;not null"` // set by the author or a moderator
+    SideEffectsPendingAt *time.Time `gorm:"index"` // last attempt at a published scheduled thread's side effects; cleared once they ran
     CreatedAt        time.Time
     UpdatedAt        time.Time
     DeletedAt        gorm.DeletedAt `gorm:"index"`
 }
 
+const (
+	ThreadStatusScheduled = "scheduled"
+	ThreadStatusPublished = "published"
+)
+
 type ThreadInteraction struct {
     ID              uint      `gorm:"primaryKey"`
     UserID          uint      `gorm:"not null;uniqueIndex:idx_user_thread_interaction"`
@@ -41,9 +55,34 @@ type ThreadInteraction struct {
     CreatedAt       time.Time `gorm:"default:current_timestamp"`
 }
 
+// FeedThread is a thread as it appears in a timeline. RepostedByUserID is set when the
+// thread was surfaced by a followed user's repost rather than written by them.
+type FeedThread struct {
+	Thread           `gorm:"embedded"`
+	RepostedByUserID *uint
+	RepostedAt       *time.Time
+	ActivityAt       time.Time
+}
+
+// PageCursor is a keyset position: the sort timestamp and ID of the last row already returned.
+// List queries return rows strictly after it in their own sort order.
+type PageCursor struct {
+	At time.Time
+	ID uint
+}
+
+// InteractedThread is a thread listed because of a user's interaction with it (likes, bookmarks),
+// carrying the interaction's position for keyset pagination.
+type InteractedThread struct {
+	Thread        `gorm:"embedded"`
+	InteractionID uint
+	InteractedAt  time.Time
+}
+
 type GetThreadsParams struct {
 	Limit                   int
 	Offset                  int
+	After                   *PageCursor // takes precedence over Offset
 	ByUserID                *uint
 	ForUsername             string
 	FeedTabType             string // "posts", "replies", "media", "likes"
@@ -70,10 +109,20 @@ type Mention struct {
 	CreatedAt time.Time `gorm:"default:current_timestamp"`
 }
 
+// ThreadRevision keeps a previous version of an edited thread.
+type ThreadRevision struct {
+	ID        uint          `gorm:"primaryKey"`
+	ThreadID  uint          `gorm:"not null;index"`
+	Content   string        `gorm:"type:text"`
+	MediaIDs  pq.Int64Array `gorm:"type:bigint[]"`
+	CreatedAt time.Time     `gorm:"default:current_timestamp"` // when this version was replaced
+}
+
 func (Thread) TableName() string { return "threads" }
 func (ThreadInteraction) TableName() string { return "thread_interactions" }
 func (Hashtag) TableName() string { return "hashtags" }
 func (Mention) TableName() string { return "mentions" }
+func (ThreadRevision) TableName() string { return "thread_revisions" }
 
 type ThreadRepository struct { db *gorm.DB }
 
@@ -82,7 +131,7 @@ func NewThreadRepository() (*ThreadRepository, error) {
      if dsn == "" { log.Fatalln("DATABASE_URL not set for thread service") }
      db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
      if err != nil { return nil, fmt.Errorf("failed to connect thread database: %w", err) }
-     if err := db.AutoMigrate(&Thread{}, &ThreadInteraction{}, &Hashtag{}, &Mention{}); err != nil {
+     if err := db.AutoMigrate(&Thread{}, &ThreadInteraction{}, &Hashtag{}, &Mention{}, &ThreadRevision{}, &ThreadStats{}, &Poll{}, &PollOption{}, &PollVote{}, &AdCampaign{}, &AdImpression{}, &AdClick{}, &LinkPreview{}, &ModerationAction{}, &RemovedMention{}, &ThreadViewEvent{}, &ThreadAnalyticsHour{}, &ThreadDraft{}); err != nil {
          return nil, fmt.Errorf("failed to migrate thread database: %w", err)
      }
      return &ThreadRepository{db: db}, nil
@@ -97,16 +146,24 @@ func NewThreadRepositoryWithTx(tx *gorm.DB) *ThreadRepository {
 }
 
 func (r *ThreadRepository) CreateThread(ctx context.Context, thread *Thread) error {
-    if thread.ScheduledAt == nil {
-        thread.PostedAt = time.Now().UTC()
+    now := time.Now().UTC()
+    if thread.ScheduledAt == nil || !thread.ScheduledAt.After(now) {
+        thread.PostedAt = now
+        thread.Status = ThreadStatusPublished
     } else {
+        // Stays hidden until the scheduler publishes it
         thread.PostedAt = *thread.ScheduledAt
+        thread.Status = ThreadStatusScheduled
     }
-     result := r.db.WithContext(ctx).Create(thread)
-     if result.Error != nil {
-         return fmt.Errorf("failed to create thread: %w", result.Error)
-     }
-     return nil
+     return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+         if err := tx.Create(thread).Error; err != nil {
+             return fmt.Errorf("failed to create thread: %w", err)
+         }
+         if thread.Status == ThreadStatusPublished {
+             return NewThreadRepositoryWithTx(tx).adjustParentStats(ctx, thread, 1)
+         }
+         return nil
+     })
 }
 
 func (r *ThreadRepository) GetThreadByID(ctx context.Context, id uint) (*Thread, error) {
@@ -125,32 +182,42 @@ func (r *ThreadRepository) AddInteraction(ctx context.Context, userID, threadID
         ThreadID:        threadID,
         InteractionType: interactionType,
     }
-    result := r.db.WithContext(ctx).Create(&interaction)
-    if result.Error != nil {
-         var pgErr *pgconn.PgError
-         if errors.As(result.Error, &pgErr) && pgErr.Code == "23505" {
-             log.Printf("Interaction already exists: user %d, thread %d, type %s", userID, threadID, interactionType)
-             return errors.New("interaction already exists")
+    return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        result := tx.Create(&interaction)
+        if result.Error != nil {
+             var pgErr *pgconn.PgError
+             if errors.As(result.Error, &pgErr) && pgErr.Code == "23505" {
+                 log.Printf("Interaction already exists: user %d, thread %d, type %s", userID, threadID, interactionType)
+                 return errors.New("interaction already exists")
+             }
+              if errors.As(result.Error, &pgErr) && pgErr.Code == "23503" {
+                    log.Printf("Foreign key violation on interaction: user %d, thread %d", userID, threadID)
+                 return errors.New("user or thread not found for interaction")
+              }
+             return fmt.Errorf("failed to add interaction: %w", result.Error)
          }
-          if errors.As(result.Error, &pgErr) && pgErr.Code == "23503" {
-                log.Printf("Foreign key violation on interaction: user %d, thread %d", userID, threadID)
-             return errors.New("user or thread not found for interaction")
-          }
-         return fmt.Errorf("failed to add interaction: %w", result.Error)
-     }
-     return nil
+        if column, ok := interactionStatColumns[interactionType]; ok {
+            return NewThreadRepositoryWithTx(tx).adjustStat(ctx, threadID, column, 1)
+        }
+        return nil
+    })
 }
 
 func (r *ThreadRepository) RemoveInteraction(ctx context.Context, userID, threadID uint, interactionType string) error {
-    result := r.db.WithContext(ctx).Where("user_id = ? AND thread_id = ? AND interaction_type = ?", userID, threadID, interactionType).Delete(&ThreadInteraction{})
-    if result.Error != nil {
-        return fmt.Errorf("failed to remove interaction: %w", result.Error)
-    }
-    if result.RowsAffected == 0 {
-         log.Printf("No interaction found to remove: user %d, thread %d, type %s", userID, threadID, interactionType)
-        return errors.New("interaction not found")
-    }
-    return nil
+    return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+        result := tx.Where("user_id = ? AND thread_id = ? AND interaction_type = ?", userID, threadID, interactionType).Delete(&ThreadInteraction{})
+        if result.Error != nil {
+            return fmt.Errorf("failed to remove interaction: %w", result.Error)
+        }
+        if result.RowsAffected == 0 {
+             log.Printf("No interaction found to remove: user %d, thread %d, type %s", userID, threadID, interactionType)
+            return errors.New("interaction not found")
+        }
+        if column, ok := interactionStatColumns[interactionType]; ok {
+            return NewThreadRepositoryWithTx(tx).adjustStat(ctx, threadID, column, -1)
+        }
+        return nil
+    })
 }
 
  func (r *ThreadRepository) CheckHealth(ctx context.Context) error {
@@ -161,23 +228,94 @@ func (r *ThreadRepository) RemoveInteraction(ctx context.Context, userID, thread
      return nil
  }
 
-func (r *ThreadRepository) PerformSoftDelete(ctx context.Context, threadID uint) error {
-	result := r.db.WithContext(ctx).Delete(&Thread{}, threadID)
-	if result.Error != nil {
-		return fmt.Errorf("failed to soft delete thread %d: %w", threadID, result.Error)
+// ThreadDeletion describes a thread removed by PerformSoftDelete, for the thread.deleted event.
+type ThreadDeletion struct {
+	Thread   Thread
+	Hashtags []string // the thread's hashtags before they were removed
+}
+
+// PerformSoftDelete soft deletes a thread and, in the same transaction, removes its hashtags and mentions
+// so it drops out of hashtag pages and mention lists. Interactions, its poll and its counters are kept until
+// PurgeDeletedThreads so RestoreThread can bring them back. Replies render with a placeholder for the missing parent.
+func (r *ThreadRepository) PerformSoftDelete(ctx context.Context, threadID uint) (*ThreadDeletion, error) {
+	deletion := &ThreadDeletion{}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		thread := &deletion.Thread
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(thread, threadID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) { return errors.New("thread not found") }
+			return fmt.Errorf("failed to load thread %d for deletion: %w", threadID, err)
+		}
+		result := tx.Delete(&Thread{}, threadID)
+		if result.Error != nil {
+			return fmt.Errorf("failed to soft delete thread %d: %w", threadID, result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("thread not found")
+		}
+		if err := tx.Unscoped().Select("deleted_at").First(thread, threadID).Error; err != nil {
+			return fmt.Errorf("failed to read deletion time of thread %d: %w", threadID, err)
+		}
+
+		err := tx.Model(&Hashtag{}).Where("thread_id = ?", threadID).Pluck("tag_name", &deletion.Hashtags).Error
+		if err != nil {
+			return fmt.Errorf("failed to get hashtags of deleted thread %d: %w", threadID, err)
+		}
+		if err := tx.Where("thread_id = ?", threadID).Delete(&Hashtag{}).Error; err != nil {
+			return fmt.Errorf("failed to remove hashtags of deleted thread %d: %w", threadID, err)
+		}
+		if err := tx.Where("thread_id = ?", threadID).Delete(&Mention{}).Error; err != nil {
+			return fmt.Errorf("failed to remove mentions of deleted thread %d: %w", threadID, err)
+		}
+
+		// Scheduled threads were never counted on their parent
+		if thread.Status == ThreadStatusPublished {
+			return NewThreadRepositoryWithTx(tx).adjustParentStats(ctx, thread, -1)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	if result.RowsAffected == 0 {
-		return errors.New("thread not found")
+	return deletion, nil
+}
+
+// GetDeletedThreadIDs returns which of ids belong to soft-deleted or purged threads.
+func (r *ThreadRepository) GetDeletedThreadIDs(ctx context.Context, ids []uint) (map[uint]bool, error) {
+	deleted := make(map[uint]bool)
+	if len(ids) == 0 {
+		return deleted, nil
 	}
-	return nil
+	var liveIDs []uint
+	err := r.db.WithContext(ctx).Model(&Thread{}).
+		Where("id IN ?", ids).
+		Pluck("id", &liveIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for deleted threads: %w", err)
+	}
+	live := make(map[uint]bool, len(liveIDs))
+	for _, id := range liveIDs {
+		live[id] = true
+	}
+	for _, id := range ids {
+		if !live[id] {
+			deleted[id] = true
+		}
+	}
+	return deleted, nil
 }
 
 func (r *ThreadRepository) GetThreads(ctx context.Context, params GetThreadsParams) ([]Thread, error) {
 	var threads []Thread
 	query := r.db.WithContext(ctx).
+		Where("threads.status = ?", ThreadStatusPublished).
 		Order("posted_at DESC, id DESC").
-		Limit(params.Limit).
-		Offset(params.Offset)
+		Limit(params.Limit)
+
+	if params.After != nil {
+		query = query.Where("(threads.posted_at, threads.id) < (?, ?)", params.After.At, params.After.ID)
+	} else {
+		query = query.Offset(params.Offset)
+	}
 
 	if params.ForCommunityID != nil && *params.ForCommunityID != 0 {
 		query = query.Where("threads.community_id = ?", *params.ForCommunityID)
@@ -215,60 +353,6 @@ func (r *ThreadRepository) GetThreads(ctx context.Context, params GetThreadsPara
 	return threads, nil
 }
 
-func (r *ThreadRepository) GetInteractionCountsForThread(ctx context.Context, threadID uint) (likeCount int64, bookmarkCount int64, err error) {
-	var counts []struct {
-		InteractionType string
-		Count           int64
-	}
-	result := r.db.WithContext(ctx).Model(&ThreadInteraction{}).
-		Select("interaction_type, count(*) as count").
-		Where("thread_id = ?", threadID).
-		Group("interaction_type").
-		Find(&counts)
-
-	if result.Error != nil {
-		return 0, 0, fmt.Errorf("failed to get interaction counts for thread %d: %w", threadID, result.Error)
-	}
-
-	for _, c := range counts {
-		if c.InteractionType == "like" {
-			likeCount = c.Count
-		} else if c.InteractionType == "bookmark" {
-			bookmarkCount = c.Count
-		}
-	}
-	return likeCount, bookmarkCount, nil
-}
-
-func (r *ThreadRepository) GetInteractionCountsForMultipleThreads(ctx context.Context, threadIDs []uint) (map[uint]map[string]int64, error) {
-	if len(threadIDs) == 0 {
-		return make(map[uint]map[string]int64), nil
-	}
-	var results []struct {
-		ThreadID        uint
-		InteractionType string
-		Count           int64
-	}
-	err := r.db.WithContext(ctx).Model(&ThreadInteraction{}).
-		Select("thread_id, interaction_type, count(*) as count").
-		Where("thread_id IN ?", threadIDs).
-		Group("thread_id, interaction_type").
-		Find(&results).Error
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to get interaction counts for multiple threads: %w", err)
-	}
-
-	countsMap := make(map[uint]map[string]int64)
-	for _, res := range results {
-		if _, ok := countsMap[res.ThreadID]; !ok {
-			countsMap[res.ThreadID] = make(map[string]int64)
-		}
-		countsMap[res.ThreadID][res.InteractionType] = res.Count
-	}
-	return countsMap, nil
-}
-
 func (r *ThreadRepository) CheckUserInteraction(ctx context.Context, userID, threadID uint, interactionType string) (bool, error) {
 	var count int64
 	result := r.db.WithContext(ctx).Model(&ThreadInteraction{}).
@@ -304,20 +388,31 @@ func (r *ThreadRepository) CheckUserInteractionsForMultipleThreads(ctx context.C
 	return userInteractionsMap, nil
 }
 
-func (r *ThreadRepository) GetLikedThreadsByUser(ctx context.Context, userID uint, limit, offset int) ([]Thread, error) {
-	var threads []Thread
-	result := r.db.WithContext(ctx).
+func (r *ThreadRepository) GetLikedThreadsByUser(ctx context.Context, userID uint, limit, offset int, after *PageCursor) ([]InteractedThread, error) {
+	return r.getInteractedThreads(ctx, userID, "like", limit, offset, after)
+}
+
+// getInteractedThreads lists threads a user interacted with, most recent interaction first.
+func (r *ThreadRepository) getInteractedThreads(ctx context.Context, userID uint, interactionType string, limit, offset int, after *PageCursor) ([]InteractedThread, error) {
+	var items []InteractedThread
+	query := r.db.WithContext(ctx).Model(&Thread{}).
+		Select("threads.*, thread_interactions.id AS interaction_id, thread_interactions.created_at AS interacted_at").
 		Joins("JOIN thread_interactions ON thread_interactions.thread_id = threads.id").
-		Where("thread_interactions.user_id = ? AND thread_interactions.interaction_type = ?", userID, "like").
-		Order("thread_interactions.created_at DESC").
-		Limit(limit).
-		Offset(offset).
-		Find(&threads)
+		Where("thread_interactions.user_id = ? AND thread_interactions.interaction_type = ?", userID, interactionType).
+		Where("threads.status = ? AND threads.deleted_at IS NULL", ThreadStatusPublished).
+		Order("thread_interactions.created_at DESC, thread_interactions.id DESC").
+		Limit(limit)
+
+	if after != nil {
+		query = query.Where("(thread_interactions.created_at, thread_interactions.id) < (?, ?)", after.At, after.ID)
+	} else {
+		query = query.Offset(offset)
+	}
 
-	if result.Error != nil {
-		return nil, fmt.Errorf("failed to get liked threads for user %d: %w", userID, result.Error)
+	if err := query.Scan(&items).Error; err != nil {
+		return nil, fmt.Errorf("failed to get %s threads for user %d: %w", interactionType, userID, err)
 	}
-	return threads, nil
+	return items, nil
 }
 
 func (r *ThreadRepository) AddHashtags(ctx context.Context, threadID uint, tags []string) error {
@@ -353,29 +448,24 @@ func (r *ThreadRepository) AddMentions(ctx context.Context, threadID uint, menti
     return nil
 }
 
-func (r *ThreadRepository) GetBookmarkedThreadsByUser(ctx context.Context, userID uint, limit, offset int) ([]Thread, error) {
-	var threads []Thread
-	result := r.db.WithContext(ctx).
-		Joins("JOIN thread_interactions ON thread_interactions.thread_id = threads.id").
-		Where("thread_interactions.user_id = ? AND thread_interactions.interaction_type = ?", userID, "bookmark").
-		Order("thread_interactions.created_at DESC").
-		Limit(limit).
-		Offset(offset).
-		Find(&threads)
-
-	if result.Error != nil {
-		return nil, fmt.Errorf("failed to get bookmarked threads for user %d: %w", userID, result.Error)
-	}
-	return threads, nil
+func (r *ThreadRepository) GetBookmarkedThreadsByUser(ctx context.Context, userID uint, limit, offset int, after *PageCursor) ([]InteractedThread, error) {
+	return r.getInteractedThreads(ctx, userID, "bookmark", limit, offset, after)
 }
 
-func (r *ThreadRepository) GetRepliesForThread(ctx context.Context, parentThreadID uint, limit, offset int, excludeUserIDs []uint) ([]Thread, error) {
+func (r *ThreadRepository) GetRepliesForThread(ctx context.Context, parentThreadID uint, limit, offset int, after *PageCursor, excludeUserIDs []uint) ([]Thread, error) {
 	var threads []Thread
 	query := r.db.WithContext(ctx).
 		Where("threads.parent_thread_id = ?", parentThreadID). // Key filter
+		Where("threads.status = ?", ThreadStatusPublished).
+		Where("threads.hidden_at IS NULL"). // hidden replies are listed by GetHiddenReplies
 		Order("threads.posted_at ASC, threads.id ASC").          // Replies usually shown oldest to newest
-		Limit(limit). // Use limit from arguments
-		Offset(offset) // Use offset from arguments
+		Limit(limit) // Use limit from arguments
+
+	if after != nil {
+		query = query.Where("(threads.posted_at, threads.id) > (?, ?)", after.At, after.ID)
+	} else {
+		query = query.Offset(offset)
+	}
 
     // Apply block exclusions
     if len(excludeUserIDs) > 0 {
@@ -388,3 +478,537 @@ func (r *ThreadRepository) GetRepliesForThread(ctx context.Context, parentThread
 	}
 	return threads, nil
 }
+
+
+// --- Scheduled Threads ---
+
+func (r *ThreadRepository) GetScheduledThreadsByUser(ctx context.Context, userID uint, limit, offset int, after *PageCursor) ([]Thread, error) {
+	var threads []Thread
+	query := r.db.WithContext(ctx).
+		Where("user_id = ? AND status = ?", userID, ThreadStatusScheduled).
+		Order("scheduled_at ASC, id ASC").
+		Limit(limit)
+
+	if after != nil {
+		query = query.Where("(scheduled_at, id) > (?, ?)", after.At, after.ID)
+	} else {
+		query = query.Offset(offset)
+	}
+
+	result := query.Find(&threads)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get scheduled threads for user %d: %w", userID, result.Error)
+	}
+	return threads, nil
+}
+
+func (r *ThreadRepository) RescheduleThread(ctx context.Context, threadID uint, scheduledAt time.Time) (*Thread, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var thread Thread
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND status = ?", threadID, ThreadStatusScheduled).
+			First(&thread).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) { return errors.New("thread not found") }
+			return fmt.Errorf("failed to load thread %d for rescheduling: %w", threadID, err)
+		}
+		result := tx.Model(&Thread{}).
+			Where("id = ?", threadID).
+			Updates(map[string]interface{}{"scheduled_at": scheduledAt, "posted_at": scheduledAt})
+		if result.Error != nil {
+			return fmt.Errorf("failed to reschedule thread %d: %w", threadID, result.Error)
+		}
+
+		// A poll keeps the same duration relative to when the thread goes live
+		var poll Poll
+		err = tx.Where("thread_id = ?", threadID).First(&poll).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to load poll of thread %d: %w", threadID, err)
+		}
+		closesAt := poll.ClosesAt.Add(scheduledAt.Sub(thread.PostedAt))
+		if err := tx.Model(&poll).Update("closes_at", closesAt).Error; err != nil {
+			return fmt.Errorf("failed to move poll closing time of thread %d: %w", threadID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetThreadByID(ctx, threadID)
+}
+
+// ClaimDueScheduledThreads flips due scheduled threads to published and returns them, marked as having
+// side effects pending until MarkSideEffectsDone. Rows locked by another instance are skipped so each
+// thread is claimed exactly once.
+func (r *ThreadRepository) ClaimDueScheduledThreads(ctx context.Context, now time.Time, batchSize int) ([]Thread, error) {
+	var threads []Thread
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		dueIDs := tx.Model(&Thread{}).
+			Select("id").
+			Where("status = ? AND scheduled_at <= ?", ThreadStatusScheduled, now).
+			Order("scheduled_at ASC, id ASC"). // a scheduled chain goes live parent first even across batches
+			Limit(batchSize).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+
+		result := tx.Model(&threads).
+			Clauses(clause.Returning{}).
+			Where("id IN (?)", dueIDs).
+			Updates(map[string]interface{}{"status": ThreadStatusPublished, "side_effects_pending_at": now})
+		if result.Error != nil {
+			return fmt.Errorf("failed to claim due scheduled threads: %w", result.Error)
+		}
+		sortByScheduledAt(threads)
+
+		// Replies and quotes only count towards their parent once they are visible
+		txRepo := NewThreadRepositoryWithTx(tx)
+		for i := range threads {
+			if err := txRepo.adjustParentStats(ctx, &threads[i], 1); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return threads, nil
+}
+
+// ClaimPendingSideEffects returns published threads whose side effects haven't run and weren't attempted
+// since retryAfter ago, and records now as their latest attempt so other instances leave them alone.
+func (r *ThreadRepository) ClaimPendingSideEffects(ctx context.Context, now time.Time, retryAfter time.Duration, batchSize int) ([]Thread, error) {
+	var threads []Thread
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		pendingIDs := tx.Model(&Thread{}).
+			Select("id").
+			Where("status = ? AND side_effects_pending_at <= ?", ThreadStatusPublished, now.Add(-retryAfter)).
+			Order("scheduled_at ASC, id ASC").
+			Limit(batchSize).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+
+		result := tx.Model(&threads).
+			Clauses(clause.Returning{}).
+			Where("id IN (?)", pendingIDs).
+			Update("side_effects_pending_at", now)
+		if result.Error != nil {
+			return fmt.Errorf("failed to claim threads with pending side effects: %w", result.Error)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortByScheduledAt(threads)
+	return threads, nil
+}
+
+// MarkSideEffectsDone records that a published scheduled thread's side effects have run.
+func (r *ThreadRepository) MarkSideEffectsDone(ctx context.Context, threadID uint) error {
+	err := r.db.WithContext(ctx).Model(&Thread{}).
+		Where("id = ?", threadID).
+		Update("side_effects_pending_at", nil).Error
+	if err != nil {
+		return fmt.Errorf("failed to mark side effects of thread %d as done: %w", threadID, err)
+	}
+	return nil
+}
+
+// sortByScheduledAt puts claimed threads back in (scheduled_at, id) order, which RETURNING doesn't keep,
+// so chains are published parent first.
+func sortByScheduledAt(threads []Thread) {
+	sort.Slice(threads, func(i, j int) bool {
+		a, b := threads[i].ScheduledAt, threads[j].ScheduledAt
+		if a != nil && b != nil && !a.Equal(*b) {
+			return a.Before(*b)
+		}
+		return threads[i].ID < threads[j].ID
+	})
+}
+
+func (r *ThreadRepository) GetMentionedUserIDs(ctx context.Context, threadID uint) ([]uint, error) {
+	var userIDs []uint
+	err := r.db.WithContext(ctx).Model(&Mention{}).
+		Where("thread_id = ?", threadID).
+		Pluck("mentioned_user_id", &userIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get mentions for thread %d: %w", threadID, err)
+	}
+	return userIDs, nil
+}
+
+// GetMentionedUserIDsForThreads returns the users mentioned in each of threadIDs, keyed by thread ID.
+func (r *ThreadRepository) GetMentionedUserIDsForThreads(ctx context.Context, threadIDs []uint) (map[uint][]uint, error) {
+	mentionsMap := make(map[uint][]uint)
+	if len(threadIDs) == 0 {
+		return mentionsMap, nil
+	}
+	var mentions []Mention
+	if err := r.db.WithContext(ctx).Where("thread_id IN ?", threadIDs).Find(&mentions).Error; err != nil {
+		return nil, fmt.Errorf("failed to get mentions for threads: %w", err)
+	}
+	for _, m := range mentions {
+		mentionsMap[m.ThreadID] = append(mentionsMap[m.ThreadID], m.MentionedUserID)
+	}
+	return mentionsMap, nil
+}
+
+// GetThreadsMentioningUser returns the published threads that mention userID, newest first.
+func (r *ThreadRepository) GetThreadsMentioningUser(ctx context.Context, userID uint, limit, offset int, after *PageCursor, excludeUserIDs []uint) ([]Thread, error) {
+	var threads []Thread
+	query := r.db.WithContext(ctx).
+		Joins("JOIN mentions ON mentions.thread_id = threads.id AND mentions.mentioned_user_id = ?", userID).
+		Where("threads.status = ?", ThreadStatusPublished).
+		Order("threads.posted_at DESC, threads.id DESC").
+		Limit(limit)
+
+	if after != nil {
+		query = query.Where("(threads.posted_at, threads.id) < (?, ?)", after.At, after.ID)
+	} else {
+		query = query.Offset(offset)
+	}
+	if len(excludeUserIDs) > 0 {
+		query = query.Where("threads.user_id NOT IN ?", excludeUserIDs)
+	}
+
+	if err := query.Find(&threads).Error; err != nil {
+		return nil, fmt.Errorf("failed to get threads mentioning user %d: %w", userID, err)
+	}
+	return threads, nil
+}
+
+
+// --- Reposts and Quotes ---
+
+// GetFollowingFeed returns threads written or reposted by the given users, newest activity first.
+// A thread that appears more than once (e.g. posted and later reposted) is only returned for its latest activity.
+func (r *ThreadRepository) GetFollowingFeed(ctx context.Context, followedUserIDs []uint, excludeUserIDs []uint, limit, offset int, after *PageCursor) ([]FeedThread, error) {
+	var items []FeedThread
+	if len(followedUserIDs) == 0 {
+		return items, nil
+	}
+
+	exclusion := ""
+	args := map[string]interface{}{
+		"status":   ThreadStatusPublished,
+		"followed": followedUserIDs,
+		"limit":    limit,
+		"offset":   offset,
+	}
+	if len(excludeUserIDs) > 0 {
+		exclusion = " AND threads.user_id NOT IN @excluded"
+		args["excluded"] = excludeUserIDs
+	}
+	position := ""
+	if after != nil {
+		position = "WHERE (latest.activity_at, latest.id) < (@after_at, @after_id)"
+		args["after_at"] = after.At
+		args["after_id"] = after.ID
+		args["offset"] = 0
+	}
+
+	query := `
+		SELECT * FROM (
+			SELECT DISTINCT ON (feed.id) feed.* FROM (
+				SELECT threads.*, NULL::bigint AS reposted_by_user_id, NULL::timestamptz AS reposted_at, threads.posted_at AS activity_at
+				FROM threads
+				WHERE threads.deleted_at IS NULL AND threads.status = @status AND threads.user_id IN @followed` + exclusion + `
+				UNION ALL
+				SELECT threads.*, ti.user_id, ti.created_at, ti.created_at
+				FROM thread_interactions ti
+				JOIN threads ON threads.id = ti.thread_id
+				WHERE ti.interaction_type = 'repost' AND ti.user_id IN @followed
+					AND threads.deleted_at IS NULL AND threads.status = @status` + exclusion + `
+			) feed
+			ORDER BY feed.id, feed.activity_at DESC
+		) latest
+		` + position + `
+		ORDER BY latest.activity_at DESC, latest.id DESC
+		LIMIT @limit OFFSET @offset`
+
+	if err := r.db.WithContext(ctx).Raw(query, args).Scan(&items).Error; err != nil {
+		return nil, fmt.Errorf("failed to get following feed: %w", err)
+	}
+	return items, nil
+}
+
+// GetThreadsByIDs returns the published threads among ids, keyed by ID.
+func (r *ThreadRepository) GetThreadsByIDs(ctx context.Context, ids []uint) (map[uint]Thread, error) {
+	threadsMap := make(map[uint]Thread)
+	if len(ids) == 0 {
+		return threadsMap, nil
+	}
+	var threads []Thread
+	err := r.db.WithContext(ctx).
+		Where("id IN ? AND status = ?", ids, ThreadStatusPublished).
+		Find(&threads).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get threads by ids: %w", err)
+	}
+	for _, t := range threads {
+		threadsMap[t.ID] = t
+	}
+	return threadsMap, nil
+}
+
+func (r *ThreadRepository) GetQuotesForThread(ctx context.Context, quotedThreadID uint, limit, offset int, after *PageCursor, excludeUserIDs []uint) ([]Thread, error) {
+	var threads []Thread
+	query := r.db.WithContext(ctx).
+		Where("threads.quoted_thread_id = ?", quotedThreadID).
+		Where("threads.status = ?", ThreadStatusPublished).
+		Order("threads.posted_at DESC, threads.id DESC").
+		Limit(limit)
+
+	if after != nil {
+		query = query.Where("(threads.posted_at, threads.id) < (?, ?)", after.At, after.ID)
+	} else {
+		query = query.Offset(offset)
+	}
+
+	if len(excludeUserIDs) > 0 {
+		query = query.Where("threads.user_id NOT IN ?", excludeUserIDs)
+	}
+
+	if err := query.Find(&threads).Error; err != nil {
+		return nil, fmt.Errorf("failed to get quotes for thread %d: %w", quotedThreadID, err)
+	}
+	return threads, nil
+}
+
+
+// --- Editing ---
+
+// GetThreadByIDForUpdate loads a thread and locks its row until the surrounding transaction ends.
+func (r *ThreadRepository) GetThreadByIDForUpdate(ctx context.Context, id uint) (*Thread, error) {
+	var thread Thread
+	result := r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&thread, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) { return nil, errors.New("thread not found") }
+		return nil, fmt.Errorf("failed to get thread %d for update: %w", id, result.Error)
+	}
+	return &thread, nil
+}
+
+// UpdateThreadContent replaces a thread's content and media, saving the previous version as a revision.
+// Pass a nil editedAt to skip the revision (e.g. for threads that were never visible).
+func (r *ThreadRepository) UpdateThreadContent(ctx context.Context, thread *Thread, content string, mediaIDs pq.Int64Array, linkPreviewURL *string, editedAt *time.Time) error {
+	if editedAt != nil {
+		revision := ThreadRevision{ThreadID: thread.ID, Content: thread.Content, MediaIDs: thread.MediaIDs, CreatedAt: *editedAt}
+		if err := r.db.WithContext(ctx).Create(&revision).Error; err != nil {
+			return fmt.Errorf("failed to save revision for thread %d: %w", thread.ID, err)
+		}
+	}
+
+	updates := map[string]interface{}{"content": content, "media_ids": mediaIDs, "link_preview_url": linkPreviewURL}
+	if editedAt != nil {
+		updates["edited_at"] = *editedAt
+	}
+	if err := r.db.WithContext(ctx).Model(thread).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to update thread %d: %w", thread.ID, err)
+	}
+	thread.Content = content
+	thread.MediaIDs = mediaIDs
+	thread.LinkPreviewURL = linkPreviewURL
+	if editedAt != nil {
+		thread.EditedAt = editedAt
+	}
+	return nil
+}
+
+func (r *ThreadRepository) GetThreadRevisions(ctx context.Context, threadID uint) ([]ThreadRevision, error) {
+	var revisions []ThreadRevision
+	err := r.db.WithContext(ctx).
+		Where("thread_id = ?", threadID).
+		Order("created_at DESC, id DESC").
+		Find(&revisions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revisions for thread %d: %w", threadID, err)
+	}
+	return revisions, nil
+}
+
+func (r *ThreadRepository) GetHashtagsForThread(ctx context.Context, threadID uint) ([]string, error) {
+	var tags []string
+	err := r.db.WithContext(ctx).Model(&Hashtag{}).
+		Where("thread_id = ?", threadID).
+		Pluck("tag_name", &tags).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hashtags for thread %d: %w", threadID, err)
+	}
+	return tags, nil
+}
+
+func (r *ThreadRepository) RemoveHashtags(ctx context.Context, threadID uint, tags []string) error {
+	if len(tags) == 0 { return nil }
+	err := r.db.WithContext(ctx).
+		Where("thread_id = ? AND tag_name IN ?", threadID, tags).
+		Delete(&Hashtag{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to remove hashtags from thread %d: %w", threadID, err)
+	}
+	return nil
+}
+
+func (r *ThreadRepository) RemoveMentions(ctx context.Context, threadID uint, mentionedUserIDs []uint) error {
+	if len(mentionedUserIDs) == 0 { return nil }
+	err := r.db.WithContext(ctx).
+		Where("thread_id = ? AND mentioned_user_id IN ?", threadID, mentionedUserIDs).
+		Delete(&Mention{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to remove mentions from thread %d: %w", threadID, err)
+	}
+	return nil
+}
+
+// --- For you ranking ---
+
+// RankingCandidate is a thread with the counters the "foryou" ranker scores it on.
+type RankingCandidate struct {
+	ID            uint
+	UserID        uint
+	PostedAt      time.Time
+	Categories    pq.StringArray `gorm:"type:text[]"`
+	LikeCount     int64
+	ReplyCount    int64
+	BookmarkCount int64
+}
+
+// GetRankingCandidates returns up to limit published top-level threads posted in (since, until], newest first.
+func (r *ThreadRepository) GetRankingCandidates(ctx context.Context, since, until time.Time, excludeUserIDs []uint, limit int) ([]RankingCandidate, error) {
+	var candidates []RankingCandidate
+	query := r.db.WithContext(ctx).Model(&Thread{}).
+		Select("threads.id, threads.user_id, threads.posted_at, threads.categories, " +
+			"COALESCE(thread_stats.like_count, 0) AS like_count, " +
+			"COALESCE(thread_stats.reply_count, 0) AS reply_count, " +
+			"COALESCE(thread_stats.bookmark_count, 0) AS bookmark_count").
+		Joins("LEFT JOIN thread_stats ON thread_stats.thread_id = threads.id").
+		Where("threads.status = ? AND threads.parent_thread_id IS NULL", ThreadStatusPublished).
+		Where("threads.posted_at > ? AND threads.posted_at <= ?", since, until).
+		Order("threads.posted_at DESC, threads.id DESC").
+		Limit(limit)
+	if len(excludeUserIDs) > 0 {
+		query = query.Where("threads.user_id NOT IN ?", excludeUserIDs)
+	}
+	if err := query.Scan(&candidates).Error; err != nil {
+		return nil, fmt.Errorf("failed to get ranking candidates: %w", err)
+	}
+	return candidates, nil
+}
+
+// GetLikedCategoryCounts counts the categories of the threads a user liked most recently.
+func (r *ThreadRepository) GetLikedCategoryCounts(ctx context.Context, userID uint, recentLikes int) (map[string]int64, error) {
+	var rows []struct {
+		Category string
+		Count    int64
+	}
+	query := `
+		SELECT category, COUNT(*) AS count
+		FROM (
+			SELECT threads.categories
+			FROM thread_interactions ti
+			JOIN threads ON threads.id = ti.thread_id
+			WHERE ti.user_id = ? AND ti.interaction_type = 'like' AND threads.deleted_at IS NULL
+			ORDER BY ti.created_at DESC
+			LIMIT ?
+		) liked, unnest(liked.categories) AS category
+		GROUP BY category`
+	if err := r.db.WithContext(ctx).Raw(query, userID, recentLikes).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get liked categories for user %d: %w", userID, err)
+	}
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Category] = row.Count
+	}
+	return counts, nil
+}
+
+// --- Conversations ---
+
+// ConversationReply is a reply in a conversation tree. Depth is 1 for direct replies to the root of the
+// tree; SiblingRank is the reply's position among its siblings, oldest first.
+type ConversationReply struct {
+	Thread      `gorm:"embedded"`
+	Depth       int
+	SiblingRank int
+}
+
+// GetAncestors walks parent_thread_id up from a thread and returns its visible ancestors, root first.
+// Deleted, unpublished or excluded ancestors are left out of the chain but still walked through.
+func (r *ThreadRepository) GetAncestors(ctx context.Context, threadID uint, maxAncestors int, excludeUserIDs []uint) ([]Thread, error) {
+	var threads []Thread
+	exclusion := ""
+	args := map[string]interface{}{
+		"id":     threadID,
+		"max":    maxAncestors,
+		"status": ThreadStatusPublished,
+	}
+	if len(excludeUserIDs) > 0 {
+		exclusion = " AND threads.user_id NOT IN @excluded"
+		args["excluded"] = excludeUserIDs
+	}
+
+	query := `
+		WITH RECURSIVE chain AS (
+			SELECT id, parent_thread_id, 0 AS depth FROM threads WHERE id = @id
+			UNION ALL
+			SELECT t.id, t.parent_thread_id, chain.depth + 1
+			FROM threads t
+			JOIN chain ON t.id = chain.parent_thread_id
+			WHERE chain.depth < @max
+		)
+		SELECT threads.* FROM chain
+		JOIN threads ON threads.id = chain.id
+		WHERE chain.depth > 0 AND threads.deleted_at IS NULL AND threads.status = @status` + exclusion + `
+		ORDER BY chain.depth DESC`
+
+	if err := r.db.WithContext(ctx).Raw(query, args).Scan(&threads).Error; err != nil {
+		return nil, fmt.Errorf("failed to get ancestors of thread %d: %w", threadID, err)
+	}
+	return threads, nil
+}
+
+// GetReplyTree returns the replies below a thread down to maxDepth levels, oldest first within each parent.
+// Each parent contributes at most fanOut replies to the tree, plus one extra row with SiblingRank fanOut+1
+// when it has more; that extra row is only a marker and is not descended into.
+func (r *ThreadRepository) GetReplyTree(ctx context.Context, rootID uint, maxDepth, fanOut int, excludeUserIDs []uint) ([]ConversationReply, error) {
+	var replies []ConversationReply
+	exclusion := ""
+	args := map[string]interface{}{
+		"root":      rootID,
+		"max_depth": maxDepth,
+		"fan_out":   fanOut,
+		"peek":      fanOut + 1,
+		"status":    ThreadStatusPublished,
+	}
+	if len(excludeUserIDs) > 0 {
+		exclusion = " AND threads.user_id NOT IN @excluded"
+		args["excluded"] = excludeUserIDs
+	}
+
+	children := `
+		SELECT threads.*, ROW_NUMBER() OVER (ORDER BY threads.posted_at ASC, threads.id ASC) AS sibling_rank
+		FROM threads
+		WHERE threads.parent_thread_id = %s AND threads.deleted_at IS NULL AND threads.status = @status AND threads.hidden_at IS NULL` + exclusion + `
+		ORDER BY threads.posted_at ASC, threads.id ASC
+		LIMIT @peek`
+
+	query := `
+		WITH RECURSIVE tree AS (
+			SELECT c.*, 1 AS depth FROM (` + fmt.Sprintf(children, "@root") + `) c
+			UNION ALL
+			SELECT c.*, tree.depth + 1
+			FROM tree
+			CROSS JOIN LATERAL (` + fmt.Sprintf(children, "tree.id") + `) c
+			WHERE tree.depth < @max_depth AND tree.sibling_rank <= @fan_out
+		)
+		SELECT * FROM tree
+		ORDER BY depth ASC, parent_thread_id ASC, sibling_rank ASC`
+
+	if err := r.db.WithContext(ctx).Raw(query, args).Scan(&replies).Error; err != nil {
+		return nil, fmt.Errorf("failed to get reply tree for thread %d: %w", rootID, err)
+	}
+	return replies, nil
+}
@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ThreadStats holds denormalized counters for a thread so feeds don't have to aggregate interactions.
+// Counters are updated in the same transaction as the change they count; ReconcileThreadStats repairs any drift.
+type ThreadStats struct {
+	ThreadID      uint  `gorm:"primaryKey;autoIncrement:false"`
+	LikeCount     int64 `gorm:"not null;default:0"`
+	ReplyCount    int64 `gorm:"not null;default:0"`
+	RepostCount   int64 `gorm:"not null;default:0"`
+	BookmarkCount int64 `gorm:"not null;default:0"`
+	QuoteCount    int64 `gorm:"not null;default:0"`
+	UpdatedAt     time.Time
+}
+
+func (ThreadStats) TableName() string { return "thread_stats" }
+
+const (
+	statLikeCount     = "like_count"
+	statReplyCount    = "reply_count"
+	statRepostCount   = "repost_count"
+	statBookmarkCount = "bookmark_count"
+	statQuoteCount    = "quote_count"
+)
+
+// topEngagementScore ranks threads for "top" sorts over a LEFT JOIN of thread_stats.
+// Conversation weighs more than likes.
+const topEngagementScore = "(COALESCE(thread_stats.like_count, 0) + 2 * COALESCE(thread_stats.reply_count, 0) + " +
+	"2 * COALESCE(thread_stats.repost_count, 0) + 2 * COALESCE(thread_stats.quote_count, 0))"
+
+// interactionStatColumns maps an interaction type to the counter it drives.
+var interactionStatColumns = map[string]string{
+	"like":     statLikeCount,
+	"repost":   statRepostCount,
+	"bookmark": statBookmarkCount,
+}
+
+// adjustStat adds delta to one counter of a thread, creating the stats row if needed. Counters never go below zero.
+func (r *ThreadRepository) adjustStat(ctx context.Context, threadID uint, column string, delta int64) error {
+	var err error
+	if delta > 0 {
+		row := map[string]interface{}{"thread_id": threadID, column: delta, "updated_at": time.Now().UTC()}
+		err = r.db.WithContext(ctx).Model(&ThreadStats{}).Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "thread_id"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				column:       gorm.Expr(fmt.Sprintf("thread_stats.%s + ?", column), delta),
+				"updated_at": gorm.Expr("EXCLUDED.updated_at"),
+			}),
+		}).Create(row).Error
+	} else {
+		err = r.db.WithContext(ctx).Model(&ThreadStats{}).
+			Where("thread_id = ?", threadID).
+			Updates(map[string]interface{}{
+				column:       gorm.Expr(fmt.Sprintf("GREATEST(%s + ?, 0)", column), delta),
+				"updated_at": time.Now().UTC(),
+			}).Error
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update %s for thread %d: %w", column, threadID, err)
+	}
+	return nil
+}
+
+// adjustParentStats updates the reply and quote counters of the threads a thread replies to or quotes.
+// Called with +1 when a thread becomes visible and -1 when a visible thread is deleted.
+func (r *ThreadRepository) adjustParentStats(ctx context.Context, thread *Thread, delta int64) error {
+	if thread.ParentThreadID != nil {
+		if err := r.adjustStat(ctx, *thread.ParentThreadID, statReplyCount, delta); err != nil {
+			return err
+		}
+	}
+	if thread.QuotedThreadID != nil {
+		if err := r.adjustStat(ctx, *thread.QuotedThreadID, statQuoteCount, delta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ThreadRepository) GetThreadStatsForMultipleThreads(ctx context.Context, threadIDs []uint) (map[uint]ThreadStats, error) {
+	statsMap := make(map[uint]ThreadStats)
+	if len(threadIDs) == 0 {
+		return statsMap, nil
+	}
+	var stats []ThreadStats
+	if err := r.db.WithContext(ctx).Where("thread_id IN ?", threadIDs).Find(&stats).Error; err != nil {
+		return nil, fmt.Errorf("failed to get thread stats: %w", err)
+	}
+	for _, s := range stats {
+		statsMap[s.ThreadID] = s
+	}
+	return statsMap, nil
+}
+
+// ReconcileThreadStats rebuilds the counters of up to batchSize threads with IDs above afterID from the source tables.
+// It returns the last thread ID it looked at (0 once there are no more threads) and how many rows it corrected.
+func (r *ThreadRepository) ReconcileThreadStats(ctx context.Context, afterID uint, batchSize int) (uint, int64, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).Model(&Thread{}).
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(batchSize).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to list threads for stats reconciliation: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, 0, nil
+	}
+	corrected, err := r.rebuildThreadStats(ctx, ids)
+	if err != nil {
+		return 0, 0, err
+	}
+	return ids[len(ids)-1], corrected, nil
+}
+
+// rebuildThreadStats recomputes the counters of the given threads and returns how many rows changed.
+func (r *ThreadRepository) rebuildThreadStats(ctx context.Context, ids []uint) (int64, error) {
+	query := `
+		INSERT INTO thread_stats (thread_id, like_count, reply_count, repost_count, bookmark_count, quote_count, updated_at)
+		SELECT t.id,
+			COALESCE(i.likes, 0), COALESCE(rp.replies, 0), COALESCE(i.reposts, 0), COALESCE(i.bookmarks, 0), COALESCE(q.quotes, 0),
+			NOW()
+		FROM threads t
+		LEFT JOIN (
+			SELECT thread_id,
+				COUNT(*) FILTER (WHERE interaction_type = 'like') AS likes,
+				COUNT(*) FILTER (WHERE interaction_type = 'repost') AS reposts,
+				COUNT(*) FILTER (WHERE interaction_type = 'bookmark') AS bookmarks
+			FROM thread_interactions
+			WHERE thread_id IN @ids
+			GROUP BY thread_id
+		) i ON i.thread_id = t.id
+		LEFT JOIN (
+			SELECT parent_thread_id AS thread_id, COUNT(*) AS replies
+			FROM threads
+			WHERE parent_thread_id IN @ids AND status = @status AND deleted_at IS NULL
+			GROUP BY parent_thread_id
+		) rp ON rp.thread_id = t.id
+		LEFT JOIN (
+			SELECT quoted_thread_id AS thread_id, COUNT(*) AS quotes
+			FROM threads
+			WHERE quoted_thread_id IN @ids AND status = @status AND deleted_at IS NULL
+			GROUP BY quoted_thread_id
+		) q ON q.thread_id = t.id
+		WHERE t.id IN @ids
+		ON CONFLICT (thread_id) DO UPDATE SET
+			like_count = EXCLUDED.like_count,
+			reply_count = EXCLUDED.reply_count,
+			repost_count = EXCLUDED.repost_count,
+			bookmark_count = EXCLUDED.bookmark_count,
+			quote_count = EXCLUDED.quote_count,
+			updated_at = EXCLUDED.updated_at
+		WHERE (thread_stats.like_count, thread_stats.reply_count, thread_stats.repost_count, thread_stats.bookmark_count, thread_stats.quote_count)
+			IS DISTINCT FROM (EXCLUDED.like_count, EXCLUDED.reply_count, EXCLUDED.repost_count, EXCLUDED.bookmark_count, EXCLUDED.quote_count)`
+
+	result := r.db.WithContext(ctx).Exec(query, map[string]interface{}{"ids": ids, "status": ThreadStatusPublished})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to reconcile thread stats: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,73 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+)
+
+// PollClosedFunc announces a poll whose voting period has ended.
+type PollClosedFunc func(ctx context.Context, poll *postgres.Poll) error
+
+// PollCloser periodically claims polls past their closing time and announces them once.
+type PollCloser struct {
+	repo      *postgres.ThreadRepository
+	announce  PollClosedFunc
+	interval  time.Duration
+	batchSize int
+}
+
+func NewPollCloser(repo *postgres.ThreadRepository, announce PollClosedFunc, interval time.Duration, batchSize int) *PollCloser {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	return &PollCloser{
+		repo:      repo,
+		announce:  announce,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Start blocks until ctx is cancelled, closing due polls every interval.
+func (c *PollCloser) Start(ctx context.Context) {
+	log.Printf("Poll closer started (interval: %v, batch: %d)", c.interval, c.batchSize)
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	c.closeDue(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Poll closer stopped.")
+			return
+		case <-ticker.C:
+			c.closeDue(ctx)
+		}
+	}
+}
+
+func (c *PollCloser) closeDue(ctx context.Context) {
+	for {
+		polls, err := c.repo.ClaimClosedPolls(ctx, time.Now().UTC(), c.batchSize)
+		if err != nil {
+			log.Printf("PollCloser: Failed to claim closed polls: %v", err)
+			return
+		}
+		for i := range polls {
+			if err := c.announce(ctx, &polls[i]); err != nil {
+				log.Printf("PollCloser: Failed to announce closed poll %d: %v", polls[i].ID, err)
+				continue
+			}
+			log.Printf("PollCloser: Closed poll %d on thread %d", polls[i].ID, polls[i].ThreadID)
+		}
+		if len(polls) < c.batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+)
+
+// PublishFunc runs the side effects (mention events, trending counts) for a thread that just went live.
+type PublishFunc func(ctx context.Context, thread *postgres.Thread) error
+
+// sideEffectsRetryAfter is how long a published thread's side effects wait before they are tried again.
+const sideEffectsRetryAfter = 2 * time.Minute
+
+// Publisher periodically claims scheduled threads whose time has come and publishes them.
+// Threads whose side effects failed stay pending and are retried on a later run.
+type Publisher struct {
+	repo      *postgres.ThreadRepository
+	publish   PublishFunc
+	interval  time.Duration
+	batchSize int
+}
+
+func NewPublisher(repo *postgres.ThreadRepository, publish PublishFunc, interval time.Duration, batchSize int) *Publisher {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	return &Publisher{
+		repo:      repo,
+		publish:   publish,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Start blocks until ctx is cancelled, publishing due threads every interval.
+func (p *Publisher) Start(ctx context.Context) {
+	log.Printf("Scheduled thread publisher started (interval: %v, batch: %d)", p.interval, p.batchSize)
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	p.publishDue(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Scheduled thread publisher stopped.")
+			return
+		case <-ticker.C:
+			p.publishDue(ctx)
+		}
+	}
+}
+
+func (p *Publisher) publishDue(ctx context.Context) {
+	p.retryPending(ctx)
+	for {
+		threads, err := p.repo.ClaimDueScheduledThreads(ctx, time.Now().UTC(), p.batchSize)
+		if err != nil {
+			log.Printf("Scheduler: Failed to claim due threads: %v", err)
+			return
+		}
+		p.runSideEffects(ctx, threads)
+		// A full batch means there may be more due threads waiting
+		if len(threads) < p.batchSize {
+			return
+		}
+	}
+}
+
+// retryPending runs the side effects that failed on an earlier run, or never ran because the service stopped.
+func (p *Publisher) retryPending(ctx context.Context) {
+	for {
+		threads, err := p.repo.ClaimPendingSideEffects(ctx, time.Now().UTC(), sideEffectsRetryAfter, p.batchSize)
+		if err != nil {
+			log.Printf("Scheduler: Failed to claim threads with pending side effects: %v", err)
+			return
+		}
+		p.runSideEffects(ctx, threads)
+		if len(threads) < p.batchSize {
+			return
+		}
+	}
+}
+
+func (p *Publisher) runSideEffects(ctx context.Context, threads []postgres.Thread) {
+	for i := range threads {
+		if err := p.publish(ctx, &threads[i]); err != nil {
+			log.Printf("Scheduler: Failed to run publish side effects for thread %d, retrying in %v: %v", threads[i].ID, sideEffectsRetryAfter, err)
+			continue
+		}
+		if err := p.repo.MarkSideEffectsDone(ctx, threads[i].ID); err != nil {
+			log.Printf("Scheduler: %v", err)
+			continue
+		}
+		log.Printf("Scheduler: Published scheduled thread %d", threads[i].ID)
+	}
+}
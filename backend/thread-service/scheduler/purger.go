@@ -0,0 +1,69 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+)
+
+// Purger periodically hard deletes threads that have been soft deleted for longer than the restore window.
+type Purger struct {
+	repo          *postgres.ThreadRepository
+	restoreWindow time.Duration
+	interval      time.Duration
+	batchSize     int
+}
+
+func NewPurger(repo *postgres.ThreadRepository, restoreWindow, interval time.Duration, batchSize int) *Purger {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	return &Purger{
+		repo:          repo,
+		restoreWindow: restoreWindow,
+		interval:      interval,
+		batchSize:     batchSize,
+	}
+}
+
+// Start blocks until ctx is cancelled, purging expired threads every interval.
+func (p *Purger) Start(ctx context.Context) {
+	log.Printf("Deleted thread purger started (window: %v, interval: %v, batch: %d)", p.restoreWindow, p.interval, p.batchSize)
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	p.purgeExpired(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Deleted thread purger stopped.")
+			return
+		case <-ticker.C:
+			p.purgeExpired(ctx)
+		}
+	}
+}
+
+func (p *Purger) purgeExpired(ctx context.Context) {
+	cutoff := time.Now().UTC().Add(-p.restoreWindow)
+	var total int
+	for {
+		ids, err := p.repo.PurgeDeletedThreads(ctx, cutoff, p.batchSize)
+		if err != nil {
+			log.Printf("Purger: Failed to purge threads deleted before %v: %v", cutoff, err)
+			return
+		}
+		total += len(ids)
+		if len(ids) < p.batchSize || ctx.Err() != nil {
+			break
+		}
+	}
+	if total > 0 {
+		log.Printf("Purger: Purged %d threads deleted before %v", total, cutoff)
+	}
+}
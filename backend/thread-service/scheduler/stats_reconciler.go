@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/thread-service/repository/postgres"
+)
+
+// StatsReconciler periodically rebuilds thread_stats from the source tables to repair counter drift.
+type StatsReconciler struct {
+	repo      *postgres.ThreadRepository
+	interval  time.Duration
+	batchSize int
+}
+
+func NewStatsReconciler(repo *postgres.ThreadRepository, interval time.Duration, batchSize int) *StatsReconciler {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	return &StatsReconciler{
+		repo:      repo,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Start blocks until ctx is cancelled, reconciling every thread once per interval.
+func (s *StatsReconciler) Start(ctx context.Context) {
+	log.Printf("Thread stats reconciler started (interval: %v, batch: %d)", s.interval, s.batchSize)
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.reconcileAll(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("Thread stats reconciler stopped.")
+			return
+		case <-ticker.C:
+			s.reconcileAll(ctx)
+		}
+	}
+}
+
+func (s *StatsReconciler) reconcileAll(ctx context.Context) {
+	var afterID uint
+	var total int64
+	for {
+		lastID, corrected, err := s.repo.ReconcileThreadStats(ctx, afterID, s.batchSize)
+		if err != nil {
+			log.Printf("Stats reconciler: Failed after thread %d: %v", afterID, err)
+			return
+		}
+		total += corrected
+		if lastID == 0 || ctx.Err() != nil {
+			break
+		}
+		afterID = lastID
+	}
+	if total > 0 {
+		log.Printf("Stats reconciler: Corrected counters for %d threads", total)
+	}
+}
@@ -0,0 +1,347 @@
+package timeline
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	largeAuthorsKey = "timeline:large_authors"
+	pushBatchSize   = 500 // followers per Redis pipeline
+	pageSlack       = 16  // extra rows read so entries sharing the cursor's timestamp can be skipped
+)
+
+// pushScript adds an entry to a built timeline unless the thread is already there with newer activity,
+// then trims the timeline to its cap. KEYS: timeline, sources, built marker. ARGV: score, thread ID, source, cap.
+var pushScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[3]) == 0 then return 0 end
+local cur = redis.call('ZSCORE', KEYS[1], ARGV[2])
+if cur and tonumber(cur) >= tonumber(ARGV[1]) then return 0 end
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+redis.call('HSET', KEYS[2], ARGV[2], ARGV[3])
+local excess = redis.call('ZCARD', KEYS[1]) - tonumber(ARGV[4])
+if excess > 0 then
+	local old = redis.call('ZRANGE', KEYS[1], 0, excess - 1)
+	redis.call('ZREMRANGEBYRANK', KEYS[1], 0, excess - 1)
+	redis.call('HDEL', KEYS[2], unpack(old))
+end
+return 1
+`)
+
+// removeScript removes a thread from a timeline only if it is there because of the given source.
+// KEYS: timeline, sources. ARGV: thread ID, source.
+var removeScript = redis.NewScript(`
+if redis.call('HGET', KEYS[2], ARGV[1]) ~= ARGV[2] then return 0 end
+redis.call('ZREM', KEYS[1], ARGV[1])
+redis.call('HDEL', KEYS[2], ARGV[1])
+return 1
+`)
+
+// Store holds the timelines. Per user it keeps a sorted set of thread IDs scored by activity time,
+// a hash of who put each thread there, the set of large authors to merge in on read, and a marker
+// saying the timeline has been built. Timelines without the marker are ignored by pushes and rebuilt on read.
+type Store struct {
+	rdb *redis.Client
+	cfg Config
+}
+
+func NewStore(rdb *redis.Client, cfg Config) *Store {
+	return &Store{rdb: rdb, cfg: cfg}
+}
+
+func (s *Store) Config() Config {
+	return s.cfg
+}
+
+func timelineKey(userID uint) string { return fmt.Sprintf("timeline:%d", userID) }
+func sourcesKey(userID uint) string  { return fmt.Sprintf("timeline:src:%d", userID) }
+func pullKey(userID uint) string     { return fmt.Sprintf("timeline:pull:%d", userID) }
+func builtKey(userID uint) string    { return fmt.Sprintf("timeline:built:%d", userID) }
+
+func (s *Store) IsBuilt(ctx context.Context, userID uint) (bool, error) {
+	n, err := s.rdb.Exists(ctx, builtKey(userID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check timeline of user %d: %w", userID, err)
+	}
+	return n > 0, nil
+}
+
+// Rebuild replaces a user's timeline with entries (newest first) and the large authors they follow.
+func (s *Store) Rebuild(ctx context.Context, userID uint, entries []Entry, pullAuthors []uint) error {
+	if len(entries) > s.cfg.MaxEntries {
+		entries = entries[:s.cfg.MaxEntries]
+	}
+	_, err := s.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, timelineKey(userID), sourcesKey(userID), pullKey(userID), builtKey(userID))
+		if len(entries) > 0 {
+			members := make([]*redis.Z, len(entries))
+			sources := make(map[string]interface{}, len(entries))
+			for i, e := range entries {
+				member := strconv.FormatUint(uint64(e.ThreadID), 10)
+				members[i] = &redis.Z{Score: score(e.At), Member: member}
+				sources[member] = encodeSource(e)
+			}
+			pipe.ZAdd(ctx, timelineKey(userID), members...)
+			pipe.HSet(ctx, sourcesKey(userID), sources)
+		}
+		if len(pullAuthors) > 0 {
+			ids := make([]interface{}, len(pullAuthors))
+			for i, id := range pullAuthors {
+				ids[i] = id
+			}
+			pipe.SAdd(ctx, pullKey(userID), ids...)
+		}
+		pipe.Set(ctx, builtKey(userID), 1, s.cfg.TTL)
+		s.expire(ctx, pipe, userID)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to rebuild timeline of user %d: %w", userID, err)
+	}
+	return nil
+}
+
+func (s *Store) expire(ctx context.Context, pipe redis.Pipeliner, userID uint) {
+	for _, key := range []string{timelineKey(userID), sourcesKey(userID), pullKey(userID), builtKey(userID)} {
+		pipe.Expire(ctx, key, s.cfg.TTL)
+	}
+}
+
+// Push adds e to the built timelines of userIDs.
+func (s *Store) Push(ctx context.Context, userIDs []uint, e Entry) error {
+	if err := pushScript.Load(ctx, s.rdb).Err(); err != nil {
+		return fmt.Errorf("failed to load timeline push script: %w", err)
+	}
+	member := strconv.FormatUint(uint64(e.ThreadID), 10)
+	source := encodeSource(e)
+	for start := 0; start < len(userIDs); start += pushBatchSize {
+		end := start + pushBatchSize
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+		_, err := s.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for _, userID := range userIDs[start:end] {
+				keys := []string{timelineKey(userID), sourcesKey(userID), builtKey(userID)}
+				pushScript.EvalSha(ctx, pipe, keys, score(e.At), member, source, s.cfg.MaxEntries)
+			}
+			return nil
+		})
+		if err != nil && err != redis.Nil {
+			return fmt.Errorf("failed to push thread %d to timelines: %w", e.ThreadID, err)
+		}
+	}
+	return nil
+}
+
+// Add pushes several entries into one user's timeline, e.g. to backfill a newly followed account.
+func (s *Store) Add(ctx context.Context, userID uint, entries []Entry) error {
+	for _, e := range entries {
+		if err := s.Push(ctx, []uint{userID}, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Remove takes a thread out of the timelines of userIDs where it was put by e's source, e.g. after an unrepost.
+func (s *Store) Remove(ctx context.Context, userIDs []uint, e Entry) error {
+	if err := removeScript.Load(ctx, s.rdb).Err(); err != nil {
+		return fmt.Errorf("failed to load timeline remove script: %w", err)
+	}
+	member := strconv.FormatUint(uint64(e.ThreadID), 10)
+	source := encodeSource(e)
+	for start := 0; start < len(userIDs); start += pushBatchSize {
+		end := start + pushBatchSize
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+		_, err := s.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for _, userID := range userIDs[start:end] {
+				removeScript.EvalSha(ctx, pipe, []string{timelineKey(userID), sourcesKey(userID)}, member, source)
+			}
+			return nil
+		})
+		if err != nil && err != redis.Nil {
+			return fmt.Errorf("failed to remove thread %d from timelines: %w", e.ThreadID, err)
+		}
+	}
+	return nil
+}
+
+// RemoveSource drops everything a no longer followed user put into userID's timeline.
+func (s *Store) RemoveSource(ctx context.Context, userID, sourceUserID uint) error {
+	sources, err := s.rdb.HGetAll(ctx, sourcesKey(userID)).Result()
+	if err != nil {
+		return fmt.Errorf("failed to read timeline sources of user %d: %w", userID, err)
+	}
+	var members []string
+	for member, source := range sources {
+		if id, _, err := decodeSource(source); err == nil && id == sourceUserID {
+			members = append(members, member)
+		}
+	}
+	if len(members) == 0 {
+		return nil
+	}
+	_, err = s.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		zMembers := make([]interface{}, len(members))
+		for i, m := range members {
+			zMembers[i] = m
+		}
+		pipe.ZRem(ctx, timelineKey(userID), zMembers...)
+		pipe.HDel(ctx, sourcesKey(userID), members...)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove user %d from timeline of user %d: %w", sourceUserID, userID, err)
+	}
+	return nil
+}
+
+// Page returns up to limit entries after the cursor (afterAt, afterID), newest first.
+// A zero afterAt starts from the top. Reading also keeps the timeline from expiring.
+func (s *Store) Page(ctx context.Context, userID uint, afterAt time.Time, afterID uint, limit int) ([]Entry, error) {
+	max := "+inf"
+	if !afterAt.IsZero() {
+		max = strconv.FormatFloat(score(afterAt), 'f', -1, 64)
+	}
+	rows, err := s.rdb.ZRevRangeByScoreWithScores(ctx, timelineKey(userID), &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   max,
+		Count: int64(limit + pageSlack),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read timeline of user %d: %w", userID, err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	members := make([]string, len(rows))
+	for i, row := range rows {
+		members[i], _ = row.Member.(string)
+	}
+	var sourcesCmd *redis.SliceCmd
+	_, err = s.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		sourcesCmd = pipe.HMGet(ctx, sourcesKey(userID), members...)
+		s.expire(ctx, pipe, userID)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read timeline sources of user %d: %w", userID, err)
+	}
+	sources := sourcesCmd.Val()
+
+	entries := make([]Entry, 0, limit)
+	for i, row := range rows {
+		threadID, err := strconv.ParseUint(members[i], 10, 64)
+		if err != nil {
+			continue
+		}
+		e := Entry{ThreadID: uint(threadID), At: fromScore(row.Score)}
+		if i < len(sources) {
+			if source, ok := sources[i].(string); ok {
+				e.SourceUserID, e.Repost, _ = decodeSource(source)
+			}
+		}
+		if !afterAt.IsZero() && !After(e, afterAt, afterID) {
+			continue
+		}
+		entries = append(entries, e)
+		if len(entries) == limit {
+			break
+		}
+	}
+	return entries, nil
+}
+
+// MarkLargeAuthor records that authorID is no longer fanned out. It reports whether this is new.
+func (s *Store) MarkLargeAuthor(ctx context.Context, authorID uint) (bool, error) {
+	added, err := s.rdb.SAdd(ctx, largeAuthorsKey, authorID).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to mark user %d as a large account: %w", authorID, err)
+	}
+	return added > 0, nil
+}
+
+// LargeAuthorsAmong returns the IDs in userIDs that are large accounts.
+func (s *Store) LargeAuthorsAmong(ctx context.Context, userIDs []uint) ([]uint, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	ids := make([]interface{}, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = id
+	}
+	flags, err := s.rdb.SMIsMember(ctx, largeAuthorsKey, ids...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check large accounts: %w", err)
+	}
+	var large []uint
+	for i, isLarge := range flags {
+		if isLarge {
+			large = append(large, userIDs[i])
+		}
+	}
+	return large, nil
+}
+
+// PullAuthors returns the large accounts userID follows, whose threads are merged in on read.
+func (s *Store) PullAuthors(ctx context.Context, userID uint) ([]uint, error) {
+	raw, err := s.rdb.SMembers(ctx, pullKey(userID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read large accounts followed by user %d: %w", userID, err)
+	}
+	ids := make([]uint, 0, len(raw))
+	for _, r := range raw {
+		if id, err := strconv.ParseUint(r, 10, 64); err == nil {
+			ids = append(ids, uint(id))
+		}
+	}
+	return ids, nil
+}
+
+// AddPullAuthor merges authorID's threads into the built timelines of userIDs on read.
+func (s *Store) AddPullAuthor(ctx context.Context, userIDs []uint, authorID uint) error {
+	for start := 0; start < len(userIDs); start += pushBatchSize {
+		end := start + pushBatchSize
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+		builtCmds := make([]*redis.IntCmd, end-start)
+		_, err := s.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i, userID := range userIDs[start:end] {
+				builtCmds[i] = pipe.Exists(ctx, builtKey(userID))
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to check timelines: %w", err)
+		}
+		_, err = s.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i, userID := range userIDs[start:end] {
+				if builtCmds[i].Val() == 0 {
+					continue // rebuilt with the right pull set on next read
+				}
+				pipe.SAdd(ctx, pullKey(userID), authorID)
+				pipe.Expire(ctx, pullKey(userID), s.cfg.TTL)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add large account %d to timelines: %w", authorID, err)
+		}
+	}
+	return nil
+}
+
+func (s *Store) RemovePullAuthor(ctx context.Context, userID, authorID uint) error {
+	if err := s.rdb.SRem(ctx, pullKey(userID), authorID).Err(); err != nil {
+		return fmt.Errorf("failed to remove large account %d from timeline of user %d: %w", authorID, userID, err)
+	}
+	return nil
+}
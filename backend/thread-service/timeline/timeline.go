@@ -0,0 +1,158 @@
+// Package timeline keeps each user's "following" feed precomputed in Redis.
+// New threads and reposts are pushed into the timelines of the author's followers when they happen
+// (fan-out on write). Authors with very many followers are skipped and merged in when the feed is read.
+package timeline
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Config struct {
+	MaxEntries            int           // per-user cap; older entries are trimmed
+	LargeAccountFollowers int           // authors with more followers than this are read on demand instead of fanned out
+	BackfillPerAuthor     int           // recent threads copied into a timeline when its owner follows someone
+	TTL                   time.Duration // idle timelines expire and are rebuilt from Postgres on the next read
+}
+
+func DefaultConfig() Config {
+	return Config{
+		MaxEntries:            800,
+		LargeAccountFollowers: 5000,
+		BackfillPerAuthor:     20,
+		TTL:                   7 * 24 * time.Hour,
+	}
+}
+
+// ConfigFromEnv starts from DefaultConfig and applies any TIMELINE_* overrides that parse.
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig()
+	envInt("TIMELINE_MAX_ENTRIES", &cfg.MaxEntries)
+	envInt("TIMELINE_LARGE_ACCOUNT_FOLLOWERS", &cfg.LargeAccountFollowers)
+	envInt("TIMELINE_BACKFILL_PER_AUTHOR", &cfg.BackfillPerAuthor)
+	if v, err := strconv.Atoi(os.Getenv("TIMELINE_TTL_HOURS")); err == nil && v > 0 {
+		cfg.TTL = time.Duration(v) * time.Hour
+	}
+	return cfg
+}
+
+func envInt(key string, dst *int) {
+	if v, err := strconv.Atoi(os.Getenv(key)); err == nil && v > 0 {
+		*dst = v
+	}
+}
+
+// Entry is one thread in a timeline. A thread appears at most once per timeline, at its latest activity.
+type Entry struct {
+	ThreadID     uint
+	SourceUserID uint      // the followed user who put it there: the author, or the reposter
+	Repost       bool      // SourceUserID reposted the thread rather than wrote it
+	At           time.Time // posted_at, or reposted_at for reposts
+}
+
+// encodeSource stores who put an entry in a timeline so unfollowing can take it out again.
+func encodeSource(e Entry) string {
+	kind := "p"
+	if e.Repost {
+		kind = "r"
+	}
+	return fmt.Sprintf("%s:%d", kind, e.SourceUserID)
+}
+
+func decodeSource(s string) (uint, bool, error) {
+	kind, id, ok := strings.Cut(s, ":")
+	if !ok || (kind != "p" && kind != "r") {
+		return 0, false, fmt.Errorf("invalid timeline source %q", s)
+	}
+	userID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid timeline source %q", s)
+	}
+	return uint(userID), kind == "r", nil
+}
+
+// score keeps microsecond precision so timeline cursors line up with Postgres timestamps.
+func score(t time.Time) float64 {
+	return float64(t.UnixMicro())
+}
+
+func fromScore(s float64) time.Time {
+	return time.UnixMicro(int64(s)).UTC()
+}
+
+// After reports whether e comes after the cursor position (at, id) in newest-first order.
+func After(e Entry, at time.Time, id uint) bool {
+	if !e.At.Equal(at) {
+		return e.At.Before(at)
+	}
+	return e.ThreadID < id
+}
+
+// Merge combines newest-first lists into one newest-first list of at most limit entries.
+// A thread present in several lists is kept once, at its latest activity.
+func Merge(limit int, lists ...[]Entry) []Entry {
+	latest := make(map[uint]Entry)
+	for _, list := range lists {
+		for _, e := range list {
+			if cur, ok := latest[e.ThreadID]; !ok || e.At.After(cur.At) {
+				latest[e.ThreadID] = e
+			}
+		}
+	}
+	merged := make([]Entry, 0, len(latest))
+	for _, e := range latest {
+		merged = append(merged, e)
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		if !merged[i].At.Equal(merged[j].At) {
+			return merged[i].At.After(merged[j].At)
+		}
+		return merged[i].ThreadID > merged[j].ThreadID
+	})
+	if len(merged) > limit {
+		merged = merged[:limit]
+	}
+	return merged
+}
+
+// Filled is one feed page built by Fill. CursorAt and CursorID point at the last candidate read, kept or
+// not, so the next page resumes after everything this one already filtered out.
+type Filled struct {
+	Entries  []Entry
+	CursorAt time.Time
+	CursorID uint
+	HasMore  bool
+}
+
+// Fill builds a page of up to limit entries after the cursor (at, id). next returns up to n candidates after a
+// cursor, newest first, and whether nothing older is left; keep returns the candidates that may be shown.
+// At most rounds batches are read, so a heavily filtered page can come back short while HasMore is still set.
+func Fill(limit, rounds int, at time.Time, id uint,
+	next func(at time.Time, id uint, n int) ([]Entry, bool, error),
+	keep func(candidates []Entry) ([]Entry, error)) (Filled, error) {
+	page := Filled{CursorAt: at, CursorID: id, HasMore: true}
+	for round := 0; round < rounds && len(page.Entries) < limit; round++ {
+		candidates, exhausted, err := next(page.CursorAt, page.CursorID, limit-len(page.Entries))
+		if err != nil {
+			return Filled{}, err
+		}
+		if len(candidates) > 0 {
+			last := candidates[len(candidates)-1]
+			page.CursorAt, page.CursorID = last.At, last.ThreadID
+			kept, err := keep(candidates)
+			if err != nil {
+				return Filled{}, err
+			}
+			page.Entries = append(page.Entries, kept...)
+		}
+		if exhausted {
+			page.HasMore = false
+			break
+		}
+	}
+	return page, nil
+}
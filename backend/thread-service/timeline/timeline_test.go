@@ -0,0 +1,147 @@
+package timeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func TestMerge(t *testing.T) {
+	pushed := []Entry{
+		{ThreadID: 5, SourceUserID: 1, At: testNow},
+		{ThreadID: 3, SourceUserID: 2, Repost: true, At: testNow.Add(-2 * time.Minute)},
+		{ThreadID: 2, SourceUserID: 1, At: testNow.Add(-5 * time.Minute)},
+	}
+	pulled := []Entry{
+		{ThreadID: 4, SourceUserID: 9, At: testNow.Add(-time.Minute)},
+		{ThreadID: 3, SourceUserID: 9, At: testNow.Add(-10 * time.Minute)},
+		{ThreadID: 1, SourceUserID: 9, At: testNow.Add(-2 * time.Minute)},
+	}
+
+	merged := Merge(10, pushed, pulled)
+	ids := make([]uint, len(merged))
+	for i, e := range merged {
+		ids[i] = e.ThreadID
+	}
+	assert.Equal(t, []uint{5, 4, 3, 1, 2}, ids, "newest first, ties broken by higher thread ID")
+	assert.True(t, merged[2].Repost, "duplicate thread keeps its latest activity")
+	assert.Equal(t, uint(2), merged[2].SourceUserID)
+
+	assert.Len(t, Merge(2, pushed, pulled), 2)
+	assert.Empty(t, Merge(5))
+}
+
+func TestAfter(t *testing.T) {
+	e := Entry{ThreadID: 7, At: testNow}
+	assert.True(t, After(e, testNow.Add(time.Second), 1))
+	assert.False(t, After(e, testNow.Add(-time.Second), 100))
+	assert.True(t, After(e, testNow, 8), "same time falls back to thread ID")
+	assert.False(t, After(e, testNow, 7), "cursor entry itself is excluded")
+}
+
+// testStream serves entries 1..count, newest first, one minute apart.
+func testStream(count int) func(at time.Time, id uint, n int) ([]Entry, bool, error) {
+	all := make([]Entry, count)
+	for i := range all {
+		all[i] = Entry{ThreadID: uint(count - i), At: testNow.Add(-time.Duration(i) * time.Minute)}
+	}
+	return func(at time.Time, id uint, n int) ([]Entry, bool, error) {
+		var out []Entry
+		for _, e := range all {
+			if at.IsZero() || After(e, at, id) {
+				out = append(out, e)
+			}
+		}
+		if len(out) > n {
+			return out[:n], false, nil
+		}
+		return out, len(out) < n, nil
+	}
+}
+
+func entryIDs(entries []Entry) []uint {
+	ids := make([]uint, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ThreadID
+	}
+	return ids
+}
+
+func TestFill(t *testing.T) {
+	keepAll := func(c []Entry) ([]Entry, error) { return c, nil }
+	page, err := Fill(3, 3, time.Time{}, 0, testStream(10), keepAll)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{10, 9, 8}, entryIDs(page.Entries))
+	assert.True(t, page.HasMore)
+	assert.Equal(t, uint(8), page.CursorID)
+
+	page, err = Fill(5, 3, page.CursorAt, page.CursorID, testStream(10), keepAll)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{7, 6, 5, 4, 3}, entryIDs(page.Entries))
+
+	page, err = Fill(5, 3, page.CursorAt, page.CursorID, testStream(10), keepAll)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{2, 1}, entryIDs(page.Entries))
+	assert.False(t, page.HasMore, "stream ran out")
+}
+
+func TestFillShortenedByFiltering(t *testing.T) {
+	// Threads 10 to 4 are all filtered out, e.g. by blocked authors or deletions
+	keepLow := func(c []Entry) ([]Entry, error) {
+		var kept []Entry
+		for _, e := range c {
+			if e.ThreadID < 4 {
+				kept = append(kept, e)
+			}
+		}
+		return kept, nil
+	}
+
+	page, err := Fill(2, 3, time.Time{}, 0, testStream(10), keepLow)
+	assert.NoError(t, err)
+	assert.Empty(t, page.Entries, "three rounds of two candidates are all filtered")
+	assert.True(t, page.HasMore, "a short page isn't the end of the feed")
+	assert.Equal(t, uint(5), page.CursorID, "cursor is at the last candidate read")
+
+	page, err = Fill(2, 3, page.CursorAt, page.CursorID, testStream(10), keepLow)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{3, 2}, entryIDs(page.Entries))
+	assert.True(t, page.HasMore)
+
+	page, err = Fill(2, 3, page.CursorAt, page.CursorID, testStream(10), keepLow)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{1}, entryIDs(page.Entries))
+	assert.False(t, page.HasMore)
+}
+
+func TestSourceEncoding(t *testing.T) {
+	for _, e := range []Entry{{SourceUserID: 42}, {SourceUserID: 7, Repost: true}} {
+		userID, repost, err := decodeSource(encodeSource(e))
+		assert.NoError(t, err)
+		assert.Equal(t, e.SourceUserID, userID)
+		assert.Equal(t, e.Repost, repost)
+	}
+	for _, bad := range []string{"", "x:1", "p:", "p:abc", "12"} {
+		_, _, err := decodeSource(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestScoreRoundTrip(t *testing.T) {
+	at := time.Date(2025, 6, 1, 12, 0, 0, 123456000, time.UTC)
+	assert.True(t, at.Equal(fromScore(score(at))))
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("TIMELINE_MAX_ENTRIES", "300")
+	t.Setenv("TIMELINE_TTL_HOURS", "48")
+	t.Setenv("TIMELINE_LARGE_ACCOUNT_FOLLOWERS", "-1")
+
+	cfg := ConfigFromEnv()
+	assert.Equal(t, 300, cfg.MaxEntries)
+	assert.Equal(t, 48*time.Hour, cfg.TTL)
+	assert.Equal(t, DefaultConfig().LargeAccountFollowers, cfg.LargeAccountFollowers)
+}
@@ -0,0 +1,32 @@
+package utils
+
+import "strings"
+
+// Categories are the thread categories the AI service can suggest, in the form stored on threads.
+var Categories = []string{"world", "sports", "business", "sci_tech"}
+
+// NormalizeCategory maps input such as "Sci/Tech" or "sci-tech" to its stored form.
+func NormalizeCategory(input string) (string, bool) {
+	category := strings.ToLower(strings.TrimSpace(input))
+	category = strings.NewReplacer("/", "_", "-", "_", " ", "_").Replace(category)
+	for _, known := range Categories {
+		if category == known {
+			return category, true
+		}
+	}
+	return "", false
+}
+
+// NormalizeCategories normalizes and de-duplicates categories, dropping unknown ones.
+func NormalizeCategories(inputs []string) []string {
+	var categories []string
+	seen := make(map[string]bool)
+	for _, input := range inputs {
+		category, ok := NormalizeCategory(input)
+		if ok && !seen[category] {
+			categories = append(categories, category)
+			seen[category] = true
+		}
+	}
+	return categories
+}
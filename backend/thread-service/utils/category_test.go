@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeCategory(t *testing.T) {
+	for input, want := range map[string]string{"World": "world", " sports ": "sports", "Sci/Tech": "sci_tech", "sci-tech": "sci_tech", "sci_tech": "sci_tech"} {
+		got, ok := NormalizeCategory(input)
+		assert.True(t, ok, input)
+		assert.Equal(t, want, got, input)
+	}
+	for _, bad := range []string{"", "politics", "sci tech!"} {
+		_, ok := NormalizeCategory(bad)
+		assert.False(t, ok, bad)
+	}
+}
+
+func TestNormalizeCategories(t *testing.T) {
+	assert.Equal(t, []string{"business", "sci_tech"}, NormalizeCategories([]string{"Business", "unknown", "Sci/Tech", "business"}))
+	assert.Empty(t, NormalizeCategories(nil))
+}
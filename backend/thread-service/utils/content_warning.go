@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxContentWarningLength is how many characters a content warning may have.
+const MaxContentWarningLength = 100
+
+// NormalizeContentWarning trims a content warning and collapses its whitespace. An empty result means no warning.
+func NormalizeContentWarning(input string) (string, error) {
+	warning := strings.Join(strings.Fields(input), " ")
+	if utf8.RuneCountInString(warning) > MaxContentWarningLength {
+		return "", fmt.Errorf("content warning must be at most %d characters", MaxContentWarningLength)
+	}
+	return warning, nil
+}
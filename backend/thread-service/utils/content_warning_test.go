@@ -0,0 +1,21 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeContentWarning(t *testing.T) {
+	for input, want := range map[string]string{"": "", "   ": "", " Spoilers ": "Spoilers", "graphic\n  violence": "graphic violence"} {
+		got, err := NormalizeContentWarning(input)
+		assert.NoError(t, err, input)
+		assert.Equal(t, want, got, input)
+	}
+
+	_, err := NormalizeContentWarning(strings.Repeat("é", MaxContentWarningLength))
+	assert.NoError(t, err, "length is counted in characters, not bytes")
+	_, err = NormalizeContentWarning(strings.Repeat("a", MaxContentWarningLength+1))
+	assert.Error(t, err)
+}
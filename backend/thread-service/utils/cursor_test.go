@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	at := time.Date(2025, 3, 14, 15, 9, 26, 535897000, time.UTC)
+	cursor := EncodeCursor(at, 42)
+
+	decodedAt, decodedID, err := DecodeCursor(cursor)
+	assert.NoError(t, err)
+	assert.True(t, at.Equal(decodedAt), "timestamp must survive with nanosecond precision")
+	assert.Equal(t, uint(42), decodedID)
+	assert.NotContains(t, cursor, "=", "cursor must be URL safe")
+}
+
+func TestDecodeCursorRejectsGarbage(t *testing.T) {
+	testCases := []string{"", "!!!", EncodeCursor(time.Now(), 1)[:3], "MTIz"}
+	for _, tc := range testCases {
+		_, _, err := DecodeCursor(tc)
+		assert.ErrorIs(t, err, ErrInvalidCursor, "cursor %q", tc)
+	}
+}
@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	MinPollOptions      = 2
+	MaxPollOptions      = 4
+	MaxPollOptionLength = 25
+	DefaultPollDuration = 24 * time.Hour
+	MinPollDuration     = 5 * time.Minute
+	MaxPollDuration     = 7 * 24 * time.Hour
+)
+
+// NormalizePollOptions trims the options and checks their count, length and uniqueness.
+func NormalizePollOptions(options []string) ([]string, error) {
+	if len(options) < MinPollOptions || len(options) > MaxPollOptions {
+		return nil, fmt.Errorf("a poll needs between %d and %d options", MinPollOptions, MaxPollOptions)
+	}
+	normalized := make([]string, len(options))
+	seen := make(map[string]bool, len(options))
+	for i, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			return nil, fmt.Errorf("poll option %d is empty", i+1)
+		}
+		if utf8.RuneCountInString(option) > MaxPollOptionLength {
+			return nil, fmt.Errorf("poll option %d is longer than %d characters", i+1, MaxPollOptionLength)
+		}
+		key := strings.ToLower(option)
+		if seen[key] {
+			return nil, fmt.Errorf("poll option %q is duplicated", option)
+		}
+		seen[key] = true
+		normalized[i] = option
+	}
+	return normalized, nil
+}
+
+// PollClosingTime resolves when a poll on a thread going live at postedAt closes.
+// A zero requested time means the default duration.
+func PollClosingTime(postedAt, requested time.Time) (time.Time, error) {
+	if requested.IsZero() {
+		return postedAt.Add(DefaultPollDuration), nil
+	}
+	duration := requested.Sub(postedAt)
+	if duration < MinPollDuration || duration > MaxPollDuration {
+		return time.Time{}, fmt.Errorf("a poll must stay open between %v and %v after posting", MinPollDuration, MaxPollDuration)
+	}
+	return requested, nil
+}
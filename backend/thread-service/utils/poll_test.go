@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePollOptions(t *testing.T) {
+	testCases := []struct {
+		name     string
+		options  []string
+		expected []string
+		wantErr  bool
+	}{
+		{"two options", []string{"Yes", "No"}, []string{"Yes", "No"}, false},
+		{"trims whitespace", []string{"  Cats ", "Dogs"}, []string{"Cats", "Dogs"}, false},
+		{"too few", []string{"Only"}, nil, true},
+		{"too many", []string{"a", "b", "c", "d", "e"}, nil, true},
+		{"blank option", []string{"a", "   "}, nil, true},
+		{"too long", []string{"a", "this option is far too long to fit"}, nil, true},
+		{"duplicate ignoring case", []string{"Yes", "yes"}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := NormalizePollOptions(tc.options)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestPollClosingTime(t *testing.T) {
+	postedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	closesAt, err := PollClosingTime(postedAt, time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, postedAt.Add(DefaultPollDuration), closesAt)
+
+	closesAt, err = PollClosingTime(postedAt, postedAt.Add(2*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, postedAt.Add(2*time.Hour), closesAt)
+
+	_, err = PollClosingTime(postedAt, postedAt.Add(time.Minute))
+	assert.Error(t, err, "closing too soon")
+	_, err = PollClosingTime(postedAt, postedAt.Add(8*24*time.Hour))
+	assert.Error(t, err, "closing too late")
+}
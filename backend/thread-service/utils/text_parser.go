@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"net/url"
 	"regexp"
 	"strings"
 )
@@ -8,8 +9,16 @@ import (
 var (
 	hashtagRegex = regexp.MustCompile(`(?i)#([a-zA-Z0-9_]+)`) // Case-insensitive
 	mentionRegex = regexp.MustCompile(`@([a-zA-Z0-9_]{4,30})`) // Usernames 4-30 chars, alphanumeric + underscore
+	tagNameRegex = regexp.MustCompile(`^[a-z0-9_]{1,100}$`)    // a single stored tag; hashtags.tag_name is varchar(100)
+	urlRegex     = regexp.MustCompile(`(?i)\bhttps?://[^\s<>"'` + "`" + `]+`)
 )
 
+// MaxURLLength is the longest URL kept as a link preview reference.
+const MaxURLLength = 2048
+
+// trackingParams are query parameters that don't change what a link points to.
+var trackingParams = []string{"fbclid", "gclid", "igshid", "mc_cid", "mc_eid"}
+
 func ExtractHashtags(content string) []string {
 	matches := hashtagRegex.FindAllStringSubmatch(content, -1)
 	var tags []string
@@ -26,6 +35,16 @@ func ExtractHashtags(content string) []string {
 	return tags
 }
 
+// NormalizeHashtag turns user input such as "#Go" into the stored form "go".
+// It reports false if the input is not a single valid tag.
+func NormalizeHashtag(input string) (string, bool) {
+	tag := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(input), "#"))
+	if !tagNameRegex.MatchString(tag) {
+		return "", false
+	}
+	return tag, true
+}
+
 func ExtractMentions(content string) []string {
 	matches := mentionRegex.FindAllStringSubmatch(content, -1)
 	var usernames []string
@@ -40,4 +59,119 @@ func ExtractMentions(content string) []string {
 		}
 	}
 	return usernames
-}
\ No newline at end of file
+}
+
+// DiffHashtags compares the hashtags of two versions of a thread and returns
+// the tags that were added and removed, in the order they appear.
+func DiffHashtags(oldTags, newTags []string) (added []string, removed []string) {
+	oldSet := make(map[string]bool, len(oldTags))
+	for _, tag := range oldTags {
+		oldSet[strings.ToLower(tag)] = true
+	}
+	newSet := make(map[string]bool, len(newTags))
+	for _, tag := range newTags {
+		tag = strings.ToLower(tag)
+		if !newSet[tag] && !oldSet[tag] {
+			added = append(added, tag)
+		}
+		newSet[tag] = true
+	}
+	for _, tag := range oldTags {
+		tag = strings.ToLower(tag)
+		if !newSet[tag] {
+			removed = append(removed, tag)
+			newSet[tag] = true // avoid reporting duplicates twice
+		}
+	}
+	return added, removed
+}
+
+// ExtractURLs returns the http(s) URLs in content in the order they appear, without duplicates.
+// Trailing punctuation is left out, as is a closing parenthesis the URL didn't open.
+func ExtractURLs(content string) []string {
+	var urls []string
+	seen := make(map[string]bool)
+	for _, match := range urlRegex.FindAllString(content, -1) {
+		match = trimURLPunctuation(match)
+		if !seen[match] {
+			urls = append(urls, match)
+			seen[match] = true
+		}
+	}
+	return urls
+}
+
+func trimURLPunctuation(rawURL string) string {
+	for len(rawURL) > 0 {
+		last := rawURL[len(rawURL)-1]
+		switch {
+		case strings.IndexByte(".,;:!?", last) >= 0:
+			rawURL = rawURL[:len(rawURL)-1]
+		case last == ')' && strings.Count(rawURL, "(") < strings.Count(rawURL, ")"):
+			rawURL = rawURL[:len(rawURL)-1]
+		default:
+			return rawURL
+		}
+	}
+	return rawURL
+}
+
+// NormalizeURL turns rawURL into the form link previews are cached under: lowercase scheme and host,
+// no default port, fragment or tracking parameters, and sorted query parameters.
+// It reports false for anything but an absolute http(s) URL, and for URLs carrying credentials.
+func NormalizeURL(rawURL string) (string, bool) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || u.User != nil || u.Hostname() == "" {
+		return "", false
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", false
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	port := u.Port()
+	if (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") {
+		port = ""
+	}
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]" // IPv6 literal
+	}
+	if port != "" {
+		host += ":" + port
+	}
+	u.Host = host
+	u.Fragment = ""
+	u.RawFragment = ""
+	if u.Path == "" {
+		u.Path = "/"
+	}
+
+	query := u.Query()
+	for key := range query {
+		if strings.HasPrefix(strings.ToLower(key), "utm_") {
+			query.Del(key)
+		}
+	}
+	for _, key := range trackingParams {
+		query.Del(key)
+	}
+	u.RawQuery = query.Encode()
+	u.ForceQuery = false
+
+	normalized := u.String()
+	if len(normalized) > MaxURLLength {
+		return "", false
+	}
+	return normalized, true
+}
+
+// FirstPreviewURL returns the normalized form of the first URL in content that can have a link preview.
+func FirstPreviewURL(content string) (string, bool) {
+	for _, rawURL := range ExtractURLs(content) {
+		if normalized, ok := NormalizeURL(rawURL); ok {
+			return normalized, true
+		}
+	}
+	return "", false
+}
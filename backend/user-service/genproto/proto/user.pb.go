@@ -83,6 +83,8 @@ type User struct {
 	SubscribedToNewsletter bool                   `protobuf:"varint,12,opt,name=subscribed_to_newsletter,json=subscribedToNewsletter,proto3" json:"subscribed_to_newsletter,omitempty"`
 	Bio                    string                 `protobuf:"bytes,13,opt,name=bio,proto3" json:"bio,omitempty"`
 	IsVerified             bool                   `protobuf:"varint,14,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	MentionPermission      string                 `protobuf:"bytes,15,opt,name=mention_permission,json=mentionPermission,proto3" json:"mention_permission,omitempty"`             // who can @mention this user: "everyone", "following" (people they follow) or "none"; only set on the self-view
+	ShowSensitiveContent   bool                   `protobuf:"varint,16,opt,name=show_sensitive_content,json=showSensitiveContent,proto3" json:"show_sensitive_content,omitempty"` // show threads marked sensitive or with a content warning without masking; only set on the self-view
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -215,6 +217,20 @@ func (x *User) GetIsVerified() bool {
 	return false
 }
 
+func (x *User) GetMentionPermission() string {
+	if x != nil {
+		return x.MentionPermission
+	}
+	return ""
+}
+
+func (x *User) GetShowSensitiveContent() bool {
+	if x != nil {
+		return x.ShowSensitiveContent
+	}
+	return false
+}
+
 type RegisterRequest struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	Name                   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -827,6 +843,7 @@ type UserProfileResponse struct {
 	IsFollowedByRequester bool                   `protobuf:"varint,4,opt,name=is_followed_by_requester,json=isFollowedByRequester,proto3" json:"is_followed_by_requester,omitempty"`
 	IsBlockedByRequester  bool                   `protobuf:"varint,5,opt,name=is_blocked_by_requester,json=isBlockedByRequester,proto3" json:"is_blocked_by_requester,omitempty"`
 	IsBlockingRequester   bool                   `protobuf:"varint,6,opt,name=is_blocking_requester,json=isBlockingRequester,proto3" json:"is_blocking_requester,omitempty"`
+	IsMutedByRequester    bool                   `protobuf:"varint,7,opt,name=is_muted_by_requester,json=isMutedByRequester,proto3" json:"is_muted_by_requester,omitempty"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -903,6 +920,13 @@ func (x *UserProfileResponse) GetIsBlockingRequester() bool {
 	return false
 }
 
+func (x *UserProfileResponse) GetIsMutedByRequester() bool {
+	if x != nil {
+		return x.IsMutedByRequester
+	}
+	return false
+}
+
 type GetUserProfileRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	UserIdToView    uint32                 `protobuf:"varint,1,opt,name=user_id_to_view,json=userIdToView,proto3" json:"user_id_to_view,omitempty"`
@@ -970,6 +994,8 @@ type UpdateUserProfileRequest struct {
 	Bio                    *string `protobuf:"bytes,11,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
 	AccountPrivacy         *string `protobuf:"bytes,12,opt,name=account_privacy,json=accountPrivacy,proto3,oneof" json:"account_privacy,omitempty"`
 	SubscribedToNewsletter *bool   `protobuf:"varint,13,opt,name=subscribed_to_newsletter,json=subscribedToNewsletter,proto3,oneof" json:"subscribed_to_newsletter,omitempty"`
+	MentionPermission      *string `protobuf:"bytes,14,opt,name=mention_permission,json=mentionPermission,proto3,oneof" json:"mention_permission,omitempty"`
+	ShowSensitiveContent   *bool   `protobuf:"varint,15,opt,name=show_sensitive_content,json=showSensitiveContent,proto3,oneof" json:"show_sensitive_content,omitempty"`
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -1081,6 +1107,20 @@ func (x *UpdateUserProfileRequest) GetSubscribedToNewsletter() bool {
 	return false
 }
 
+func (x *UpdateUserProfileRequest) GetMentionPermission() string {
+	if x != nil && x.MentionPermission != nil {
+		return *x.MentionPermission
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileRequest) GetShowSensitiveContent() bool {
+	if x != nil && x.ShowSensitiveContent != nil {
+		return *x.ShowSensitiveContent
+	}
+	return false
+}
+
 type FollowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FollowerId    uint32                 `protobuf:"varint,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
@@ -1686,189 +1726,1411 @@ func (x *ApplyForPremiumRequest) GetFacePictureUrl() string {
 	return ""
 }
 
-var File_proto_user_proto protoreflect.FileDescriptor
+type MuteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MuterId       uint32                 `protobuf:"varint,1,opt,name=muter_id,json=muterId,proto3" json:"muter_id,omitempty"`
+	MutedId       uint32                 `protobuf:"varint,2,opt,name=muted_id,json=mutedId,proto3" json:"muted_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_user_proto_rawDesc = "" +
-	"\n" +
-	"\x10proto/user.proto\x12\x04user\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"(\n" +
-	"\x0eHealthResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"\xd1\x03\n" +
-	"\x04User\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
-	"\busername\x18\x03 \x01(\tR\busername\x12\x14\n" +
-	"\x05email\x18\x04 \x01(\tR\x05email\x12\x16\n" +
-	"\x06gender\x18\x05 \x01(\tR\x06gender\x12'\n" +
-	"\x0fprofile_picture\x18\x06 \x01(\tR\x0eprofilePicture\x12\x16\n" +
-	"\x06banner\x18\a \x01(\tR\x06banner\x12\"\n" +
-	"\rdate_of_birth\x18\b \x01(\tR\vdateOfBirth\x12%\n" +
-	"\x0eaccount_status\x18\t \x01(\tR\raccountStatus\x12'\n" +
-	"\x0faccount_privacy\x18\n" +
-	" \x01(\tR\x0eaccountPrivacy\x129\n" +
-	"\n" +
-	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
-	"\x18subscribed_to_newsletter\x18\f \x01(\bR\x16subscribedToNewsletter\x12\x10\n" +
-	"\x03bio\x18\r \x01(\tR\x03bio\x12\x1f\n" +
-	"\vis_verified\x18\x0e \x01(\bR\n" +
-	"isVerified\"\xbf\x03\n" +
-	"\x0fRegisterRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x16\n" +
-	"\x06gender\x18\x05 \x01(\tR\x06gender\x12\"\n" +
-	"\rdate_of_birth\x18\x06 \x01(\tR\vdateOfBirth\x12+\n" +
-	"\x11security_question\x18\a \x01(\tR\x10securityQuestion\x12'\n" +
-	"\x0fsecurity_answer\x18\b \x01(\tR\x0esecurityAnswer\x128\n" +
-	"\x18subscribed_to_newsletter\x18\t \x01(\bR\x16subscribedToNewsletter\x123\n" +
-	"\x13profile_picture_url\x18\n" +
-	" \x01(\tH\x00R\x11profilePictureUrl\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"banner_url\x18\v \x01(\tH\x01R\tbannerUrl\x88\x01\x01B\x16\n" +
-	"\x14_profile_picture_urlB\r\n" +
-	"\v_banner_url\"@\n" +
-	"\fLoginRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"V\n" +
-	"\fAuthResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\">\n" +
-	"\x12VerifyEmailRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
-	"\x04code\x18\x02 \x01(\tR\x04code\"2\n" +
-	"\x1aGetSecurityQuestionRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\"J\n" +
-	"\x1bGetSecurityQuestionResponse\x12+\n" +
-	"\x11security_question\x18\x01 \x01(\tR\x10securityQuestion\"x\n" +
-	"\x14ResetPasswordRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12'\n" +
-	"\x0fsecurity_answer\x18\x02 \x01(\tR\x0esecurityAnswer\x12!\n" +
-	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"6\n" +
-	"\x18GetUserByUsernameRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\"8\n" +
-	"\x1bGetUserProfilesByIdsRequest\x12\x19\n" +
-	"\buser_ids\x18\x01 \x03(\rR\auserIds\"\xa9\x01\n" +
-	"\x1cGetUserProfilesByIdsResponse\x12C\n" +
-	"\x05users\x18\x01 \x03(\v2-.user.GetUserProfilesByIdsResponse.UsersEntryR\x05users\x1aD\n" +
-	"\n" +
-	"UsersEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\rR\x03key\x12 \n" +
-	"\x05value\x18\x02 \x01(\v2\n" +
-	".user.UserR\x05value:\x028\x01\"5\n" +
-	"\x1dResendVerificationCodeRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\"\xa9\x02\n" +
-	"\x13UserProfileResponse\x12\x1e\n" +
-	"\x04user\x18\x01 \x01(\v2\n" +
-	".user.UserR\x04user\x12%\n" +
-	"\x0efollower_count\x18\x02 \x01(\x05R\rfollowerCount\x12'\n" +
-	"\x0ffollowing_count\x18\x03 \x01(\x05R\x0efollowingCount\x127\n" +
-	"\x18is_followed_by_requester\x18\x04 \x01(\bR\x15isFollowedByRequester\x125\n" +
-	"\x17is_blocked_by_requester\x18\x05 \x01(\bR\x14isBlockedByRequester\x122\n" +
-	"\x15is_blocking_requester\x18\x06 \x01(\bR\x13isBlockingRequester\"\x85\x01\n" +
-	"\x15GetUserProfileRequest\x12%\n" +
-	"\x0fuser_id_to_view\x18\x01 \x01(\rR\fuserIdToView\x12/\n" +
-	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01B\x14\n" +
-	"\x12_requester_user_id\"\xf3\x04\n" +
-	"\x18UpdateUserProfileRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x17\n" +
-	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12.\n" +
-	"\x10current_password\x18\x05 \x01(\tH\x01R\x0fcurrentPassword\x88\x01\x01\x12&\n" +
-	"\fnew_password\x18\x06 \x01(\tH\x02R\vnewPassword\x88\x01\x01\x12\x1b\n" +
-	"\x06gender\x18\a \x01(\tH\x03R\x06gender\x88\x01\x01\x123\n" +
-	"\x13profile_picture_url\x18\b \x01(\tH\x04R\x11profilePictureUrl\x88\x01\x01\x12\"\n" +
-	"\n" +
-	"banner_url\x18\t \x01(\tH\x05R\tbannerUrl\x88\x01\x01\x12'\n" +
-	"\rdate_of_birth\x18\n" +
-	" \x01(\tH\x06R\vdateOfBirth\x88\x01\x01\x12\x15\n" +
-	"\x03bio\x18\v \x01(\tH\aR\x03bio\x88\x01\x01\x12,\n" +
-	"\x0faccount_privacy\x18\f \x01(\tH\bR\x0eaccountPrivacy\x88\x01\x01\x12=\n" +
-	"\x18subscribed_to_newsletter\x18\r \x01(\bH\tR\x16subscribedToNewsletter\x88\x01\x01B\a\n" +
-	"\x05_nameB\x13\n" +
-	"\x11_current_passwordB\x0f\n" +
-	"\r_new_passwordB\t\n" +
-	"\a_genderB\x16\n" +
-	"\x14_profile_picture_urlB\r\n" +
-	"\v_banner_urlB\x10\n" +
-	"\x0e_date_of_birthB\x06\n" +
-	"\x04_bioB\x12\n" +
-	"\x10_account_privacyB\x1b\n" +
-	"\x19_subscribed_to_newsletter\"Q\n" +
-	"\rFollowRequest\x12\x1f\n" +
-	"\vfollower_id\x18\x01 \x01(\rR\n" +
-	"followerId\x12\x1f\n" +
-	"\vfollowed_id\x18\x02 \x01(\rR\n" +
-	"followedId\"L\n" +
-	"\fBlockRequest\x12\x1d\n" +
-	"\n" +
-	"blocker_id\x18\x01 \x01(\rR\tblockerId\x12\x1d\n" +
-	"\n" +
-	"blocked_id\x18\x02 \x01(\rR\tblockedId\"\xa0\x01\n" +
-	"\x14GetSocialListRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\rR\x06userId\x12/\n" +
-	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01\x12\x12\n" +
-	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limitB\x14\n" +
-	"\x12_requester_user_id\"t\n" +
-	"\n" +
-	"SocialUser\x12-\n" +
-	"\fuser_summary\x18\x01 \x01(\v2\n" +
-	".user.UserR\vuserSummary\x127\n" +
-	"\x18is_followed_by_requester\x18\x02 \x01(\bR\x15isFollowedByRequester\"Z\n" +
-	"\x15GetSocialListResponse\x12&\n" +
-	"\x05users\x18\x01 \x03(\v2\x10.user.SocialUserR\x05users\x12\x19\n" +
-	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"V\n" +
-	"\x11SocialListRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"J\n" +
-	"\x12UserIDListResponse\x12\x19\n" +
-	"\buser_ids\x18\x01 \x03(\rR\auserIds\x12\x19\n" +
-	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"M\n" +
-	"\x11BlockCheckRequest\x12\x19\n" +
-	"\bactor_id\x18\x01 \x01(\rR\aactorId\x12\x1d\n" +
-	"\n" +
-	"subject_id\x18\x02 \x01(\rR\tsubjectId\".\n" +
-	"\x13BlockStatusResponse\x12\x17\n" +
-	"\ais_true\x18\x01 \x01(\bR\x06isTrue\"V\n" +
-	"\x12FollowCheckRequest\x12\x1f\n" +
-	"\vfollower_id\x18\x01 \x01(\rR\n" +
-	"followerId\x12\x1f\n" +
-	"\vfollowed_id\x18\x02 \x01(\rR\n" +
-	"followedId\"\xbb\x01\n" +
-	"\x16ApplyForPremiumRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\rR\x06userId\x12F\n" +
-	" national_identity_card_no_hashed\x18\x02 \x01(\tR\x1cnationalIdentityCardNoHashed\x12\x16\n" +
-	"\x06reason\x18\x03 \x01(\tR\x06reason\x12(\n" +
-	"\x10face_picture_url\x18\x04 \x01(\tR\x0efacePictureUrl2\x81\r\n" +
-	"\vUserService\x12;\n" +
-	"\vHealthCheck\x12\x16.google.protobuf.Empty\x1a\x14.user.HealthResponse\x129\n" +
-	"\bRegister\x12\x15.user.RegisterRequest\x1a\x16.google.protobuf.Empty\x12/\n" +
-	"\x05Login\x12\x12.user.LoginRequest\x1a\x12.user.AuthResponse\x12?\n" +
-	"\vVerifyEmail\x12\x18.user.VerifyEmailRequest\x1a\x16.google.protobuf.Empty\x12Z\n" +
-	"\x13GetSecurityQuestion\x12 .user.GetSecurityQuestionRequest\x1a!.user.GetSecurityQuestionResponse\x12C\n" +
-	"\rResetPassword\x12\x1a.user.ResetPasswordRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
-	"\x0eGetUserProfile\x12\x1b.user.GetUserProfileRequest\x1a\x19.user.UserProfileResponse\x12]\n" +
-	"\x14GetUserProfilesByIds\x12!.user.GetUserProfilesByIdsRequest\x1a\".user.GetUserProfilesByIdsResponse\x12U\n" +
-	"\x16ResendVerificationCode\x12#.user.ResendVerificationCodeRequest\x1a\x16.google.protobuf.Empty\x129\n" +
-	"\n" +
-	"FollowUser\x12\x13.user.FollowRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
-	"\fUnfollowUser\x12\x13.user.FollowRequest\x1a\x16.google.protobuf.Empty\x127\n" +
-	"\tBlockUser\x12\x12.user.BlockRequest\x1a\x16.google.protobuf.Empty\x129\n" +
-	"\vUnblockUser\x12\x12.user.BlockRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
-	"\fGetFollowers\x12\x1a.user.GetSocialListRequest\x1a\x1b.user.GetSocialListResponse\x12G\n" +
-	"\fGetFollowing\x12\x1a.user.GetSocialListRequest\x1a\x1b.user.GetSocialListResponse\x12?\n" +
-	"\x11GetUserByUsername\x12\x1e.user.GetUserByUsernameRequest\x1a\n" +
-	".user.User\x12?\n" +
-	"\x11UpdateUserProfile\x12\x1e.user.UpdateUserProfileRequest\x1a\n" +
-	".user.User\x12F\n" +
-	"\x11GetBlockedUserIDs\x12\x17.user.SocialListRequest\x1a\x18.user.UserIDListResponse\x12G\n" +
-	"\x12GetBlockingUserIDs\x12\x17.user.SocialListRequest\x1a\x18.user.UserIDListResponse\x12D\n" +
-	"\x0fGetFollowingIDs\x12\x17.user.SocialListRequest\x1a\x18.user.UserIDListResponse\x12A\n" +
-	"\vIsBlockedBy\x12\x17.user.BlockCheckRequest\x1a\x19.user.BlockStatusResponse\x12@\n" +
-	"\n" +
-	"HasBlocked\x12\x17.user.BlockCheckRequest\x1a\x19.user.BlockStatusResponse\x12B\n" +
-	"\vIsFollowing\x12\x18.user.FollowCheckRequest\x1a\x19.user.BlockStatusResponse\x12G\n" +
-	"\x0fApplyForPremium\x12\x1c.user.ApplyForPremiumRequest\x1a\x16.google.protobuf.EmptyBAZ?github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genprotob\x06proto3"
+func (x *MuteRequest) Reset() {
+	*x = MuteRequest{}
+	mi := &file_proto_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteRequest) ProtoMessage() {}
+
+func (x *MuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteRequest.ProtoReflect.Descriptor instead.
+func (*MuteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MuteRequest) GetMuterId() uint32 {
+	if x != nil {
+		return x.MuterId
+	}
+	return 0
+}
+
+func (x *MuteRequest) GetMutedId() uint32 {
+	if x != nil {
+		return x.MutedId
+	}
+	return 0
+}
+
+// Scopes are "home", "replies", "notifications" and "search".
+type MutedWord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Phrase        string                 `protobuf:"bytes,2,opt,name=phrase,proto3" json:"phrase,omitempty"` // lowercased; a word, a phrase or a #hashtag
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"` // unset mutes forever
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutedWord) Reset() {
+	*x = MutedWord{}
+	mi := &file_proto_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutedWord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutedWord) ProtoMessage() {}
+
+func (x *MutedWord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutedWord.ProtoReflect.Descriptor instead.
+func (*MutedWord) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MutedWord) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MutedWord) GetPhrase() string {
+	if x != nil {
+		return x.Phrase
+	}
+	return ""
+}
+
+func (x *MutedWord) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *MutedWord) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *MutedWord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Adding a phrase that is already muted replaces its scopes and expiry.
+type AddMutedWordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Phrase        string                 `protobuf:"bytes,2,opt,name=phrase,proto3" json:"phrase,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"` // empty mutes everywhere
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMutedWordRequest) Reset() {
+	*x = AddMutedWordRequest{}
+	mi := &file_proto_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMutedWordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMutedWordRequest) ProtoMessage() {}
+
+func (x *AddMutedWordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMutedWordRequest.ProtoReflect.Descriptor instead.
+func (*AddMutedWordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddMutedWordRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddMutedWordRequest) GetPhrase() string {
+	if x != nil {
+		return x.Phrase
+	}
+	return ""
+}
+
+func (x *AddMutedWordRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *AddMutedWordRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type RemoveMutedWordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MutedWordId   uint32                 `protobuf:"varint,2,opt,name=muted_word_id,json=mutedWordId,proto3" json:"muted_word_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMutedWordRequest) Reset() {
+	*x = RemoveMutedWordRequest{}
+	mi := &file_proto_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMutedWordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMutedWordRequest) ProtoMessage() {}
+
+func (x *RemoveMutedWordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMutedWordRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMutedWordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RemoveMutedWordRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveMutedWordRequest) GetMutedWordId() uint32 {
+	if x != nil {
+		return x.MutedWordId
+	}
+	return 0
+}
+
+type GetMutedWordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMutedWordsRequest) Reset() {
+	*x = GetMutedWordsRequest{}
+	mi := &file_proto_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMutedWordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutedWordsRequest) ProtoMessage() {}
+
+func (x *GetMutedWordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutedWordsRequest.ProtoReflect.Descriptor instead.
+func (*GetMutedWordsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetMutedWordsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetMutedWordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MutedWords    []*MutedWord           `protobuf:"bytes,1,rep,name=muted_words,json=mutedWords,proto3" json:"muted_words,omitempty"` // unexpired only, newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMutedWordsResponse) Reset() {
+	*x = GetMutedWordsResponse{}
+	mi := &file_proto_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMutedWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutedWordsResponse) ProtoMessage() {}
+
+func (x *GetMutedWordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutedWordsResponse.ProtoReflect.Descriptor instead.
+func (*GetMutedWordsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetMutedWordsResponse) GetMutedWords() []*MutedWord {
+	if x != nil {
+		return x.MutedWords
+	}
+	return nil
+}
+
+type GetMuteFilterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Scope         string                 `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMuteFilterRequest) Reset() {
+	*x = GetMuteFilterRequest{}
+	mi := &file_proto_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMuteFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMuteFilterRequest) ProtoMessage() {}
+
+func (x *GetMuteFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMuteFilterRequest.ProtoReflect.Descriptor instead.
+func (*GetMuteFilterRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetMuteFilterRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetMuteFilterRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+// MuteFilter is everything a user has muted that applies in one scope.
+type MuteFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MutedUserIds  []uint32               `protobuf:"varint,1,rep,packed,name=muted_user_ids,json=mutedUserIds,proto3" json:"muted_user_ids,omitempty"`
+	Phrases       []string               `protobuf:"bytes,2,rep,name=phrases,proto3" json:"phrases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MuteFilter) Reset() {
+	*x = MuteFilter{}
+	mi := &file_proto_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MuteFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuteFilter) ProtoMessage() {}
+
+func (x *MuteFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuteFilter.ProtoReflect.Descriptor instead.
+func (*MuteFilter) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MuteFilter) GetMutedUserIds() []uint32 {
+	if x != nil {
+		return x.MutedUserIds
+	}
+	return nil
+}
+
+func (x *MuteFilter) GetPhrases() []string {
+	if x != nil {
+		return x.Phrases
+	}
+	return nil
+}
+
+type GetContentPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContentPreferencesRequest) Reset() {
+	*x = GetContentPreferencesRequest{}
+	mi := &file_proto_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContentPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContentPreferencesRequest) ProtoMessage() {}
+
+func (x *GetContentPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContentPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetContentPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetContentPreferencesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// ContentPreferences are private settings that other services apply on the user's behalf.
+// They are only returned on the user's own profile, never to other viewers.
+type ContentPreferences struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ShowSensitiveContent bool                   `protobuf:"varint,1,opt,name=show_sensitive_content,json=showSensitiveContent,proto3" json:"show_sensitive_content,omitempty"`
+	MentionPermission    string                 `protobuf:"bytes,2,opt,name=mention_permission,json=mentionPermission,proto3" json:"mention_permission,omitempty"` // everyone, following or none
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ContentPreferences) Reset() {
+	*x = ContentPreferences{}
+	mi := &file_proto_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentPreferences) ProtoMessage() {}
+
+func (x *ContentPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentPreferences.ProtoReflect.Descriptor instead.
+func (*ContentPreferences) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ContentPreferences) GetShowSensitiveContent() bool {
+	if x != nil {
+		return x.ShowSensitiveContent
+	}
+	return false
+}
+
+func (x *ContentPreferences) GetMentionPermission() string {
+	if x != nil {
+		return x.MentionPermission
+	}
+	return ""
+}
+
+// A private list is only visible to its owner, and can't be followed.
+type List struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner                 *User                  `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Name                  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description           string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	IsPrivate             bool                   `protobuf:"varint,5,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	MemberCount           int32                  `protobuf:"varint,6,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	FollowerCount         int32                  `protobuf:"varint,7,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	IsFollowedByRequester bool                   `protobuf:"varint,8,opt,name=is_followed_by_requester,json=isFollowedByRequester,proto3" json:"is_followed_by_requester,omitempty"`
+	CreatedAt             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *List) Reset() {
+	*x = List{}
+	mi := &file_proto_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *List) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*List) ProtoMessage() {}
+
+func (x *List) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use List.ProtoReflect.Descriptor instead.
+func (*List) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *List) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *List) GetOwner() *User {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *List) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *List) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *List) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+func (x *List) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *List) GetFollowerCount() int32 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+func (x *List) GetIsFollowedByRequester() bool {
+	if x != nil {
+		return x.IsFollowedByRequester
+	}
+	return false
+}
+
+func (x *List) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       uint32                 `protobuf:"varint,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	IsPrivate     bool                   `protobuf:"varint,4,opt,name=is_private,json=isPrivate,proto3" json:"is_private,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateListRequest) Reset() {
+	*x = CreateListRequest{}
+	mi := &file_proto_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateListRequest) ProtoMessage() {}
+
+func (x *CreateListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateListRequest.ProtoReflect.Descriptor instead.
+func (*CreateListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateListRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *CreateListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateListRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateListRequest) GetIsPrivate() bool {
+	if x != nil {
+		return x.IsPrivate
+	}
+	return false
+}
+
+// Only the owner can update a list. Making it private drops its followers.
+type UpdateListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        uint32                 `protobuf:"varint,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	RequesterId   uint32                 `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	IsPrivate     *bool                  `protobuf:"varint,5,opt,name=is_private,json=isPrivate,proto3,oneof" json:"is_private,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateListRequest) Reset() {
+	*x = UpdateListRequest{}
+	mi := &file_proto_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateListRequest) ProtoMessage() {}
+
+func (x *UpdateListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateListRequest.ProtoReflect.Descriptor instead.
+func (*UpdateListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateListRequest) GetListId() uint32 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *UpdateListRequest) GetRequesterId() uint32 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *UpdateListRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateListRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateListRequest) GetIsPrivate() bool {
+	if x != nil && x.IsPrivate != nil {
+		return *x.IsPrivate
+	}
+	return false
+}
+
+// Someone else's private list resolves as not found.
+type ListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        uint32                 `protobuf:"varint,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	RequesterId   uint32                 `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"` // 0 when logged out
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	mi := &file_proto_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListRequest) GetListId() uint32 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *ListRequest) GetRequesterId() uint32 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+type GetUserListsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequesterId   uint32                 `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserListsRequest) Reset() {
+	*x = GetUserListsRequest{}
+	mi := &file_proto_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserListsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserListsRequest) ProtoMessage() {}
+
+func (x *GetUserListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserListsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserListsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetUserListsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserListsRequest) GetRequesterId() uint32 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *GetUserListsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUserListsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetListsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lists         []*List                `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListsResponse) Reset() {
+	*x = GetListsResponse{}
+	mi := &file_proto_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListsResponse) ProtoMessage() {}
+
+func (x *GetListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListsResponse.ProtoReflect.Descriptor instead.
+func (*GetListsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetListsResponse) GetLists() []*List {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+func (x *GetListsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type ListMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        uint32                 `protobuf:"varint,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	RequesterId   uint32                 `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"` // must own the list
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemberRequest) Reset() {
+	*x = ListMemberRequest{}
+	mi := &file_proto_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemberRequest) ProtoMessage() {}
+
+func (x *ListMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemberRequest.ProtoReflect.Descriptor instead.
+func (*ListMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListMemberRequest) GetListId() uint32 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *ListMemberRequest) GetRequesterId() uint32 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *ListMemberRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetListMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        uint32                 `protobuf:"varint,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	RequesterId   uint32                 `protobuf:"varint,2,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListMembersRequest) Reset() {
+	*x = GetListMembersRequest{}
+	mi := &file_proto_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListMembersRequest) ProtoMessage() {}
+
+func (x *GetListMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListMembersRequest.ProtoReflect.Descriptor instead.
+func (*GetListMembersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetListMembersRequest) GetListId() uint32 {
+	if x != nil {
+		return x.ListId
+	}
+	return 0
+}
+
+func (x *GetListMembersRequest) GetRequesterId() uint32 {
+	if x != nil {
+		return x.RequesterId
+	}
+	return 0
+}
+
+func (x *GetListMembersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetListMembersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+var File_proto_user_proto protoreflect.FileDescriptor
+
+const file_proto_user_proto_rawDesc = "" +
+	"\n" +
+	"\x10proto/user.proto\x12\x04user\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"(\n" +
+	"\x0eHealthResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\xb6\x04\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x16\n" +
+	"\x06gender\x18\x05 \x01(\tR\x06gender\x12'\n" +
+	"\x0fprofile_picture\x18\x06 \x01(\tR\x0eprofilePicture\x12\x16\n" +
+	"\x06banner\x18\a \x01(\tR\x06banner\x12\"\n" +
+	"\rdate_of_birth\x18\b \x01(\tR\vdateOfBirth\x12%\n" +
+	"\x0eaccount_status\x18\t \x01(\tR\raccountStatus\x12'\n" +
+	"\x0faccount_privacy\x18\n" +
+	" \x01(\tR\x0eaccountPrivacy\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\x18subscribed_to_newsletter\x18\f \x01(\bR\x16subscribedToNewsletter\x12\x10\n" +
+	"\x03bio\x18\r \x01(\tR\x03bio\x12\x1f\n" +
+	"\vis_verified\x18\x0e \x01(\bR\n" +
+	"isVerified\x12-\n" +
+	"\x12mention_permission\x18\x0f \x01(\tR\x11mentionPermission\x124\n" +
+	"\x16show_sensitive_content\x18\x10 \x01(\bR\x14showSensitiveContent\"\xbf\x03\n" +
+	"\x0fRegisterRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06gender\x18\x05 \x01(\tR\x06gender\x12\"\n" +
+	"\rdate_of_birth\x18\x06 \x01(\tR\vdateOfBirth\x12+\n" +
+	"\x11security_question\x18\a \x01(\tR\x10securityQuestion\x12'\n" +
+	"\x0fsecurity_answer\x18\b \x01(\tR\x0esecurityAnswer\x128\n" +
+	"\x18subscribed_to_newsletter\x18\t \x01(\bR\x16subscribedToNewsletter\x123\n" +
+	"\x13profile_picture_url\x18\n" +
+	" \x01(\tH\x00R\x11profilePictureUrl\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"banner_url\x18\v \x01(\tH\x01R\tbannerUrl\x88\x01\x01B\x16\n" +
+	"\x14_profile_picture_urlB\r\n" +
+	"\v_banner_url\"@\n" +
+	"\fLoginRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"V\n" +
+	"\fAuthResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\">\n" +
+	"\x12VerifyEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"2\n" +
+	"\x1aGetSecurityQuestionRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"J\n" +
+	"\x1bGetSecurityQuestionResponse\x12+\n" +
+	"\x11security_question\x18\x01 \x01(\tR\x10securityQuestion\"x\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12'\n" +
+	"\x0fsecurity_answer\x18\x02 \x01(\tR\x0esecurityAnswer\x12!\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"6\n" +
+	"\x18GetUserByUsernameRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"8\n" +
+	"\x1bGetUserProfilesByIdsRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\rR\auserIds\"\xa9\x01\n" +
+	"\x1cGetUserProfilesByIdsResponse\x12C\n" +
+	"\x05users\x18\x01 \x03(\v2-.user.GetUserProfilesByIdsResponse.UsersEntryR\x05users\x1aD\n" +
+	"\n" +
+	"UsersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\rR\x03key\x12 \n" +
+	"\x05value\x18\x02 \x01(\v2\n" +
+	".user.UserR\x05value:\x028\x01\"5\n" +
+	"\x1dResendVerificationCodeRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"\xdc\x02\n" +
+	"\x13UserProfileResponse\x12\x1e\n" +
+	"\x04user\x18\x01 \x01(\v2\n" +
+	".user.UserR\x04user\x12%\n" +
+	"\x0efollower_count\x18\x02 \x01(\x05R\rfollowerCount\x12'\n" +
+	"\x0ffollowing_count\x18\x03 \x01(\x05R\x0efollowingCount\x127\n" +
+	"\x18is_followed_by_requester\x18\x04 \x01(\bR\x15isFollowedByRequester\x125\n" +
+	"\x17is_blocked_by_requester\x18\x05 \x01(\bR\x14isBlockedByRequester\x122\n" +
+	"\x15is_blocking_requester\x18\x06 \x01(\bR\x13isBlockingRequester\x121\n" +
+	"\x15is_muted_by_requester\x18\a \x01(\bR\x12isMutedByRequester\"\x85\x01\n" +
+	"\x15GetUserProfileRequest\x12%\n" +
+	"\x0fuser_id_to_view\x18\x01 \x01(\rR\fuserIdToView\x12/\n" +
+	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01B\x14\n" +
+	"\x12_requester_user_id\"\x94\x06\n" +
+	"\x18UpdateUserProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12.\n" +
+	"\x10current_password\x18\x05 \x01(\tH\x01R\x0fcurrentPassword\x88\x01\x01\x12&\n" +
+	"\fnew_password\x18\x06 \x01(\tH\x02R\vnewPassword\x88\x01\x01\x12\x1b\n" +
+	"\x06gender\x18\a \x01(\tH\x03R\x06gender\x88\x01\x01\x123\n" +
+	"\x13profile_picture_url\x18\b \x01(\tH\x04R\x11profilePictureUrl\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"banner_url\x18\t \x01(\tH\x05R\tbannerUrl\x88\x01\x01\x12'\n" +
+	"\rdate_of_birth\x18\n" +
+	" \x01(\tH\x06R\vdateOfBirth\x88\x01\x01\x12\x15\n" +
+	"\x03bio\x18\v \x01(\tH\aR\x03bio\x88\x01\x01\x12,\n" +
+	"\x0faccount_privacy\x18\f \x01(\tH\bR\x0eaccountPrivacy\x88\x01\x01\x12=\n" +
+	"\x18subscribed_to_newsletter\x18\r \x01(\bH\tR\x16subscribedToNewsletter\x88\x01\x01\x122\n" +
+	"\x12mention_permission\x18\x0e \x01(\tH\n" +
+	"R\x11mentionPermission\x88\x01\x01\x129\n" +
+	"\x16show_sensitive_content\x18\x0f \x01(\bH\vR\x14showSensitiveContent\x88\x01\x01B\a\n" +
+	"\x05_nameB\x13\n" +
+	"\x11_current_passwordB\x0f\n" +
+	"\r_new_passwordB\t\n" +
+	"\a_genderB\x16\n" +
+	"\x14_profile_picture_urlB\r\n" +
+	"\v_banner_urlB\x10\n" +
+	"\x0e_date_of_birthB\x06\n" +
+	"\x04_bioB\x12\n" +
+	"\x10_account_privacyB\x1b\n" +
+	"\x19_subscribed_to_newsletterB\x15\n" +
+	"\x13_mention_permissionB\x19\n" +
+	"\x17_show_sensitive_content\"Q\n" +
+	"\rFollowRequest\x12\x1f\n" +
+	"\vfollower_id\x18\x01 \x01(\rR\n" +
+	"followerId\x12\x1f\n" +
+	"\vfollowed_id\x18\x02 \x01(\rR\n" +
+	"followedId\"L\n" +
+	"\fBlockRequest\x12\x1d\n" +
+	"\n" +
+	"blocker_id\x18\x01 \x01(\rR\tblockerId\x12\x1d\n" +
+	"\n" +
+	"blocked_id\x18\x02 \x01(\rR\tblockedId\"\xa0\x01\n" +
+	"\x14GetSocialListRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12/\n" +
+	"\x11requester_user_id\x18\x02 \x01(\rH\x00R\x0frequesterUserId\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limitB\x14\n" +
+	"\x12_requester_user_id\"t\n" +
+	"\n" +
+	"SocialUser\x12-\n" +
+	"\fuser_summary\x18\x01 \x01(\v2\n" +
+	".user.UserR\vuserSummary\x127\n" +
+	"\x18is_followed_by_requester\x18\x02 \x01(\bR\x15isFollowedByRequester\"Z\n" +
+	"\x15GetSocialListResponse\x12&\n" +
+	"\x05users\x18\x01 \x03(\v2\x10.user.SocialUserR\x05users\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"V\n" +
+	"\x11SocialListRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"J\n" +
+	"\x12UserIDListResponse\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\rR\auserIds\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"M\n" +
+	"\x11BlockCheckRequest\x12\x19\n" +
+	"\bactor_id\x18\x01 \x01(\rR\aactorId\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x02 \x01(\rR\tsubjectId\".\n" +
+	"\x13BlockStatusResponse\x12\x17\n" +
+	"\ais_true\x18\x01 \x01(\bR\x06isTrue\"V\n" +
+	"\x12FollowCheckRequest\x12\x1f\n" +
+	"\vfollower_id\x18\x01 \x01(\rR\n" +
+	"followerId\x12\x1f\n" +
+	"\vfollowed_id\x18\x02 \x01(\rR\n" +
+	"followedId\"\xbb\x01\n" +
+	"\x16ApplyForPremiumRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12F\n" +
+	" national_identity_card_no_hashed\x18\x02 \x01(\tR\x1cnationalIdentityCardNoHashed\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12(\n" +
+	"\x10face_picture_url\x18\x04 \x01(\tR\x0efacePictureUrl\"C\n" +
+	"\vMuteRequest\x12\x19\n" +
+	"\bmuter_id\x18\x01 \x01(\rR\amuterId\x12\x19\n" +
+	"\bmuted_id\x18\x02 \x01(\rR\amutedId\"\xd5\x01\n" +
+	"\tMutedWord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x16\n" +
+	"\x06phrase\x18\x02 \x01(\tR\x06phrase\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12>\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\r\n" +
+	"\v_expires_at\"\xad\x01\n" +
+	"\x13AddMutedWordRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x16\n" +
+	"\x06phrase\x18\x02 \x01(\tR\x06phrase\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12>\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"U\n" +
+	"\x16RemoveMutedWordRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\"\n" +
+	"\rmuted_word_id\x18\x02 \x01(\rR\vmutedWordId\"/\n" +
+	"\x14GetMutedWordsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"I\n" +
+	"\x15GetMutedWordsResponse\x120\n" +
+	"\vmuted_words\x18\x01 \x03(\v2\x0f.user.MutedWordR\n" +
+	"mutedWords\"E\n" +
+	"\x14GetMuteFilterRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x14\n" +
+	"\x05scope\x18\x02 \x01(\tR\x05scope\"L\n" +
+	"\n" +
+	"MuteFilter\x12$\n" +
+	"\x0emuted_user_ids\x18\x01 \x03(\rR\fmutedUserIds\x12\x18\n" +
+	"\aphrases\x18\x02 \x03(\tR\aphrases\"7\n" +
+	"\x1cGetContentPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"y\n" +
+	"\x12ContentPreferences\x124\n" +
+	"\x16show_sensitive_content\x18\x01 \x01(\bR\x14showSensitiveContent\x12-\n" +
+	"\x12mention_permission\x18\x02 \x01(\tR\x11mentionPermission\"\xcb\x02\n" +
+	"\x04List\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12 \n" +
+	"\x05owner\x18\x02 \x01(\v2\n" +
+	".user.UserR\x05owner\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x05 \x01(\bR\tisPrivate\x12!\n" +
+	"\fmember_count\x18\x06 \x01(\x05R\vmemberCount\x12%\n" +
+	"\x0efollower_count\x18\a \x01(\x05R\rfollowerCount\x127\n" +
+	"\x18is_followed_by_requester\x18\b \x01(\bR\x15isFollowedByRequester\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x83\x01\n" +
+	"\x11CreateListRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\rR\aownerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"is_private\x18\x04 \x01(\bR\tisPrivate\"\xdb\x01\n" +
+	"\x11UpdateListRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\rR\x06listId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\rR\vrequesterId\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"is_private\x18\x05 \x01(\bH\x02R\tisPrivate\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_descriptionB\r\n" +
+	"\v_is_private\"I\n" +
+	"\vListRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\rR\x06listId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\rR\vrequesterId\"{\n" +
+	"\x13GetUserListsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\rR\vrequesterId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"O\n" +
+	"\x10GetListsResponse\x12 \n" +
+	"\x05lists\x18\x01 \x03(\v2\n" +
+	".user.ListR\x05lists\x12\x19\n" +
+	"\bhas_more\x18\x02 \x01(\bR\ahasMore\"h\n" +
+	"\x11ListMemberRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\rR\x06listId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\rR\vrequesterId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\"}\n" +
+	"\x15GetListMembersRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\rR\x06listId\x12!\n" +
+	"\frequester_id\x18\x02 \x01(\rR\vrequesterId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit2\xc0\x17\n" +
+	"\vUserService\x12;\n" +
+	"\vHealthCheck\x12\x16.google.protobuf.Empty\x1a\x14.user.HealthResponse\x129\n" +
+	"\bRegister\x12\x15.user.RegisterRequest\x1a\x16.google.protobuf.Empty\x12/\n" +
+	"\x05Login\x12\x12.user.LoginRequest\x1a\x12.user.AuthResponse\x12?\n" +
+	"\vVerifyEmail\x12\x18.user.VerifyEmailRequest\x1a\x16.google.protobuf.Empty\x12Z\n" +
+	"\x13GetSecurityQuestion\x12 .user.GetSecurityQuestionRequest\x1a!.user.GetSecurityQuestionResponse\x12C\n" +
+	"\rResetPassword\x12\x1a.user.ResetPasswordRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\x0eGetUserProfile\x12\x1b.user.GetUserProfileRequest\x1a\x19.user.UserProfileResponse\x12]\n" +
+	"\x14GetUserProfilesByIds\x12!.user.GetUserProfilesByIdsRequest\x1a\".user.GetUserProfilesByIdsResponse\x12U\n" +
+	"\x16ResendVerificationCode\x12#.user.ResendVerificationCodeRequest\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\n" +
+	"FollowUser\x12\x13.user.FollowRequest\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\fUnfollowUser\x12\x13.user.FollowRequest\x1a\x16.google.protobuf.Empty\x127\n" +
+	"\tBlockUser\x12\x12.user.BlockRequest\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\vUnblockUser\x12\x12.user.BlockRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\fGetFollowers\x12\x1a.user.GetSocialListRequest\x1a\x1b.user.GetSocialListResponse\x12G\n" +
+	"\fGetFollowing\x12\x1a.user.GetSocialListRequest\x1a\x1b.user.GetSocialListResponse\x12?\n" +
+	"\x11GetUserByUsername\x12\x1e.user.GetUserByUsernameRequest\x1a\n" +
+	".user.User\x12?\n" +
+	"\x11UpdateUserProfile\x12\x1e.user.UpdateUserProfileRequest\x1a\n" +
+	".user.User\x12F\n" +
+	"\x11GetBlockedUserIDs\x12\x17.user.SocialListRequest\x1a\x18.user.UserIDListResponse\x12G\n" +
+	"\x12GetBlockingUserIDs\x12\x17.user.SocialListRequest\x1a\x18.user.UserIDListResponse\x12D\n" +
+	"\x0fGetFollowingIDs\x12\x17.user.SocialListRequest\x1a\x18.user.UserIDListResponse\x12C\n" +
+	"\x0eGetFollowerIDs\x12\x17.user.SocialListRequest\x1a\x18.user.UserIDListResponse\x12A\n" +
+	"\vIsBlockedBy\x12\x17.user.BlockCheckRequest\x1a\x19.user.BlockStatusResponse\x12@\n" +
+	"\n" +
+	"HasBlocked\x12\x17.user.BlockCheckRequest\x1a\x19.user.BlockStatusResponse\x12B\n" +
+	"\vIsFollowing\x12\x18.user.FollowCheckRequest\x1a\x19.user.BlockStatusResponse\x12G\n" +
+	"\x0fApplyForPremium\x12\x1c.user.ApplyForPremiumRequest\x1a\x16.google.protobuf.Empty\x125\n" +
+	"\bMuteUser\x12\x11.user.MuteRequest\x1a\x16.google.protobuf.Empty\x127\n" +
+	"\n" +
+	"UnmuteUser\x12\x11.user.MuteRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\rGetMutedUsers\x12\x1a.user.GetSocialListRequest\x1a\x1b.user.GetSocialListResponse\x12:\n" +
+	"\fAddMutedWord\x12\x19.user.AddMutedWordRequest\x1a\x0f.user.MutedWord\x12G\n" +
+	"\x0fRemoveMutedWord\x12\x1c.user.RemoveMutedWordRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\rGetMutedWords\x12\x1a.user.GetMutedWordsRequest\x1a\x1b.user.GetMutedWordsResponse\x12=\n" +
+	"\rGetMuteFilter\x12\x1a.user.GetMuteFilterRequest\x1a\x10.user.MuteFilter\x12U\n" +
+	"\x15GetContentPreferences\x12\".user.GetContentPreferencesRequest\x1a\x18.user.ContentPreferences\x121\n" +
+	"\n" +
+	"CreateList\x12\x17.user.CreateListRequest\x1a\n" +
+	".user.List\x121\n" +
+	"\n" +
+	"UpdateList\x12\x17.user.UpdateListRequest\x1a\n" +
+	".user.List\x127\n" +
+	"\n" +
+	"DeleteList\x12\x11.user.ListRequest\x1a\x16.google.protobuf.Empty\x12(\n" +
+	"\aGetList\x12\x11.user.ListRequest\x1a\n" +
+	".user.List\x12A\n" +
+	"\fGetUserLists\x12\x19.user.GetUserListsRequest\x1a\x16.user.GetListsResponse\x12E\n" +
+	"\x10GetFollowedLists\x12\x19.user.GetUserListsRequest\x1a\x16.user.GetListsResponse\x12@\n" +
+	"\rAddListMember\x12\x17.user.ListMemberRequest\x1a\x16.google.protobuf.Empty\x12C\n" +
+	"\x10RemoveListMember\x12\x17.user.ListMemberRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\x0eGetListMembers\x12\x1b.user.GetListMembersRequest\x1a\x1b.user.GetSocialListResponse\x12?\n" +
+	"\x10GetListMemberIDs\x12\x11.user.ListRequest\x1a\x18.user.UserIDListResponse\x127\n" +
+	"\n" +
+	"FollowList\x12\x11.user.ListRequest\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\fUnfollowList\x12\x11.user.ListRequest\x1a\x16.google.protobuf.EmptyBAZ?github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genprotob\x06proto3"
 
 var (
 	file_proto_user_proto_rawDescOnce sync.Once
@@ -1882,7 +3144,7 @@ func file_proto_user_proto_rawDescGZIP() []byte {
 	return file_proto_user_proto_rawDescData
 }
 
-var file_proto_user_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_proto_user_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_proto_user_proto_goTypes = []any{
 	(*HealthResponse)(nil),                // 0: user.HealthResponse
 	(*User)(nil),                          // 1: user.User
@@ -1911,70 +3173,137 @@ var file_proto_user_proto_goTypes = []any{
 	(*BlockStatusResponse)(nil),           // 24: user.BlockStatusResponse
 	(*FollowCheckRequest)(nil),            // 25: user.FollowCheckRequest
 	(*ApplyForPremiumRequest)(nil),        // 26: user.ApplyForPremiumRequest
-	nil,                                   // 27: user.GetUserProfilesByIdsResponse.UsersEntry
-	(*timestamppb.Timestamp)(nil),         // 28: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                 // 29: google.protobuf.Empty
+	(*MuteRequest)(nil),                   // 27: user.MuteRequest
+	(*MutedWord)(nil),                     // 28: user.MutedWord
+	(*AddMutedWordRequest)(nil),           // 29: user.AddMutedWordRequest
+	(*RemoveMutedWordRequest)(nil),        // 30: user.RemoveMutedWordRequest
+	(*GetMutedWordsRequest)(nil),          // 31: user.GetMutedWordsRequest
+	(*GetMutedWordsResponse)(nil),         // 32: user.GetMutedWordsResponse
+	(*GetMuteFilterRequest)(nil),          // 33: user.GetMuteFilterRequest
+	(*MuteFilter)(nil),                    // 34: user.MuteFilter
+	(*GetContentPreferencesRequest)(nil),  // 35: user.GetContentPreferencesRequest
+	(*ContentPreferences)(nil),            // 36: user.ContentPreferences
+	(*List)(nil),                          // 37: user.List
+	(*CreateListRequest)(nil),             // 38: user.CreateListRequest
+	(*UpdateListRequest)(nil),             // 39: user.UpdateListRequest
+	(*ListRequest)(nil),                   // 40: user.ListRequest
+	(*GetUserListsRequest)(nil),           // 41: user.GetUserListsRequest
+	(*GetListsResponse)(nil),              // 42: user.GetListsResponse
+	(*ListMemberRequest)(nil),             // 43: user.ListMemberRequest
+	(*GetListMembersRequest)(nil),         // 44: user.GetListMembersRequest
+	nil,                                   // 45: user.GetUserProfilesByIdsResponse.UsersEntry
+	(*timestamppb.Timestamp)(nil),         // 46: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                 // 47: google.protobuf.Empty
 }
 var file_proto_user_proto_depIdxs = []int32{
-	28, // 0: user.User.created_at:type_name -> google.protobuf.Timestamp
-	27, // 1: user.GetUserProfilesByIdsResponse.users:type_name -> user.GetUserProfilesByIdsResponse.UsersEntry
+	46, // 0: user.User.created_at:type_name -> google.protobuf.Timestamp
+	45, // 1: user.GetUserProfilesByIdsResponse.users:type_name -> user.GetUserProfilesByIdsResponse.UsersEntry
 	1,  // 2: user.UserProfileResponse.user:type_name -> user.User
 	1,  // 3: user.SocialUser.user_summary:type_name -> user.User
 	19, // 4: user.GetSocialListResponse.users:type_name -> user.SocialUser
-	1,  // 5: user.GetUserProfilesByIdsResponse.UsersEntry.value:type_name -> user.User
-	29, // 6: user.UserService.HealthCheck:input_type -> google.protobuf.Empty
-	2,  // 7: user.UserService.Register:input_type -> user.RegisterRequest
-	3,  // 8: user.UserService.Login:input_type -> user.LoginRequest
-	5,  // 9: user.UserService.VerifyEmail:input_type -> user.VerifyEmailRequest
-	6,  // 10: user.UserService.GetSecurityQuestion:input_type -> user.GetSecurityQuestionRequest
-	8,  // 11: user.UserService.ResetPassword:input_type -> user.ResetPasswordRequest
-	14, // 12: user.UserService.GetUserProfile:input_type -> user.GetUserProfileRequest
-	10, // 13: user.UserService.GetUserProfilesByIds:input_type -> user.GetUserProfilesByIdsRequest
-	12, // 14: user.UserService.ResendVerificationCode:input_type -> user.ResendVerificationCodeRequest
-	16, // 15: user.UserService.FollowUser:input_type -> user.FollowRequest
-	16, // 16: user.UserService.UnfollowUser:input_type -> user.FollowRequest
-	17, // 17: user.UserService.BlockUser:input_type -> user.BlockRequest
-	17, // 18: user.UserService.UnblockUser:input_type -> user.BlockRequest
-	18, // 19: user.UserService.GetFollowers:input_type -> user.GetSocialListRequest
-	18, // 20: user.UserService.GetFollowing:input_type -> user.GetSocialListRequest
-	9,  // 21: user.UserService.GetUserByUsername:input_type -> user.GetUserByUsernameRequest
-	15, // 22: user.UserService.UpdateUserProfile:input_type -> user.UpdateUserProfileRequest
-	21, // 23: user.UserService.GetBlockedUserIDs:input_type -> user.SocialListRequest
-	21, // 24: user.UserService.GetBlockingUserIDs:input_type -> user.SocialListRequest
-	21, // 25: user.UserService.GetFollowingIDs:input_type -> user.SocialListRequest
-	23, // 26: user.UserService.IsBlockedBy:input_type -> user.BlockCheckRequest
-	23, // 27: user.UserService.HasBlocked:input_type -> user.BlockCheckRequest
-	25, // 28: user.UserService.IsFollowing:input_type -> user.FollowCheckRequest
-	26, // 29: user.UserService.ApplyForPremium:input_type -> user.ApplyForPremiumRequest
-	0,  // 30: user.UserService.HealthCheck:output_type -> user.HealthResponse
-	29, // 31: user.UserService.Register:output_type -> google.protobuf.Empty
-	4,  // 32: user.UserService.Login:output_type -> user.AuthResponse
-	29, // 33: user.UserService.VerifyEmail:output_type -> google.protobuf.Empty
-	7,  // 34: user.UserService.GetSecurityQuestion:output_type -> user.GetSecurityQuestionResponse
-	29, // 35: user.UserService.ResetPassword:output_type -> google.protobuf.Empty
-	13, // 36: user.UserService.GetUserProfile:output_type -> user.UserProfileResponse
-	11, // 37: user.UserService.GetUserProfilesByIds:output_type -> user.GetUserProfilesByIdsResponse
-	29, // 38: user.UserService.ResendVerificationCode:output_type -> google.protobuf.Empty
-	29, // 39: user.UserService.FollowUser:output_type -> google.protobuf.Empty
-	29, // 40: user.UserService.UnfollowUser:output_type -> google.protobuf.Empty
-	29, // 41: user.UserService.BlockUser:output_type -> google.protobuf.Empty
-	29, // 42: user.UserService.UnblockUser:output_type -> google.protobuf.Empty
-	20, // 43: user.UserService.GetFollowers:output_type -> user.GetSocialListResponse
-	20, // 44: user.UserService.GetFollowing:output_type -> user.GetSocialListResponse
-	1,  // 45: user.UserService.GetUserByUsername:output_type -> user.User
-	1,  // 46: user.UserService.UpdateUserProfile:output_type -> user.User
-	22, // 47: user.UserService.GetBlockedUserIDs:output_type -> user.UserIDListResponse
-	22, // 48: user.UserService.GetBlockingUserIDs:output_type -> user.UserIDListResponse
-	22, // 49: user.UserService.GetFollowingIDs:output_type -> user.UserIDListResponse
-	24, // 50: user.UserService.IsBlockedBy:output_type -> user.BlockStatusResponse
-	24, // 51: user.UserService.HasBlocked:output_type -> user.BlockStatusResponse
-	24, // 52: user.UserService.IsFollowing:output_type -> user.BlockStatusResponse
-	29, // 53: user.UserService.ApplyForPremium:output_type -> google.protobuf.Empty
-	30, // [30:54] is the sub-list for method output_type
-	6,  // [6:30] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	46, // 5: user.MutedWord.expires_at:type_name -> google.protobuf.Timestamp
+	46, // 6: user.MutedWord.created_at:type_name -> google.protobuf.Timestamp
+	46, // 7: user.AddMutedWordRequest.expires_at:type_name -> google.protobuf.Timestamp
+	28, // 8: user.GetMutedWordsResponse.muted_words:type_name -> user.MutedWord
+	1,  // 9: user.List.owner:type_name -> user.User
+	46, // 10: user.List.created_at:type_name -> google.protobuf.Timestamp
+	37, // 11: user.GetListsResponse.lists:type_name -> user.List
+	1,  // 12: user.GetUserProfilesByIdsResponse.UsersEntry.value:type_name -> user.User
+	47, // 13: user.UserService.HealthCheck:input_type -> google.protobuf.Empty
+	2,  // 14: user.UserService.Register:input_type -> user.RegisterRequest
+	3,  // 15: user.UserService.Login:input_type -> user.LoginRequest
+	5,  // 16: user.UserService.VerifyEmail:input_type -> user.VerifyEmailRequest
+	6,  // 17: user.UserService.GetSecurityQuestion:input_type -> user.GetSecurityQuestionRequest
+	8,  // 18: user.UserService.ResetPassword:input_type -> user.ResetPasswordRequest
+	14, // 19: user.UserService.GetUserProfile:input_type -> user.GetUserProfileRequest
+	10, // 20: user.UserService.GetUserProfilesByIds:input_type -> user.GetUserProfilesByIdsRequest
+	12, // 21: user.UserService.ResendVerificationCode:input_type -> user.ResendVerificationCodeRequest
+	16, // 22: user.UserService.FollowUser:input_type -> user.FollowRequest
+	16, // 23: user.UserService.UnfollowUser:input_type -> user.FollowRequest
+	17, // 24: user.UserService.BlockUser:input_type -> user.BlockRequest
+	17, // 25: user.UserService.UnblockUser:input_type -> user.BlockRequest
+	18, // 26: user.UserService.GetFollowers:input_type -> user.GetSocialListRequest
+	18, // 27: user.UserService.GetFollowing:input_type -> user.GetSocialListRequest
+	9,  // 28: user.UserService.GetUserByUsername:input_type -> user.GetUserByUsernameRequest
+	15, // 29: user.UserService.UpdateUserProfile:input_type -> user.UpdateUserProfileRequest
+	21, // 30: user.UserService.GetBlockedUserIDs:input_type -> user.SocialListRequest
+	21, // 31: user.UserService.GetBlockingUserIDs:input_type -> user.SocialListRequest
+	21, // 32: user.UserService.GetFollowingIDs:input_type -> user.SocialListRequest
+	21, // 33: user.UserService.GetFollowerIDs:input_type -> user.SocialListRequest
+	23, // 34: user.UserService.IsBlockedBy:input_type -> user.BlockCheckRequest
+	23, // 35: user.UserService.HasBlocked:input_type -> user.BlockCheckRequest
+	25, // 36: user.UserService.IsFollowing:input_type -> user.FollowCheckRequest
+	26, // 37: user.UserService.ApplyForPremium:input_type -> user.ApplyForPremiumRequest
+	27, // 38: user.UserService.MuteUser:input_type -> user.MuteRequest
+	27, // 39: user.UserService.UnmuteUser:input_type -> user.MuteRequest
+	18, // 40: user.UserService.GetMutedUsers:input_type -> user.GetSocialListRequest
+	29, // 41: user.UserService.AddMutedWord:input_type -> user.AddMutedWordRequest
+	30, // 42: user.UserService.RemoveMutedWord:input_type -> user.RemoveMutedWordRequest
+	31, // 43: user.UserService.GetMutedWords:input_type -> user.GetMutedWordsRequest
+	33, // 44: user.UserService.GetMuteFilter:input_type -> user.GetMuteFilterRequest
+	35, // 45: user.UserService.GetContentPreferences:input_type -> user.GetContentPreferencesRequest
+	38, // 46: user.UserService.CreateList:input_type -> user.CreateListRequest
+	39, // 47: user.UserService.UpdateList:input_type -> user.UpdateListRequest
+	40, // 48: user.UserService.DeleteList:input_type -> user.ListRequest
+	40, // 49: user.UserService.GetList:input_type -> user.ListRequest
+	41, // 50: user.UserService.GetUserLists:input_type -> user.GetUserListsRequest
+	41, // 51: user.UserService.GetFollowedLists:input_type -> user.GetUserListsRequest
+	43, // 52: user.UserService.AddListMember:input_type -> user.ListMemberRequest
+	43, // 53: user.UserService.RemoveListMember:input_type -> user.ListMemberRequest
+	44, // 54: user.UserService.GetListMembers:input_type -> user.GetListMembersRequest
+	40, // 55: user.UserService.GetListMemberIDs:input_type -> user.ListRequest
+	40, // 56: user.UserService.FollowList:input_type -> user.ListRequest
+	40, // 57: user.UserService.UnfollowList:input_type -> user.ListRequest
+	0,  // 58: user.UserService.HealthCheck:output_type -> user.HealthResponse
+	47, // 59: user.UserService.Register:output_type -> google.protobuf.Empty
+	4,  // 60: user.UserService.Login:output_type -> user.AuthResponse
+	47, // 61: user.UserService.VerifyEmail:output_type -> google.protobuf.Empty
+	7,  // 62: user.UserService.GetSecurityQuestion:output_type -> user.GetSecurityQuestionResponse
+	47, // 63: user.UserService.ResetPassword:output_type -> google.protobuf.Empty
+	13, // 64: user.UserService.GetUserProfile:output_type -> user.UserProfileResponse
+	11, // 65: user.UserService.GetUserProfilesByIds:output_type -> user.GetUserProfilesByIdsResponse
+	47, // 66: user.UserService.ResendVerificationCode:output_type -> google.protobuf.Empty
+	47, // 67: user.UserService.FollowUser:output_type -> google.protobuf.Empty
+	47, // 68: user.UserService.UnfollowUser:output_type -> google.protobuf.Empty
+	47, // 69: user.UserService.BlockUser:output_type -> google.protobuf.Empty
+	47, // 70: user.UserService.UnblockUser:output_type -> google.protobuf.Empty
+	20, // 71: user.UserService.GetFollowers:output_type -> user.GetSocialListResponse
+	20, // 72: user.UserService.GetFollowing:output_type -> user.GetSocialListResponse
+	1,  // 73: user.UserService.GetUserByUsername:output_type -> user.User
+	1,  // 74: user.UserService.UpdateUserProfile:output_type -> user.User
+	22, // 75: user.UserService.GetBlockedUserIDs:output_type -> user.UserIDListResponse
+	22, // 76: user.UserService.GetBlockingUserIDs:output_type -> user.UserIDListResponse
+	22, // 77: user.UserService.GetFollowingIDs:output_type -> user.UserIDListResponse
+	22, // 78: user.UserService.GetFollowerIDs:output_type -> user.UserIDListResponse
+	24, // 79: user.UserService.IsBlockedBy:output_type -> user.BlockStatusResponse
+	24, // 80: user.UserService.HasBlocked:output_type -> user.BlockStatusResponse
+	24, // 81: user.UserService.IsFollowing:output_type -> user.BlockStatusResponse
+	47, // 82: user.UserService.ApplyForPremium:output_type -> google.protobuf.Empty
+	47, // 83: user.UserService.MuteUser:output_type -> google.protobuf.Empty
+	47, // 84: user.UserService.UnmuteUser:output_type -> google.protobuf.Empty
+	20, // 85: user.UserService.GetMutedUsers:output_type -> user.GetSocialListResponse
+	28, // 86: user.UserService.AddMutedWord:output_type -> user.MutedWord
+	47, // 87: user.UserService.RemoveMutedWord:output_type -> google.protobuf.Empty
+	32, // 88: user.UserService.GetMutedWords:output_type -> user.GetMutedWordsResponse
+	34, // 89: user.UserService.GetMuteFilter:output_type -> user.MuteFilter
+	36, // 90: user.UserService.GetContentPreferences:output_type -> user.ContentPreferences
+	37, // 91: user.UserService.CreateList:output_type -> user.List
+	37, // 92: user.UserService.UpdateList:output_type -> user.List
+	47, // 93: user.UserService.DeleteList:output_type -> google.protobuf.Empty
+	37, // 94: user.UserService.GetList:output_type -> user.List
+	42, // 95: user.UserService.GetUserLists:output_type -> user.GetListsResponse
+	42, // 96: user.UserService.GetFollowedLists:output_type -> user.GetListsResponse
+	47, // 97: user.UserService.AddListMember:output_type -> google.protobuf.Empty
+	47, // 98: user.UserService.RemoveListMember:output_type -> google.protobuf.Empty
+	20, // 99: user.UserService.GetListMembers:output_type -> user.GetSocialListResponse
+	22, // 100: user.UserService.GetListMemberIDs:output_type -> user.UserIDListResponse
+	47, // 101: user.UserService.FollowList:output_type -> google.protobuf.Empty
+	47, // 102: user.UserService.UnfollowList:output_type -> google.protobuf.Empty
+	58, // [58:103] is the sub-list for method output_type
+	13, // [13:58] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_proto_user_proto_init() }
@@ -1986,13 +3315,16 @@ func file_proto_user_proto_init() {
 	file_proto_user_proto_msgTypes[14].OneofWrappers = []any{}
 	file_proto_user_proto_msgTypes[15].OneofWrappers = []any{}
 	file_proto_user_proto_msgTypes[18].OneofWrappers = []any{}
+	file_proto_user_proto_msgTypes[28].OneofWrappers = []any{}
+	file_proto_user_proto_msgTypes[29].OneofWrappers = []any{}
+	file_proto_user_proto_msgTypes[39].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_user_proto_rawDesc), len(file_proto_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -40,10 +40,31 @@ const (
 	UserService_GetBlockedUserIDs_FullMethodName      = "/user.UserService/GetBlockedUserIDs"
 	UserService_GetBlockingUserIDs_FullMethodName     = "/user.UserService/GetBlockingUserIDs"
 	UserService_GetFollowingIDs_FullMethodName        = "/user.UserService/GetFollowingIDs"
+	UserService_GetFollowerIDs_FullMethodName         = "/user.UserService/GetFollowerIDs"
 	UserService_IsBlockedBy_FullMethodName            = "/user.UserService/IsBlockedBy"
 	UserService_HasBlocked_FullMethodName             = "/user.UserService/HasBlocked"
 	UserService_IsFollowing_FullMethodName            = "/user.UserService/IsFollowing"
 	UserService_ApplyForPremium_FullMethodName        = "/user.UserService/ApplyForPremium"
+	UserService_MuteUser_FullMethodName               = "/user.UserService/MuteUser"
+	UserService_UnmuteUser_FullMethodName             = "/user.UserService/UnmuteUser"
+	UserService_GetMutedUsers_FullMethodName          = "/user.UserService/GetMutedUsers"
+	UserService_AddMutedWord_FullMethodName           = "/user.UserService/AddMutedWord"
+	UserService_RemoveMutedWord_FullMethodName        = "/user.UserService/RemoveMutedWord"
+	UserService_GetMutedWords_FullMethodName          = "/user.UserService/GetMutedWords"
+	UserService_GetMuteFilter_FullMethodName          = "/user.UserService/GetMuteFilter"
+	UserService_GetContentPreferences_FullMethodName  = "/user.UserService/GetContentPreferences"
+	UserService_CreateList_FullMethodName             = "/user.UserService/CreateList"
+	UserService_UpdateList_FullMethodName             = "/user.UserService/UpdateList"
+	UserService_DeleteList_FullMethodName             = "/user.UserService/DeleteList"
+	UserService_GetList_FullMethodName                = "/user.UserService/GetList"
+	UserService_GetUserLists_FullMethodName           = "/user.UserService/GetUserLists"
+	UserService_GetFollowedLists_FullMethodName       = "/user.UserService/GetFollowedLists"
+	UserService_AddListMember_FullMethodName          = "/user.UserService/AddListMember"
+	UserService_RemoveListMember_FullMethodName       = "/user.UserService/RemoveListMember"
+	UserService_GetListMembers_FullMethodName         = "/user.UserService/GetListMembers"
+	UserService_GetListMemberIDs_FullMethodName       = "/user.UserService/GetListMemberIDs"
+	UserService_FollowList_FullMethodName             = "/user.UserService/FollowList"
+	UserService_UnfollowList_FullMethodName           = "/user.UserService/UnfollowList"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -70,10 +91,31 @@ type UserServiceClient interface {
 	GetBlockedUserIDs(ctx context.Context, in *SocialListRequest, opts ...grpc.CallOption) (*UserIDListResponse, error)
 	GetBlockingUserIDs(ctx context.Context, in *SocialListRequest, opts ...grpc.CallOption) (*UserIDListResponse, error)
 	GetFollowingIDs(ctx context.Context, in *SocialListRequest, opts ...grpc.CallOption) (*UserIDListResponse, error)
+	GetFollowerIDs(ctx context.Context, in *SocialListRequest, opts ...grpc.CallOption) (*UserIDListResponse, error)
 	IsBlockedBy(ctx context.Context, in *BlockCheckRequest, opts ...grpc.CallOption) (*BlockStatusResponse, error)
 	HasBlocked(ctx context.Context, in *BlockCheckRequest, opts ...grpc.CallOption) (*BlockStatusResponse, error)
 	IsFollowing(ctx context.Context, in *FollowCheckRequest, opts ...grpc.CallOption) (*BlockStatusResponse, error)
 	ApplyForPremium(ctx context.Context, in *ApplyForPremiumRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MuteUser(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnmuteUser(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetMutedUsers(ctx context.Context, in *GetSocialListRequest, opts ...grpc.CallOption) (*GetSocialListResponse, error)
+	AddMutedWord(ctx context.Context, in *AddMutedWordRequest, opts ...grpc.CallOption) (*MutedWord, error)
+	RemoveMutedWord(ctx context.Context, in *RemoveMutedWordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetMutedWords(ctx context.Context, in *GetMutedWordsRequest, opts ...grpc.CallOption) (*GetMutedWordsResponse, error)
+	GetMuteFilter(ctx context.Context, in *GetMuteFilterRequest, opts ...grpc.CallOption) (*MuteFilter, error)
+	GetContentPreferences(ctx context.Context, in *GetContentPreferencesRequest, opts ...grpc.CallOption) (*ContentPreferences, error)
+	CreateList(ctx context.Context, in *CreateListRequest, opts ...grpc.CallOption) (*List, error)
+	UpdateList(ctx context.Context, in *UpdateListRequest, opts ...grpc.CallOption) (*List, error)
+	DeleteList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*List, error)
+	GetUserLists(ctx context.Context, in *GetUserListsRequest, opts ...grpc.CallOption) (*GetListsResponse, error)
+	GetFollowedLists(ctx context.Context, in *GetUserListsRequest, opts ...grpc.CallOption) (*GetListsResponse, error)
+	AddListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetListMembers(ctx context.Context, in *GetListMembersRequest, opts ...grpc.CallOption) (*GetSocialListResponse, error)
+	GetListMemberIDs(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*UserIDListResponse, error)
+	FollowList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnfollowList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type userServiceClient struct {
@@ -284,6 +326,16 @@ func (c *userServiceClient) GetFollowingIDs(ctx context.Context, in *SocialListR
 	return out, nil
 }
 
+func (c *userServiceClient) GetFollowerIDs(ctx context.Context, in *SocialListRequest, opts ...grpc.CallOption) (*UserIDListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserIDListResponse)
+	err := c.cc.Invoke(ctx, UserService_GetFollowerIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) IsBlockedBy(ctx context.Context, in *BlockCheckRequest, opts ...grpc.CallOption) (*BlockStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BlockStatusResponse)
@@ -324,6 +376,206 @@ func (c *userServiceClient) ApplyForPremium(ctx context.Context, in *ApplyForPre
 	return out, nil
 }
 
+func (c *userServiceClient) MuteUser(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_MuteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnmuteUser(ctx context.Context, in *MuteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_UnmuteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetMutedUsers(ctx context.Context, in *GetSocialListRequest, opts ...grpc.CallOption) (*GetSocialListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSocialListResponse)
+	err := c.cc.Invoke(ctx, UserService_GetMutedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AddMutedWord(ctx context.Context, in *AddMutedWordRequest, opts ...grpc.CallOption) (*MutedWord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MutedWord)
+	err := c.cc.Invoke(ctx, UserService_AddMutedWord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveMutedWord(ctx context.Context, in *RemoveMutedWordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_RemoveMutedWord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetMutedWords(ctx context.Context, in *GetMutedWordsRequest, opts ...grpc.CallOption) (*GetMutedWordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMutedWordsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetMutedWords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetMuteFilter(ctx context.Context, in *GetMuteFilterRequest, opts ...grpc.CallOption) (*MuteFilter, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteFilter)
+	err := c.cc.Invoke(ctx, UserService_GetMuteFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetContentPreferences(ctx context.Context, in *GetContentPreferencesRequest, opts ...grpc.CallOption) (*ContentPreferences, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContentPreferences)
+	err := c.cc.Invoke(ctx, UserService_GetContentPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateList(ctx context.Context, in *CreateListRequest, opts ...grpc.CallOption) (*List, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(List)
+	err := c.cc.Invoke(ctx, UserService_CreateList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateList(ctx context.Context, in *UpdateListRequest, opts ...grpc.CallOption) (*List, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(List)
+	err := c.cc.Invoke(ctx, UserService_UpdateList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*List, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(List)
+	err := c.cc.Invoke(ctx, UserService_GetList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserLists(ctx context.Context, in *GetUserListsRequest, opts ...grpc.CallOption) (*GetListsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetListsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserLists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetFollowedLists(ctx context.Context, in *GetUserListsRequest, opts ...grpc.CallOption) (*GetListsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetListsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetFollowedLists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AddListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_AddListMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveListMember(ctx context.Context, in *ListMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_RemoveListMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetListMembers(ctx context.Context, in *GetListMembersRequest, opts ...grpc.CallOption) (*GetSocialListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSocialListResponse)
+	err := c.cc.Invoke(ctx, UserService_GetListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetListMemberIDs(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*UserIDListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserIDListResponse)
+	err := c.cc.Invoke(ctx, UserService_GetListMemberIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) FollowList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_FollowList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnfollowList(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_UnfollowList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -348,10 +600,31 @@ type UserServiceServer interface {
 	GetBlockedUserIDs(context.Context, *SocialListRequest) (*UserIDListResponse, error)
 	GetBlockingUserIDs(context.Context, *SocialListRequest) (*UserIDListResponse, error)
 	GetFollowingIDs(context.Context, *SocialListRequest) (*UserIDListResponse, error)
+	GetFollowerIDs(context.Context, *SocialListRequest) (*UserIDListResponse, error)
 	IsBlockedBy(context.Context, *BlockCheckRequest) (*BlockStatusResponse, error)
 	HasBlocked(context.Context, *BlockCheckRequest) (*BlockStatusResponse, error)
 	IsFollowing(context.Context, *FollowCheckRequest) (*BlockStatusResponse, error)
 	ApplyForPremium(context.Context, *ApplyForPremiumRequest) (*emptypb.Empty, error)
+	MuteUser(context.Context, *MuteRequest) (*emptypb.Empty, error)
+	UnmuteUser(context.Context, *MuteRequest) (*emptypb.Empty, error)
+	GetMutedUsers(context.Context, *GetSocialListRequest) (*GetSocialListResponse, error)
+	AddMutedWord(context.Context, *AddMutedWordRequest) (*MutedWord, error)
+	RemoveMutedWord(context.Context, *RemoveMutedWordRequest) (*emptypb.Empty, error)
+	GetMutedWords(context.Context, *GetMutedWordsRequest) (*GetMutedWordsResponse, error)
+	GetMuteFilter(context.Context, *GetMuteFilterRequest) (*MuteFilter, error)
+	GetContentPreferences(context.Context, *GetContentPreferencesRequest) (*ContentPreferences, error)
+	CreateList(context.Context, *CreateListRequest) (*List, error)
+	UpdateList(context.Context, *UpdateListRequest) (*List, error)
+	DeleteList(context.Context, *ListRequest) (*emptypb.Empty, error)
+	GetList(context.Context, *ListRequest) (*List, error)
+	GetUserLists(context.Context, *GetUserListsRequest) (*GetListsResponse, error)
+	GetFollowedLists(context.Context, *GetUserListsRequest) (*GetListsResponse, error)
+	AddListMember(context.Context, *ListMemberRequest) (*emptypb.Empty, error)
+	RemoveListMember(context.Context, *ListMemberRequest) (*emptypb.Empty, error)
+	GetListMembers(context.Context, *GetListMembersRequest) (*GetSocialListResponse, error)
+	GetListMemberIDs(context.Context, *ListRequest) (*UserIDListResponse, error)
+	FollowList(context.Context, *ListRequest) (*emptypb.Empty, error)
+	UnfollowList(context.Context, *ListRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -422,6 +695,9 @@ func (UnimplementedUserServiceServer) GetBlockingUserIDs(context.Context, *Socia
 func (UnimplementedUserServiceServer) GetFollowingIDs(context.Context, *SocialListRequest) (*UserIDListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFollowingIDs not implemented")
 }
+func (UnimplementedUserServiceServer) GetFollowerIDs(context.Context, *SocialListRequest) (*UserIDListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowerIDs not implemented")
+}
 func (UnimplementedUserServiceServer) IsBlockedBy(context.Context, *BlockCheckRequest) (*BlockStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsBlockedBy not implemented")
 }
@@ -434,6 +710,66 @@ func (UnimplementedUserServiceServer) IsFollowing(context.Context, *FollowCheckR
 func (UnimplementedUserServiceServer) ApplyForPremium(context.Context, *ApplyForPremiumRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyForPremium not implemented")
 }
+func (UnimplementedUserServiceServer) MuteUser(context.Context, *MuteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteUser not implemented")
+}
+func (UnimplementedUserServiceServer) UnmuteUser(context.Context, *MuteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteUser not implemented")
+}
+func (UnimplementedUserServiceServer) GetMutedUsers(context.Context, *GetSocialListRequest) (*GetSocialListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMutedUsers not implemented")
+}
+func (UnimplementedUserServiceServer) AddMutedWord(context.Context, *AddMutedWordRequest) (*MutedWord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMutedWord not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveMutedWord(context.Context, *RemoveMutedWordRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMutedWord not implemented")
+}
+func (UnimplementedUserServiceServer) GetMutedWords(context.Context, *GetMutedWordsRequest) (*GetMutedWordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMutedWords not implemented")
+}
+func (UnimplementedUserServiceServer) GetMuteFilter(context.Context, *GetMuteFilterRequest) (*MuteFilter, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMuteFilter not implemented")
+}
+func (UnimplementedUserServiceServer) GetContentPreferences(context.Context, *GetContentPreferencesRequest) (*ContentPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContentPreferences not implemented")
+}
+func (UnimplementedUserServiceServer) CreateList(context.Context, *CreateListRequest) (*List, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateList not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateList(context.Context, *UpdateListRequest) (*List, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateList not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteList(context.Context, *ListRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteList not implemented")
+}
+func (UnimplementedUserServiceServer) GetList(context.Context, *ListRequest) (*List, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserLists(context.Context, *GetUserListsRequest) (*GetListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserLists not implemented")
+}
+func (UnimplementedUserServiceServer) GetFollowedLists(context.Context, *GetUserListsRequest) (*GetListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowedLists not implemented")
+}
+func (UnimplementedUserServiceServer) AddListMember(context.Context, *ListMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddListMember not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveListMember(context.Context, *ListMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveListMember not implemented")
+}
+func (UnimplementedUserServiceServer) GetListMembers(context.Context, *GetListMembersRequest) (*GetSocialListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListMembers not implemented")
+}
+func (UnimplementedUserServiceServer) GetListMemberIDs(context.Context, *ListRequest) (*UserIDListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListMemberIDs not implemented")
+}
+func (UnimplementedUserServiceServer) FollowList(context.Context, *ListRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowList not implemented")
+}
+func (UnimplementedUserServiceServer) UnfollowList(context.Context, *ListRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowList not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -815,6 +1151,24 @@ func _UserService_GetFollowingIDs_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetFollowerIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SocialListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetFollowerIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetFollowerIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetFollowerIDs(ctx, req.(*SocialListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_IsBlockedBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlockCheckRequest)
 	if err := dec(in); err != nil {
@@ -887,6 +1241,366 @@ func _UserService_ApplyForPremium_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_MuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).MuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_MuteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).MuteUser(ctx, req.(*MuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnmuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnmuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnmuteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnmuteUser(ctx, req.(*MuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetMutedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSocialListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetMutedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetMutedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetMutedUsers(ctx, req.(*GetSocialListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddMutedWord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMutedWordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddMutedWord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddMutedWord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddMutedWord(ctx, req.(*AddMutedWordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveMutedWord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMutedWordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveMutedWord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveMutedWord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveMutedWord(ctx, req.(*RemoveMutedWordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetMutedWords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMutedWordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetMutedWords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetMutedWords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetMutedWords(ctx, req.(*GetMutedWordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetMuteFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMuteFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetMuteFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetMuteFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetMuteFilter(ctx, req.(*GetMuteFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetContentPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContentPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetContentPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetContentPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetContentPreferences(ctx, req.(*GetContentPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateList(ctx, req.(*CreateListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateList(ctx, req.(*UpdateListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteList(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetList(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserLists(ctx, req.(*GetUserListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetFollowedLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetFollowedLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetFollowedLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetFollowedLists(ctx, req.(*GetUserListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddListMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddListMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddListMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddListMember(ctx, req.(*ListMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveListMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveListMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveListMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveListMember(ctx, req.(*ListMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetListMembers(ctx, req.(*GetListMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetListMemberIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetListMemberIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetListMemberIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetListMemberIDs(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_FollowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).FollowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_FollowList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FollowList(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnfollowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnfollowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnfollowList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnfollowList(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -974,6 +1688,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFollowingIDs",
 			Handler:    _UserService_GetFollowingIDs_Handler,
 		},
+		{
+			MethodName: "GetFollowerIDs",
+			Handler:    _UserService_GetFollowerIDs_Handler,
+		},
 		{
 			MethodName: "IsBlockedBy",
 			Handler:    _UserService_IsBlockedBy_Handler,
@@ -990,6 +1708,86 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyForPremium",
 			Handler:    _UserService_ApplyForPremium_Handler,
 		},
+		{
+			MethodName: "MuteUser",
+			Handler:    _UserService_MuteUser_Handler,
+		},
+		{
+			MethodName: "UnmuteUser",
+			Handler:    _UserService_UnmuteUser_Handler,
+		},
+		{
+			MethodName: "GetMutedUsers",
+			Handler:    _UserService_GetMutedUsers_Handler,
+		},
+		{
+			MethodName: "AddMutedWord",
+			Handler:    _UserService_AddMutedWord_Handler,
+		},
+		{
+			MethodName: "RemoveMutedWord",
+			Handler:    _UserService_RemoveMutedWord_Handler,
+		},
+		{
+			MethodName: "GetMutedWords",
+			Handler:    _UserService_GetMutedWords_Handler,
+		},
+		{
+			MethodName: "GetMuteFilter",
+			Handler:    _UserService_GetMuteFilter_Handler,
+		},
+		{
+			MethodName: "GetContentPreferences",
+			Handler:    _UserService_GetContentPreferences_Handler,
+		},
+		{
+			MethodName: "CreateList",
+			Handler:    _UserService_CreateList_Handler,
+		},
+		{
+			MethodName: "UpdateList",
+			Handler:    _UserService_UpdateList_Handler,
+		},
+		{
+			MethodName: "DeleteList",
+			Handler:    _UserService_DeleteList_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _UserService_GetList_Handler,
+		},
+		{
+			MethodName: "GetUserLists",
+			Handler:    _UserService_GetUserLists_Handler,
+		},
+		{
+			MethodName: "GetFollowedLists",
+			Handler:    _UserService_GetFollowedLists_Handler,
+		},
+		{
+			MethodName: "AddListMember",
+			Handler:    _UserService_AddListMember_Handler,
+		},
+		{
+			MethodName: "RemoveListMember",
+			Handler:    _UserService_RemoveListMember_Handler,
+		},
+		{
+			MethodName: "GetListMembers",
+			Handler:    _UserService_GetListMembers_Handler,
+		},
+		{
+			MethodName: "GetListMemberIDs",
+			Handler:    _UserService_GetListMemberIDs_Handler,
+		},
+		{
+			MethodName: "FollowList",
+			Handler:    _UserService_FollowList_Handler,
+		},
+		{
+			MethodName: "UnfollowList",
+			Handler:    _UserService_UnfollowList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/user.proto",
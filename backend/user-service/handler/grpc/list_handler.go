@@ -0,0 +1,326 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	userpb "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/repository/postgres"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxListNameLength        = 25
+	maxListDescriptionLength = 100
+	maxListMembers           = 5000
+)
+
+func (h *UserHandler) CreateList(ctx context.Context, req *userpb.CreateListRequest) (*userpb.List, error) {
+	if req.OwnerId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Owner ID is required")
+	}
+	name, err := validateListName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	description, err := validateListDescription(req.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &postgres.List{OwnerID: uint(req.OwnerId), Name: name, Description: description, IsPrivate: req.IsPrivate}
+	if err := h.repo.CreateList(ctx, list); err != nil {
+		log.Printf("Error creating list for user %d: %v", req.OwnerId, err)
+		return nil, status.Errorf(codes.Internal, "Failed to create list")
+	}
+	log.Printf("User %d created list %d", req.OwnerId, list.ID)
+	return h.mapListsToProto(ctx, []postgres.List{*list}, req.OwnerId)[0], nil
+}
+
+func (h *UserHandler) UpdateList(ctx context.Context, req *userpb.UpdateListRequest) (*userpb.List, error) {
+	list, err := h.getOwnList(ctx, req.ListId, req.RequesterId)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if req.Name != nil {
+		name, err := validateListName(*req.Name)
+		if err != nil {
+			return nil, err
+		}
+		updates["name"] = name
+	}
+	if req.Description != nil {
+		description, err := validateListDescription(*req.Description)
+		if err != nil {
+			return nil, err
+		}
+		updates["description"] = description
+	}
+	if req.IsPrivate != nil {
+		updates["is_private"] = *req.IsPrivate
+	}
+	if len(updates) > 0 {
+		list, err = h.repo.UpdateList(ctx, list.ID, updates)
+		if err != nil {
+			log.Printf("Error updating list %d: %v", req.ListId, err)
+			return nil, status.Errorf(codes.Internal, "Failed to update list")
+		}
+	}
+	return h.mapListsToProto(ctx, []postgres.List{*list}, req.RequesterId)[0], nil
+}
+
+func (h *UserHandler) DeleteList(ctx context.Context, req *userpb.ListRequest) (*emptypb.Empty, error) {
+	list, err := h.getOwnList(ctx, req.ListId, req.RequesterId)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.repo.DeleteList(ctx, list.ID); err != nil {
+		log.Printf("Error deleting list %d: %v", req.ListId, err)
+		return nil, status.Errorf(codes.Internal, "Failed to delete list")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserHandler) GetList(ctx context.Context, req *userpb.ListRequest) (*userpb.List, error) {
+	list, err := h.getVisibleList(ctx, req.ListId, req.RequesterId)
+	if err != nil {
+		return nil, err
+	}
+	return h.mapListsToProto(ctx, []postgres.List{*list}, req.RequesterId)[0], nil
+}
+
+// GetUserLists lists the lists UserId created. Their private lists are only included for themselves.
+func (h *UserHandler) GetUserLists(ctx context.Context, req *userpb.GetUserListsRequest) (*userpb.GetListsResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "User ID is required")
+	}
+	limit, offset := getLimitOffset(req.Page, req.Limit)
+
+	lists, err := h.repo.GetListsByOwner(ctx, uint(req.UserId), req.RequesterId == req.UserId, limit, offset)
+	if err != nil {
+		log.Printf("Error retrieving lists of user %d: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve lists")
+	}
+	return &userpb.GetListsResponse{Lists: h.mapListsToProto(ctx, lists, req.RequesterId), HasMore: len(lists) == limit}, nil
+}
+
+// GetFollowedLists lists the public lists UserId follows.
+func (h *UserHandler) GetFollowedLists(ctx context.Context, req *userpb.GetUserListsRequest) (*userpb.GetListsResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "User ID is required")
+	}
+	limit, offset := getLimitOffset(req.Page, req.Limit)
+
+	lists, err := h.repo.GetFollowedLists(ctx, uint(req.UserId), limit, offset)
+	if err != nil {
+		log.Printf("Error retrieving lists followed by user %d: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve lists")
+	}
+	return &userpb.GetListsResponse{Lists: h.mapListsToProto(ctx, lists, req.RequesterId), HasMore: len(lists) == limit}, nil
+}
+
+func (h *UserHandler) AddListMember(ctx context.Context, req *userpb.ListMemberRequest) (*emptypb.Empty, error) {
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "User ID is required")
+	}
+	list, err := h.getOwnList(ctx, req.ListId, req.RequesterId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := h.repo.GetUserByID(ctx, uint(req.UserId)); err != nil {
+		return nil, status.Errorf(codes.NotFound, "User to add not found")
+	}
+	blocked, err := h.repo.IsBlockedBy(ctx, list.OwnerID, uint(req.UserId))
+	if err != nil {
+		log.Printf("Error checking block status for list member: %v", err)
+		return nil, status.Errorf(codes.Internal, "Could not add list member")
+	}
+	if blocked {
+		return nil, status.Errorf(codes.PermissionDenied, "You cannot add this user to a list")
+	}
+
+	memberCount, err := h.repo.CountListMembers(ctx, list.ID)
+	if err != nil {
+		log.Printf("Error counting members of list %d: %v", list.ID, err)
+		return nil, status.Errorf(codes.Internal, "Could not add list member")
+	}
+	if memberCount >= maxListMembers {
+		return nil, status.Errorf(codes.FailedPrecondition, "Lists can have at most %d members", maxListMembers)
+	}
+
+	if err := h.repo.AddListMember(ctx, list.ID, uint(req.UserId)); err != nil {
+		log.Printf("Error adding user %d to list %d: %v", req.UserId, list.ID, err)
+		return nil, status.Errorf(codes.Internal, "Could not add list member")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserHandler) RemoveListMember(ctx context.Context, req *userpb.ListMemberRequest) (*emptypb.Empty, error) {
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "User ID is required")
+	}
+	list, err := h.getOwnList(ctx, req.ListId, req.RequesterId)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.repo.RemoveListMember(ctx, list.ID, uint(req.UserId)); err != nil {
+		log.Printf("Error removing user %d from list %d: %v", req.UserId, list.ID, err)
+		return nil, status.Errorf(codes.Internal, "Could not remove list member")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserHandler) GetListMembers(ctx context.Context, req *userpb.GetListMembersRequest) (*userpb.GetSocialListResponse, error) {
+	list, err := h.getVisibleList(ctx, req.ListId, req.RequesterId)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset := getLimitOffset(req.Page, req.Limit)
+
+	memberIDs, err := h.repo.GetListMemberIDs(ctx, list.ID, limit, offset)
+	if err != nil {
+		log.Printf("Error retrieving members of list %d: %v", list.ID, err)
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve list members")
+	}
+	return h.hydrateSocialList(ctx, memberIDs, req.RequesterId, len(memberIDs) == limit)
+}
+
+// GetListMemberIDs returns every member of a list, for building its timeline.
+func (h *UserHandler) GetListMemberIDs(ctx context.Context, req *userpb.ListRequest) (*userpb.UserIDListResponse, error) {
+	list, err := h.getVisibleList(ctx, req.ListId, req.RequesterId)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := h.repo.GetListMemberIDs(ctx, list.ID, maxListMembers, 0)
+	if err != nil {
+		log.Printf("Error retrieving member IDs of list %d: %v", list.ID, err)
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve list members")
+	}
+	return &userpb.UserIDListResponse{UserIds: uintSliceToUint32Slice(memberIDs)}, nil
+}
+
+func (h *UserHandler) FollowList(ctx context.Context, req *userpb.ListRequest) (*emptypb.Empty, error) {
+	if req.RequesterId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Requester ID is required")
+	}
+	list, err := h.getVisibleList(ctx, req.ListId, req.RequesterId)
+	if err != nil {
+		return nil, err
+	}
+	// A private list is only visible to its owner, so this also rejects following private lists
+	if list.OwnerID == uint(req.RequesterId) {
+		return nil, status.Errorf(codes.InvalidArgument, "You cannot follow your own list")
+	}
+
+	if err := h.repo.FollowList(ctx, list.ID, uint(req.RequesterId)); err != nil {
+		log.Printf("Error following list %d: %v", list.ID, err)
+		return nil, status.Errorf(codes.Internal, "Could not follow list")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserHandler) UnfollowList(ctx context.Context, req *userpb.ListRequest) (*emptypb.Empty, error) {
+	if req.RequesterId == 0 || req.ListId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "List ID and requester ID are required")
+	}
+	if err := h.repo.UnfollowList(ctx, uint(req.ListId), uint(req.RequesterId)); err != nil {
+		log.Printf("Error unfollowing list %d: %v", req.ListId, err)
+		return nil, status.Errorf(codes.Internal, "Could not unfollow list")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getVisibleList loads a list requesterID may see. Someone else's private list is reported as not found.
+func (h *UserHandler) getVisibleList(ctx context.Context, listID, requesterID uint32) (*postgres.List, error) {
+	if listID == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "List ID is required")
+	}
+	list, err := h.repo.GetListByID(ctx, uint(listID))
+	if err != nil {
+		if err.Error() == "list not found" {
+			return nil, status.Errorf(codes.NotFound, "List not found")
+		}
+		log.Printf("Error retrieving list %d: %v", listID, err)
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve list")
+	}
+	if list.IsPrivate && list.OwnerID != uint(requesterID) {
+		return nil, status.Errorf(codes.NotFound, "List not found")
+	}
+	return list, nil
+}
+
+// getOwnList loads a list only requesterID may change.
+func (h *UserHandler) getOwnList(ctx context.Context, listID, requesterID uint32) (*postgres.List, error) {
+	list, err := h.getVisibleList(ctx, listID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if list.OwnerID != uint(requesterID) {
+		return nil, status.Errorf(codes.PermissionDenied, "Only the list owner can change this list")
+	}
+	return list, nil
+}
+
+func validateListName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", status.Errorf(codes.InvalidArgument, "List name is required")
+	}
+	if utf8.RuneCountInString(name) > maxListNameLength {
+		return "", status.Errorf(codes.InvalidArgument, "List name must be at most %d characters", maxListNameLength)
+	}
+	return name, nil
+}
+
+func validateListDescription(description string) (string, error) {
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > maxListDescriptionLength {
+		return "", status.Errorf(codes.InvalidArgument, "List description must be at most %d characters", maxListDescriptionLength)
+	}
+	return description, nil
+}
+
+func (h *UserHandler) mapListsToProto(ctx context.Context, lists []postgres.List, requesterID uint32) []*userpb.List {
+	ownerIDs := make([]uint, 0, len(lists))
+	for _, list := range lists {
+		ownerIDs = append(ownerIDs, list.OwnerID)
+	}
+	owners := make(map[uint]*userpb.User, len(ownerIDs))
+	if len(ownerIDs) > 0 {
+		dbOwners, err := h.repo.GetUsersByIDs(ctx, ownerIDs)
+		if err != nil {
+			log.Printf("Warning: could not load list owners: %v", err)
+		}
+		for i := range dbOwners {
+			owners[dbOwners[i].ID] = mapDBUserToProtoUser(&dbOwners[i])
+		}
+	}
+
+	protoLists := make([]*userpb.List, 0, len(lists))
+	for _, list := range lists {
+		memberCount, _ := h.repo.CountListMembers(ctx, list.ID)
+		followerCount, _ := h.repo.CountListFollowers(ctx, list.ID)
+		isFollowed, _ := h.repo.IsFollowingList(ctx, list.ID, uint(requesterID))
+		protoLists = append(protoLists, &userpb.List{
+			Id:                    uint32(list.ID),
+			Owner:                 owners[list.OwnerID],
+			Name:                  list.Name,
+			Description:           list.Description,
+			IsPrivate:             list.IsPrivate,
+			MemberCount:           int32(memberCount),
+			FollowerCount:         int32(followerCount),
+			IsFollowedByRequester: isFollowed,
+			CreatedAt:             timestamppb.New(list.CreatedAt),
+		})
+	}
+	return protoLists
+}
@@ -3,6 +3,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/repository/postgres"
 	"github.com/stretchr/testify/mock"
@@ -150,4 +151,109 @@ func (m *MockUserRepo) ApprovePremiumApplication(ctx context.Context, applicatio
 func (m *MockUserRepo) RejectPremiumApplication(ctx context.Context, applicationID uint, adminUserID uint, adminNotes string) error {
 	args := m.Called(ctx, applicationID, adminUserID, adminNotes)
 	return args.Error(0)
-}
\ No newline at end of file
+}
+
+func (m *MockUserRepo) MuteUser(ctx context.Context, muterID, mutedID uint) error {
+	args := m.Called(ctx, muterID, mutedID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UnmuteUser(ctx context.Context, muterID, mutedID uint) error {
+	args := m.Called(ctx, muterID, mutedID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) HasMuted(ctx context.Context, muterID, mutedID uint) (bool, error) {
+	args := m.Called(ctx, muterID, mutedID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRepo) GetMutedUserIDs(ctx context.Context, userID uint, limit, offset int) ([]uint, error) {
+	args := m.Called(ctx, userID, limit, offset)
+	return args.Get(0).([]uint), args.Error(1)
+}
+
+func (m *MockUserRepo) UpsertMutedWord(ctx context.Context, word *postgres.MutedWord) error {
+	args := m.Called(ctx, word)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) DeleteMutedWord(ctx context.Context, userID, wordID uint) error {
+	args := m.Called(ctx, userID, wordID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) GetActiveMutedWords(ctx context.Context, userID uint, now time.Time) ([]postgres.MutedWord, error) {
+	args := m.Called(ctx, userID, now)
+	return args.Get(0).([]postgres.MutedWord), args.Error(1)
+}
+
+func (m *MockUserRepo) CreateList(ctx context.Context, list *postgres.List) error {
+	args := m.Called(ctx, list)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) GetListByID(ctx context.Context, listID uint) (*postgres.List, error) {
+	args := m.Called(ctx, listID)
+	return args.Get(0).(*postgres.List), args.Error(1)
+}
+
+func (m *MockUserRepo) UpdateList(ctx context.Context, listID uint, updates map[string]interface{}) (*postgres.List, error) {
+	args := m.Called(ctx, listID, updates)
+	return args.Get(0).(*postgres.List), args.Error(1)
+}
+
+func (m *MockUserRepo) DeleteList(ctx context.Context, listID uint) error {
+	args := m.Called(ctx, listID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) GetListsByOwner(ctx context.Context, ownerID uint, includePrivate bool, limit, offset int) ([]postgres.List, error) {
+	args := m.Called(ctx, ownerID, includePrivate, limit, offset)
+	return args.Get(0).([]postgres.List), args.Error(1)
+}
+
+func (m *MockUserRepo) GetFollowedLists(ctx context.Context, userID uint, limit, offset int) ([]postgres.List, error) {
+	args := m.Called(ctx, userID, limit, offset)
+	return args.Get(0).([]postgres.List), args.Error(1)
+}
+
+func (m *MockUserRepo) AddListMember(ctx context.Context, listID, userID uint) error {
+	args := m.Called(ctx, listID, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) RemoveListMember(ctx context.Context, listID, userID uint) error {
+	args := m.Called(ctx, listID, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) GetListMemberIDs(ctx context.Context, listID uint, limit, offset int) ([]uint, error) {
+	args := m.Called(ctx, listID, limit, offset)
+	return args.Get(0).([]uint), args.Error(1)
+}
+
+func (m *MockUserRepo) CountListMembers(ctx context.Context, listID uint) (int64, error) {
+	args := m.Called(ctx, listID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockUserRepo) CountListFollowers(ctx context.Context, listID uint) (int64, error) {
+	args := m.Called(ctx, listID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockUserRepo) FollowList(ctx context.Context, listID, userID uint) error {
+	args := m.Called(ctx, listID, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UnfollowList(ctx context.Context, listID, userID uint) error {
+	args := m.Called(ctx, listID, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) IsFollowingList(ctx context.Context, listID, userID uint) (bool, error) {
+	args := m.Called(ctx, listID, userID)
+	return args.Bool(0), args.Error(1)
+}
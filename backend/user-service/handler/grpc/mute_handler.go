@@ -0,0 +1,175 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	userpb "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genproto/proto"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/mute"
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/repository/postgres"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxMutedAccountsInFilter caps the muted accounts sent with a mute filter, like the block lists the gateway fetches.
+const maxMutedAccountsInFilter = 10000
+
+func (h *UserHandler) MuteUser(ctx context.Context, req *userpb.MuteRequest) (*emptypb.Empty, error) {
+	log.Printf("User %d attempts to mute user %d", req.MuterId, req.MutedId)
+	if req.MuterId == 0 || req.MutedId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "IDs required")
+	}
+	if req.MuterId == req.MutedId {
+		return nil, status.Errorf(codes.InvalidArgument, "User cannot mute themselves")
+	}
+	if _, err := h.repo.GetUserByID(ctx, uint(req.MutedId)); err != nil {
+		return nil, status.Errorf(codes.NotFound, "User to mute not found")
+	}
+	if err := h.repo.MuteUser(ctx, uint(req.MuterId), uint(req.MutedId)); err != nil {
+		log.Printf("Error muting user: %v", err)
+		return nil, status.Errorf(codes.Internal, "Could not process mute request")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserHandler) UnmuteUser(ctx context.Context, req *userpb.MuteRequest) (*emptypb.Empty, error) {
+	log.Printf("User %d attempts to unmute user %d", req.MuterId, req.MutedId)
+	if req.MuterId == 0 || req.MutedId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "IDs required")
+	}
+	if err := h.repo.UnmuteUser(ctx, uint(req.MuterId), uint(req.MutedId)); err != nil {
+		log.Printf("Error unmuting user: %v", err)
+		return nil, status.Errorf(codes.Internal, "Could not process unmute request")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetMutedUsers lists the accounts UserId has muted, most recent first.
+func (h *UserHandler) GetMutedUsers(ctx context.Context, req *userpb.GetSocialListRequest) (*userpb.GetSocialListResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "User ID is required")
+	}
+	limit, offset := getLimitOffset(req.Page, req.Limit)
+
+	mutedIDs, err := h.repo.GetMutedUserIDs(ctx, uint(req.UserId), limit, offset)
+	if err != nil {
+		log.Printf("Error retrieving muted users: %v", err)
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve muted users")
+	}
+	return h.hydrateSocialList(ctx, mutedIDs, req.UserId, len(mutedIDs) == limit)
+}
+
+func (h *UserHandler) AddMutedWord(ctx context.Context, req *userpb.AddMutedWordRequest) (*userpb.MutedWord, error) {
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "User ID is required")
+	}
+	phrase, err := mute.NormalizePhrase(req.Phrase)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	scopes, err := mute.NormalizeScopes(req.Scopes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	word := &postgres.MutedWord{UserID: uint(req.UserId), Phrase: phrase, Scopes: strings.Join(scopes, ",")}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		if !expiresAt.After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "Expiry must be in the future")
+		}
+		word.ExpiresAt = &expiresAt
+	}
+
+	if err := h.repo.UpsertMutedWord(ctx, word); err != nil {
+		log.Printf("Error saving muted word for user %d: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "Failed to mute word")
+	}
+	return mapMutedWordToProto(word), nil
+}
+
+func (h *UserHandler) RemoveMutedWord(ctx context.Context, req *userpb.RemoveMutedWordRequest) (*emptypb.Empty, error) {
+	if req.UserId == 0 || req.MutedWordId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "User ID and muted word ID are required")
+	}
+	if err := h.repo.DeleteMutedWord(ctx, uint(req.UserId), uint(req.MutedWordId)); err != nil {
+		if err.Error() == "muted word not found" {
+			return nil, status.Errorf(codes.NotFound, "Muted word not found")
+		}
+		log.Printf("Error removing muted word %d for user %d: %v", req.MutedWordId, req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "Failed to unmute word")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *UserHandler) GetMutedWords(ctx context.Context, req *userpb.GetMutedWordsRequest) (*userpb.GetMutedWordsResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "User ID is required")
+	}
+	words, err := h.repo.GetActiveMutedWords(ctx, uint(req.UserId), time.Now())
+	if err != nil {
+		log.Printf("Error retrieving muted words for user %d: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve muted words")
+	}
+	resp := &userpb.GetMutedWordsResponse{MutedWords: make([]*userpb.MutedWord, 0, len(words))}
+	for i := range words {
+		resp.MutedWords = append(resp.MutedWords, mapMutedWordToProto(&words[i]))
+	}
+	return resp, nil
+}
+
+// GetMuteFilter returns the muted accounts and the unexpired phrases muted in one scope, for services
+// that hide muted content before it leaves the backend.
+func (h *UserHandler) GetMuteFilter(ctx context.Context, req *userpb.GetMuteFilterRequest) (*userpb.MuteFilter, error) {
+	if req.UserId == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "User ID is required")
+	}
+	if !mute.IsScope(req.Scope) {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", mute.ErrUnknownScope)
+	}
+
+	mutedIDs, err := h.repo.GetMutedUserIDs(ctx, uint(req.UserId), maxMutedAccountsInFilter, 0)
+	if err != nil {
+		log.Printf("Error retrieving muted users for filter of user %d: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve mute filter")
+	}
+	words, err := h.repo.GetActiveMutedWords(ctx, uint(req.UserId), time.Now())
+	if err != nil {
+		log.Printf("Error retrieving muted words for filter of user %d: %v", req.UserId, err)
+		return nil, status.Errorf(codes.Internal, "Failed to retrieve mute filter")
+	}
+
+	filter := &userpb.MuteFilter{MutedUserIds: uintSliceToUint32Slice(mutedIDs)}
+	for _, word := range words {
+		if hasScope(word.Scopes, req.Scope) {
+			filter.Phrases = append(filter.Phrases, word.Phrase)
+		}
+	}
+	return filter, nil
+}
+
+func hasScope(scopes, scope string) bool {
+	for _, s := range strings.Split(scopes, ",") {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func mapMutedWordToProto(word *postgres.MutedWord) *userpb.MutedWord {
+	wordProto := &userpb.MutedWord{
+		Id:        uint32(word.ID),
+		Phrase:    word.Phrase,
+		Scopes:    strings.Split(word.Scopes, ","),
+		CreatedAt: timestamppb.New(word.CreatedAt),
+	}
+	if word.ExpiresAt != nil {
+		wordProto.ExpiresAt = timestamppb.New(*word.ExpiresAt)
+	}
+	return wordProto
+}
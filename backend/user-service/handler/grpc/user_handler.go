@@ -36,6 +36,12 @@ type NewFollowerEventPayload struct {
     FollowerUsername string `json:"follower_username"`
 }
 
+// matches thread-service event/consumer.go
+type UnfollowEventPayload struct {
+    FollowedUserID uint32 `json:"followed_user_id"`
+    FollowerUserID uint32 `json:"follower_user_id"`
+}
+
 func NewUserHandler(repo postgres.IUserRepo) *UserHandler {
 	return &UserHandler{repo: repo}
 }
@@ -494,11 +500,19 @@ func (h *UserHandler) UpdateUserProfile(ctx context.Context, req *userpb.UpdateU
 		updates["account_privacy"] = privacy
 	}
 	if req.SubscribedToNewsletter != nil { updates["subscribed_to_newsletter"] = req.GetSubscribedToNewsletter() }
+	if req.MentionPermission != nil {
+		permission := req.GetMentionPermission()
+		if permission != "everyone" && permission != "following" && permission != "none" {
+			return nil, status.Errorf(codes.InvalidArgument, "Mention permission must be 'everyone', 'following' or 'none'")
+		}
+		updates["mention_permission"] = permission
+	}
+	if req.ShowSensitiveContent != nil { updates["show_sensitive_content"] = req.GetShowSensitiveContent() }
 
 
 	if len(updates) == 0 {
 		log.Printf("UpdateUserProfile: No update fields provided for user %d", userID)
-		return mapDBUserToProtoUser(currentUser), nil
+		return mapDBUserToOwnProtoUser(currentUser), nil
 	}
 
 	updatedUser, err := h.repo.UpdateUser(ctx, userID, updates)
@@ -508,7 +522,7 @@ func (h *UserHandler) UpdateUserProfile(ctx context.Context, req *userpb.UpdateU
 	}
 
 	log.Printf("User profile updated successfully for User ID: %d", userID)
-	return mapDBUserToProtoUser(updatedUser), nil
+	return mapDBUserToOwnProtoUser(updatedUser), nil
 }
 
 
@@ -545,10 +559,11 @@ func (h *UserHandler) GetUserProfile(ctx context.Context, req *userpb.GetUserPro
 	followingCount, _ := h.repo.GetFollowingCount(ctx, targetUser.ID)
 
 	// Check relationship status if requester ID is provided
-	var isFollowedByReq, isBlockedByReq bool
+	var isFollowedByReq, isBlockedByReq, isMutedByReq bool
 	if requesterID != 0 && requesterID != targetUser.ID {
 		isFollowedByReq, _ = h.repo.IsFollowing(ctx, requesterID, targetUser.ID)
 		isBlockedByReq = hasRequesterBlockedTarget
+		isMutedByReq, _ = h.repo.HasMuted(ctx, requesterID, targetUser.ID)
 	}
 
 
@@ -568,6 +583,11 @@ func (h *UserHandler) GetUserProfile(ctx context.Context, req *userpb.GetUserPro
 		Bio:            targetUser.Bio,
 		IsVerified:  targetUser.IsVerified,
 	}
+	// Content preferences are private; other services read them through GetContentPreferences
+	if isOwner {
+		userProto.MentionPermission = targetUser.MentionPermission
+		userProto.ShowSensitiveContent = targetUser.ShowSensitiveContent
+	}
 
 	return &userpb.UserProfileResponse{
 		User:                   userProto,
@@ -576,6 +596,7 @@ func (h *UserHandler) GetUserProfile(ctx context.Context, req *userpb.GetUserPro
 		IsFollowedByRequester:  isFollowedByReq,
 		IsBlockedByRequester:   isBlockedByReq,
         IsBlockingRequester:    isBlockedByTarget,
+		IsMutedByRequester:     isMutedByReq,
 	}, nil
 }
 
@@ -696,6 +717,15 @@ func (h *UserHandler) UnfollowUser(ctx context.Context, req *userpb.FollowReques
 		log.Printf("Error unfollowing user: %v", err)
 		return nil, status.Errorf(codes.Internal, "Could not process unfollow request")
 	}
+
+	// Lets thread-service take the unfollowed user's threads out of the follower's timeline
+	eventPayload := UnfollowEventPayload{FollowedUserID: req.FollowedId, FollowerUserID: req.FollowerId}
+	go func() {
+		errPub := utils.PublishEvent(context.Background(), "social_events", "social.unfollowed", eventPayload)
+		if errPub != nil {
+			log.Printf("ERROR publishing UnfollowEvent for %d -> %d: %v", req.FollowerId, req.FollowedId, errPub)
+		}
+	}()
 	return &emptypb.Empty{}, nil
 }
 
@@ -775,6 +805,14 @@ func (h *UserHandler) GetFollowingIDs(ctx context.Context, req *userpb.SocialLis
     return &userpb.UserIDListResponse{UserIds: uintSliceToUint32Slice(ids), HasMore: len(ids) == limit}, nil
 }
 
+func (h *UserHandler) GetFollowerIDs(ctx context.Context, req *userpb.SocialListRequest) (*userpb.UserIDListResponse, error) {
+    if req.UserId == 0 { return nil, status.Errorf(codes.InvalidArgument, "User ID is required") }
+    limit, offset := getLimitOffset(req.Page, req.Limit)
+    ids, err := h.repo.GetFollowers(ctx, uint(req.UserId), limit, offset)
+    if err != nil { return nil, status.Errorf(codes.Internal, "Failed to get follower list: %v", err)}
+    return &userpb.UserIDListResponse{UserIds: uintSliceToUint32Slice(ids), HasMore: len(ids) == limit}, nil
+}
+
 func (h *UserHandler) HasBlocked(ctx context.Context, req *userpb.BlockCheckRequest) (*userpb.BlockStatusResponse, error) {
 	log.Printf("Received HasBlocked request: Actor %d, Subject %d", req.ActorId, req.SubjectId)
 	if req.ActorId == 0 || req.SubjectId == 0 {
@@ -939,10 +977,35 @@ func mapDBUserToProtoUser(dbUser *postgres.User) *userpb.User {
         AccountPrivacy: dbUser.AccountPrivacy,
         SubscribedToNewsletter: dbUser.SubscribedToNewsletter,
         Bio:            dbUser.Bio,
+        IsVerified:     dbUser.IsVerified,
         CreatedAt:      timestamppb.New(dbUser.CreatedAt),
     }
 }
 
+// mapDBUserToOwnProtoUser is mapDBUserToProtoUser plus the private settings only the user may see.
+func mapDBUserToOwnProtoUser(dbUser *postgres.User) *userpb.User {
+    userProto := mapDBUserToProtoUser(dbUser)
+    if userProto != nil {
+        userProto.MentionPermission = dbUser.MentionPermission
+        userProto.ShowSensitiveContent = dbUser.ShowSensitiveContent
+    }
+    return userProto
+}
+
+// GetContentPreferences returns a user's private content settings for services that apply them, such as
+// thread-service masking sensitive threads and checking who may mention the user. It is not exposed by the gateway.
+func (h *UserHandler) GetContentPreferences(ctx context.Context, req *userpb.GetContentPreferencesRequest) (*userpb.ContentPreferences, error) {
+    if req.UserId == 0 { return nil, status.Errorf(codes.InvalidArgument, "User ID is required") }
+
+    user, err := h.repo.GetUserByID(ctx, uint(req.UserId))
+    if err != nil {
+        if err.Error() == "user not found by ID" { return nil, status.Errorf(codes.NotFound, "User not found") }
+        log.Printf("GetContentPreferences failed for user %d: %v", req.UserId, err)
+        return nil, status.Errorf(codes.Internal, "Failed to retrieve content preferences")
+    }
+    return &userpb.ContentPreferences{ShowSensitiveContent: user.ShowSensitiveContent, MentionPermission: user.MentionPermission}, nil
+}
+
 func uintSliceToUint32Slice(u []uint) []uint32 {
     u32 := make([]uint32, len(u))
     for i, v := range u { u32[i] = uint32(v) }
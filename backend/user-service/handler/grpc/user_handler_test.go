@@ -8,6 +8,7 @@ import (
 	userpb "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/genproto/proto"    // Import the generated protobuf package
 	userhandler "github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/handler/grpc" // Import actual handler
 	"github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/handler/grpc/mocks"       // Import the mock package
+	"github.com/Acad600-TPA/WEB-MJ-242/backend/user-service/repository/postgres"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
@@ -117,4 +118,93 @@ func TestUserHandler_Register_MissingRequiredFields(t *testing.T) {
             mockRepo.AssertNotCalled(t, "GetUserByEmail") // If you check for existing email before this basic validation
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestUserHandler_UpdateUserProfile_InvalidMentionPermission(t *testing.T) {
+	mockRepo := new(mocks.MockUserRepo)
+	handler := userhandler.NewUserHandler(mockRepo)
+
+	mockRepo.On("GetUserByID", mock.Anything, uint(1)).Return(&postgres.User{Username: "tester"}, nil).Once()
+
+	permission := "friends"
+	_, err := handler.UpdateUserProfile(context.Background(), &userpb.UpdateUserProfileRequest{UserId: 1, MentionPermission: &permission})
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok, "Error should be a gRPC status error")
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	mockRepo.AssertNotCalled(t, "UpdateUser")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUserHandler_AddMutedWord_InvalidScope(t *testing.T) {
+	mockRepo := new(mocks.MockUserRepo)
+	handler := userhandler.NewUserHandler(mockRepo)
+
+	_, err := handler.AddMutedWord(context.Background(), &userpb.AddMutedWordRequest{UserId: 1, Phrase: "spoilers", Scopes: []string{"messages"}})
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok, "Error should be a gRPC status error")
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	mockRepo.AssertNotCalled(t, "UpsertMutedWord")
+}
+
+func TestUserHandler_GetMuteFilter_OnlyPhrasesInScope(t *testing.T) {
+	mockRepo := new(mocks.MockUserRepo)
+	handler := userhandler.NewUserHandler(mockRepo)
+
+	mockRepo.On("GetMutedUserIDs", mock.Anything, uint(1), mock.Anything, 0).Return([]uint{7, 9}, nil).Once()
+	mockRepo.On("GetActiveMutedWords", mock.Anything, uint(1), mock.Anything).Return([]postgres.MutedWord{
+		{Phrase: "spoilers", Scopes: "home,replies"},
+		{Phrase: "#election", Scopes: "notifications"},
+	}, nil).Once()
+
+	filter, err := handler.GetMuteFilter(context.Background(), &userpb.GetMuteFilterRequest{UserId: 1, Scope: "replies"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{7, 9}, filter.MutedUserIds)
+	assert.Equal(t, []string{"spoilers"}, filter.Phrases)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUserHandler_GetList_PrivateListHiddenFromOthers(t *testing.T) {
+	mockRepo := new(mocks.MockUserRepo)
+	handler := userhandler.NewUserHandler(mockRepo)
+
+	mockRepo.On("GetListByID", mock.Anything, uint(3)).Return(&postgres.List{ID: 3, OwnerID: 1, Name: "Close friends", IsPrivate: true}, nil).Once()
+
+	_, err := handler.GetList(context.Background(), &userpb.ListRequest{ListId: 3, RequesterId: 2})
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok, "Error should be a gRPC status error")
+	assert.Equal(t, codes.NotFound, st.Code())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUserHandler_AddListMember_OnlyOwner(t *testing.T) {
+	mockRepo := new(mocks.MockUserRepo)
+	handler := userhandler.NewUserHandler(mockRepo)
+
+	mockRepo.On("GetListByID", mock.Anything, uint(3)).Return(&postgres.List{ID: 3, OwnerID: 1, Name: "Go devs"}, nil).Once()
+
+	_, err := handler.AddListMember(context.Background(), &userpb.ListMemberRequest{ListId: 3, RequesterId: 2, UserId: 5})
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok, "Error should be a gRPC status error")
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+	mockRepo.AssertNotCalled(t, "AddListMember")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUserHandler_GetContentPreferences(t *testing.T) {
+	mockRepo := new(mocks.MockUserRepo)
+	handler := userhandler.NewUserHandler(mockRepo)
+
+	mockRepo.On("GetUserByID", mock.Anything, uint(1)).Return(&postgres.User{ShowSensitiveContent: true, MentionPermission: "following"}, nil).Once()
+
+	prefs, err := handler.GetContentPreferences(context.Background(), &userpb.GetContentPreferencesRequest{UserId: 1})
+
+	assert.NoError(t, err)
+	assert.True(t, prefs.ShowSensitiveContent)
+	assert.Equal(t, "following", prefs.MentionPermission)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,145 @@
+// Package mute defines mute scopes and matches muted words against text. It has no dependencies so
+// thread-service and notification-service can apply mutes the same way user-service stores them.
+package mute
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	ScopeHome          = "home"          // feeds, hashtag, category and community timelines
+	ScopeReplies       = "replies"       // replies, conversations and quotes
+	ScopeNotifications = "notifications" // notifications and the mentions timeline
+	ScopeSearch        = "search"
+
+	MaxPhraseLength = 100
+)
+
+// Scopes lists every scope, in display order.
+var Scopes = []string{ScopeHome, ScopeReplies, ScopeNotifications, ScopeSearch}
+
+var (
+	ErrEmptyPhrase   = errors.New("muted phrase cannot be empty")
+	ErrPhraseTooLong = errors.New("muted phrase is too long")
+	ErrUnknownScope  = errors.New("unknown mute scope")
+)
+
+// NormalizePhrase lowercases phrase and collapses its whitespace.
+func NormalizePhrase(phrase string) (string, error) {
+	normalized := strings.Join(strings.Fields(strings.ToLower(phrase)), " ")
+	if normalized == "" || normalized == "#" {
+		return "", ErrEmptyPhrase
+	}
+	if utf8.RuneCountInString(normalized) > MaxPhraseLength {
+		return "", ErrPhraseTooLong
+	}
+	return normalized, nil
+}
+
+// NormalizeScopes validates and dedupes scopes, in display order. No scopes means all of them.
+func NormalizeScopes(scopes []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return append([]string(nil), Scopes...), nil
+	}
+	seen := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if !IsScope(scope) {
+			return nil, ErrUnknownScope
+		}
+		seen[scope] = true
+	}
+	normalized := make([]string, 0, len(seen))
+	for _, scope := range Scopes {
+		if seen[scope] {
+			normalized = append(normalized, scope)
+		}
+	}
+	return normalized, nil
+}
+
+func IsScope(scope string) bool {
+	for _, s := range Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter matches text against muted phrases. Phrases match whole words, case-insensitively,
+// so "cat" hides "Cat!" and "#cat" but not "category". A #hashtag phrase only hides the hashtag.
+type Filter struct {
+	phrases []string
+}
+
+func NewFilter(phrases []string) *Filter {
+	f := &Filter{}
+	for _, phrase := range phrases {
+		if normalized, err := NormalizePhrase(phrase); err == nil {
+			f.phrases = append(f.phrases, normalized)
+		}
+	}
+	return f
+}
+
+func (f *Filter) Empty() bool {
+	return f == nil || len(f.phrases) == 0
+}
+
+// Matches reports whether text contains any muted phrase.
+func (f *Filter) Matches(text string) bool {
+	if f.Empty() || text == "" {
+		return false
+	}
+	text = strings.Join(strings.Fields(strings.ToLower(text)), " ")
+	for _, phrase := range f.phrases {
+		if containsWord(text, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsWord reports whether phrase occurs in text without word characters directly around it.
+func containsWord(text, phrase string) bool {
+	for start := 0; start <= len(text)-len(phrase); {
+		i := strings.Index(text[start:], phrase)
+		if i < 0 {
+			return false
+		}
+		i += start
+		end := i + len(phrase)
+		if boundaryBefore(text, i, phrase) && boundaryAfter(text, end, phrase) {
+			return true
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		start = i + size
+	}
+	return false
+}
+
+func boundaryBefore(text string, i int, phrase string) bool {
+	first, _ := utf8.DecodeRuneInString(phrase)
+	if !isWordRune(first) || i == 0 {
+		return true
+	}
+	prev, _ := utf8.DecodeLastRuneInString(text[:i])
+	return !isWordRune(prev)
+}
+
+func boundaryAfter(text string, end int, phrase string) bool {
+	last, _ := utf8.DecodeLastRuneInString(phrase)
+	if !isWordRune(last) || end == len(text) {
+		return true
+	}
+	next, _ := utf8.DecodeRuneInString(text[end:])
+	return !isWordRune(next)
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
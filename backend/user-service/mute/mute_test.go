@@ -0,0 +1,62 @@
+package mute
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePhrase(t *testing.T) {
+	phrase, err := NormalizePhrase("  Spoiler   ALERT ")
+	assert.NoError(t, err)
+	assert.Equal(t, "spoiler alert", phrase)
+
+	_, err = NormalizePhrase("   ")
+	assert.ErrorIs(t, err, ErrEmptyPhrase)
+	_, err = NormalizePhrase("#")
+	assert.ErrorIs(t, err, ErrEmptyPhrase)
+	_, err = NormalizePhrase(strings.Repeat("a", MaxPhraseLength+1))
+	assert.ErrorIs(t, err, ErrPhraseTooLong)
+}
+
+func TestNormalizeScopes(t *testing.T) {
+	scopes, err := NormalizeScopes(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, Scopes, scopes)
+
+	scopes, err = NormalizeScopes([]string{"search", " Home", "search"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{ScopeHome, ScopeSearch}, scopes)
+
+	_, err = NormalizeScopes([]string{"home", "messages"})
+	assert.ErrorIs(t, err, ErrUnknownScope)
+}
+
+func TestFilterMatches(t *testing.T) {
+	f := NewFilter([]string{"Cat", "spoiler alert", "#GoLang", "c++"})
+
+	for _, text := range []string{
+		"My CAT is here",
+		"cat!",
+		"loving my #cat",
+		"big SPOILER\n alert ahead",
+		"learning #golang today",
+		"i write c++ daily",
+	} {
+		assert.True(t, f.Matches(text), text)
+	}
+	for _, text := range []string{
+		"category theory",
+		"concatenate",
+		"spoiler warning",
+		"golang without the hashtag",
+		"#golangweekly",
+		"",
+	} {
+		assert.False(t, f.Matches(text), text)
+	}
+
+	assert.True(t, NewFilter(nil).Empty())
+	assert.False(t, (*Filter)(nil).Matches("cat"))
+}
@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// List is a curated group of accounts with its own timeline.
+type List struct {
+	ID          uint   `gorm:"primaryKey"`
+	OwnerID     uint   `gorm:"not null;index"`
+	Name        string `gorm:"type:varchar(25);not null"`
+	Description string `gorm:"type:varchar(100)"`
+	IsPrivate   bool   `gorm:"default:false;not null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type ListMember struct {
+	ListID    uint `gorm:"primaryKey;autoIncrement:false"`
+	UserID    uint `gorm:"primaryKey;autoIncrement:false;index"`
+	CreatedAt time.Time
+}
+
+type ListFollower struct {
+	ListID    uint `gorm:"primaryKey;autoIncrement:false"`
+	UserID    uint `gorm:"primaryKey;autoIncrement:false;index"`
+	CreatedAt time.Time
+}
+
+func (r *UserRepository) CreateList(ctx context.Context, list *List) error {
+	if err := r.db.WithContext(ctx).Create(list).Error; err != nil {
+		return fmt.Errorf("failed to create list: %w", err)
+	}
+	return nil
+}
+
+func (r *UserRepository) GetListByID(ctx context.Context, listID uint) (*List, error) {
+	var list List
+	if err := r.db.WithContext(ctx).First(&list, listID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("list not found")
+		}
+		return nil, fmt.Errorf("failed to get list: %w", err)
+	}
+	return &list, nil
+}
+
+// UpdateList applies updates to a list. Making a list private also removes its followers.
+func (r *UserRepository) UpdateList(ctx context.Context, listID uint, updates map[string]interface{}) (*List, error) {
+	var list List
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&List{}).Where("id = ?", listID).Updates(updates).Error; err != nil {
+			return err
+		}
+		if isPrivate, ok := updates["is_private"].(bool); ok && isPrivate {
+			if err := tx.Delete(&ListFollower{}, "list_id = ?", listID).Error; err != nil {
+				return err
+			}
+		}
+		return tx.First(&list, listID).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update list: %w", err)
+	}
+	return &list, nil
+}
+
+func (r *UserRepository) DeleteList(ctx context.Context, listID uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&ListMember{}, "list_id = ?", listID).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&ListFollower{}, "list_id = ?", listID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&List{}, listID).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete list: %w", err)
+	}
+	return nil
+}
+
+// GetListsByOwner returns the lists ownerID created, newest first. Private lists are left out unless includePrivate is set.
+func (r *UserRepository) GetListsByOwner(ctx context.Context, ownerID uint, includePrivate bool, limit, offset int) ([]List, error) {
+	var lists []List
+	query := r.db.WithContext(ctx).Where("owner_id = ?", ownerID)
+	if !includePrivate {
+		query = query.Where("is_private = ?", false)
+	}
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&lists).Error; err != nil {
+		return nil, fmt.Errorf("failed to get lists of user %d: %w", ownerID, err)
+	}
+	return lists, nil
+}
+
+// GetFollowedLists returns the public lists userID follows, most recently followed first.
+func (r *UserRepository) GetFollowedLists(ctx context.Context, userID uint, limit, offset int) ([]List, error) {
+	var lists []List
+	err := r.db.WithContext(ctx).
+		Joins("JOIN list_followers ON list_followers.list_id = lists.id AND list_followers.user_id = ?", userID).
+		Where("lists.is_private = ?", false).
+		Order("list_followers.created_at DESC, lists.id DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&lists).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lists followed by user %d: %w", userID, err)
+	}
+	return lists, nil
+}
+
+func (r *UserRepository) AddListMember(ctx context.Context, listID, userID uint) error {
+	member := ListMember{ListID: listID, UserID: userID}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&member).Error; err != nil {
+		return fmt.Errorf("failed to add list member: %w", err)
+	}
+	return nil
+}
+
+func (r *UserRepository) RemoveListMember(ctx context.Context, listID, userID uint) error {
+	if err := r.db.WithContext(ctx).Delete(&ListMember{}, "list_id = ? AND user_id = ?", listID, userID).Error; err != nil {
+		return fmt.Errorf("failed to remove list member: %w", err)
+	}
+	return nil
+}
+
+// GetListMemberIDs returns the members of a list, most recently added first.
+func (r *UserRepository) GetListMemberIDs(ctx context.Context, listID uint, limit, offset int) ([]uint, error) {
+	var memberIDs []uint
+	err := r.db.WithContext(ctx).Model(&ListMember{}).Where("list_id = ?", listID).Order("created_at DESC").Limit(limit).Offset(offset).Pluck("user_id", &memberIDs).Error
+	return memberIDs, err
+}
+
+func (r *UserRepository) CountListMembers(ctx context.Context, listID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&ListMember{}).Where("list_id = ?", listID).Count(&count).Error
+	return count, err
+}
+
+func (r *UserRepository) CountListFollowers(ctx context.Context, listID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&ListFollower{}).Where("list_id = ?", listID).Count(&count).Error
+	return count, err
+}
+
+func (r *UserRepository) FollowList(ctx context.Context, listID, userID uint) error {
+	follower := ListFollower{ListID: listID, UserID: userID}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&follower).Error; err != nil {
+		return fmt.Errorf("failed to follow list: %w", err)
+	}
+	return nil
+}
+
+func (r *UserRepository) UnfollowList(ctx context.Context, listID, userID uint) error {
+	if err := r.db.WithContext(ctx).Delete(&ListFollower{}, "list_id = ? AND user_id = ?", listID, userID).Error; err != nil {
+		return fmt.Errorf("failed to unfollow list: %w", err)
+	}
+	return nil
+}
+
+func (r *UserRepository) IsFollowingList(ctx context.Context, listID, userID uint) (bool, error) {
+	if userID == 0 {
+		return false, nil
+	}
+	var count int64
+	err := r.db.WithContext(ctx).Model(&ListFollower{}).Where("list_id = ? AND user_id = ?", listID, userID).Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+// Mute hides MutedID's content from MuterID without blocking, so MutedID is never told.
+type Mute struct {
+	MuterID   uint `gorm:"primaryKey;autoIncrement:false"`
+	MutedID   uint `gorm:"primaryKey;autoIncrement:false"`
+	CreatedAt time.Time
+}
+
+// MutedWord hides content containing Phrase in the listed scopes until ExpiresAt.
+type MutedWord struct {
+	ID        uint       `gorm:"primaryKey"`
+	UserID    uint       `gorm:"not null;uniqueIndex:idx_muted_words_user_phrase"`
+	Phrase    string     `gorm:"type:varchar(100);not null;uniqueIndex:idx_muted_words_user_phrase"`
+	Scopes    string     `gorm:"type:varchar(64);not null"` // comma-separated mute scopes
+	ExpiresAt *time.Time `gorm:"index"`
+	CreatedAt time.Time
+}
+
+func (Mute) TableName() string      { return "mutes" }
+func (MutedWord) TableName() string { return "muted_words" }
+
+func (r *UserRepository) MuteUser(ctx context.Context, muterID, mutedID uint) error {
+	if muterID == mutedID {
+		return errors.New("user cannot mute themselves")
+	}
+	mute := Mute{MuterID: muterID, MutedID: mutedID}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&mute).Error; err != nil {
+		return fmt.Errorf("failed to mute user: %w", err)
+	}
+	log.Printf("User %d muted User %d", muterID, mutedID)
+	return nil
+}
+
+func (r *UserRepository) UnmuteUser(ctx context.Context, muterID, mutedID uint) error {
+	if err := r.db.WithContext(ctx).Delete(&Mute{}, "muter_id = ? AND muted_id = ?", muterID, mutedID).Error; err != nil {
+		return fmt.Errorf("failed to unmute user: %w", err)
+	}
+	return nil
+}
+
+func (r *UserRepository) HasMuted(ctx context.Context, muterID, mutedID uint) (bool, error) {
+	if muterID == 0 {
+		return false, nil
+	}
+	var count int64
+	err := r.db.WithContext(ctx).Model(&Mute{}).Where("muter_id = ? AND muted_id = ?", muterID, mutedID).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *UserRepository) GetMutedUserIDs(ctx context.Context, userID uint, limit, offset int) ([]uint, error) {
+	var mutedIDs []uint
+	err := r.db.WithContext(ctx).Model(&Mute{}).Where("muter_id = ?", userID).Order("created_at DESC").Limit(limit).Offset(offset).Pluck("muted_id", &mutedIDs).Error
+	return mutedIDs, err
+}
+
+// UpsertMutedWord saves word, replacing the scopes and expiry if the user already muted the phrase.
+func (r *UserRepository) UpsertMutedWord(ctx context.Context, word *MutedWord) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "phrase"}},
+		DoUpdates: clause.AssignmentColumns([]string{"scopes", "expires_at"}),
+	}).Create(word).Error
+	if err != nil {
+		return fmt.Errorf("failed to save muted word: %w", err)
+	}
+	return nil
+}
+
+func (r *UserRepository) DeleteMutedWord(ctx context.Context, userID, wordID uint) error {
+	result := r.db.WithContext(ctx).Delete(&MutedWord{}, "id = ? AND user_id = ?", wordID, userID)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete muted word: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("muted word not found")
+	}
+	return nil
+}
+
+// GetActiveMutedWords returns the user's muted words that have not expired by now, newest first.
+func (r *UserRepository) GetActiveMutedWords(ctx context.Context, userID uint, now time.Time) ([]MutedWord, error) {
+	var words []MutedWord
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND (expires_at IS NULL OR expires_at > ?)", userID, now).
+		Order("created_at DESC, id DESC").
+		Find(&words).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get muted words: %w", err)
+	}
+	return words, nil
+}
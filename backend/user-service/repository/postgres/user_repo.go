@@ -44,6 +44,27 @@ type IUserRepo interface {
 	GetPremiumApplicationByUserID(ctx context.Context, userID uint) (*PremiumApplication, error)
 	ApprovePremiumApplication(ctx context.Context, applicationID uint, adminUserID uint) error
 	RejectPremiumApplication(ctx context.Context, applicationID uint, adminUserID uint, adminNotes string) error
+	MuteUser(ctx context.Context, muterID, mutedID uint) error
+	UnmuteUser(ctx context.Context, muterID, mutedID uint) error
+	HasMuted(ctx context.Context, muterID, mutedID uint) (bool, error)
+	GetMutedUserIDs(ctx context.Context, userID uint, limit, offset int) ([]uint, error)
+	UpsertMutedWord(ctx context.Context, word *MutedWord) error
+	DeleteMutedWord(ctx context.Context, userID, wordID uint) error
+	GetActiveMutedWords(ctx context.Context, userID uint, now time.Time) ([]MutedWord, error)
+	CreateList(ctx context.Context, list *List) error
+	GetListByID(ctx context.Context, listID uint) (*List, error)
+	UpdateList(ctx context.Context, listID uint, updates map[string]interface{}) (*List, error)
+	DeleteList(ctx context.Context, listID uint) error
+	GetListsByOwner(ctx context.Context, ownerID uint, includePrivate bool, limit, offset int) ([]List, error)
+	GetFollowedLists(ctx context.Context, userID uint, limit, offset int) ([]List, error)
+	AddListMember(ctx context.Context, listID, userID uint) error
+	RemoveListMember(ctx context.Context, listID, userID uint) error
+	GetListMemberIDs(ctx context.Context, listID uint, limit, offset int) ([]uint, error)
+	CountListMembers(ctx context.Context, listID uint) (int64, error)
+	CountListFollowers(ctx context.Context, listID uint) (int64, error)
+	FollowList(ctx context.Context, listID, userID uint) error
+	UnfollowList(ctx context.Context, listID, userID uint) error
+	IsFollowingList(ctx context.Context, listID, userID uint) (bool, error)
 }
 
 
@@ -65,6 +86,8 @@ type User struct {
 	SubscribedToNewsletter bool   `gorm:"default:false;not null"`
 	Bio				   string `gorm:"type:text"`
 	IsVerified			   bool   `gorm:"default:false;not null;index"`
+	MentionPermission      string `gorm:"type:varchar(10);default:'everyone';not null"`
+	ShowSensitiveContent   bool   `gorm:"default:false;not null"`
 }
 
 type Follow struct {
@@ -112,7 +135,7 @@ func NewUserRepository() (*UserRepository, error) {
 		return nil, err
 	}
 
-	if err := db.AutoMigrate(&User{}, &Follow{}, &Block{}, &PremiumApplication{}); err != nil {
+	if err := db.AutoMigrate(&User{}, &Follow{}, &Block{}, &PremiumApplication{}, &Mute{}, &MutedWord{}, &List{}, &ListMember{}, &ListFollower{}); err != nil {
 		return nil, err
 	}
 
@@ -143,6 +166,9 @@ func (r *UserRepository) CreateUser(ctx context.Context, user *User, plainPasswo
 	if user.AccountPrivacy == "" {
 		user.AccountPrivacy = "public"
 	}
+	if user.MentionPermission == "" {
+		user.MentionPermission = "everyone"
+	}
 
 	result := r.db.WithContext(ctx).Create(user)
 	if result.Error != nil {
@@ -27,8 +27,13 @@ export interface UserProfileBasic {
   subscribed_to_newsletter: boolean;
   bio: string;
   is_verified: boolean;
+  mention_permission?: MentionPermission;
+  show_sensitive_content?: boolean;
 }
 
+// Who may @mention a user. Mentions by anyone else stay plain text.
+export type MentionPermission = "everyone" | "following" | "none";
+
 export interface UserProfileResponseData {
   user: UserProfileBasic | null;
   follower_count: number;
@@ -36,6 +41,7 @@ export interface UserProfileResponseData {
   is_followed_by_requester: boolean;
   is_blocked_by_requester: boolean;
   is_blocking_requester: boolean;
+  is_muted_by_requester?: boolean;
 }
 
 export interface MediaMetadata {
@@ -66,16 +72,121 @@ export interface ThreadData {
   media?: MediaMetadata[]; // Hydrated media info
   is_liked_by_current_user?: boolean;
   is_bookmarked_by_current_user?: boolean;
-  is_reposted_by_current_user?: boolean; // Add later
+  is_reposted_by_current_user?: boolean;
+  can_reply?: boolean; // Computed by the server from reply_restriction
   like_count: number;
   reply_count: number;
   repost_count: number;
+  quote_count?: number;
   bookmark_count: number;
+  quoted_thread_id?: number;
+  quoted_thread?: ThreadData | null; // Embedded quoted thread, missing if it was deleted
+  reposted_by?: UserProfileBasic | null; // Set when a followed user's repost surfaced this thread
+  reposted_at?: string | null;
+  edited_at?: string | null; // Set once the thread has been edited
+  poll?: PollData | null;
+  parent_deleted?: boolean; // Reply whose parent thread was deleted
+  mentioned_usernames?: string[]; // Only these @handles link to profiles
+  ad_campaign_id?: number; // Set on promoted threads placed in a feed
+  link_preview?: LinkPreviewData | null; // Card for the first link in content, once fetched
+  is_hidden?: boolean; // Reply hidden by the parent thread's author
+  content_warning?: string;
+  is_sensitive?: boolean;
+  is_masked?: boolean; // Media, link preview and, with a content warning, the text were withheld
+}
+
+export interface ModerationActionData {
+  id: number;
+  thread_id: number;
+  actor_id: number;
+  action: 'hide_reply' | 'unhide_reply' | 'remove_mention' | 'change_reply_restriction' | 'mark_sensitive' | 'unmark_sensitive';
+  target_thread_id?: number;
+  target_user_id?: number;
+  detail?: string; // e.g. "everyone -> following"
+  created_at: string;
+}
+
+export interface ThreadAnalyticsBucket {
+  hour: string; // start of the UTC hour
+  impressions: number;
+  follower_impressions: number;
+  non_follower_impressions: number;
+  detail_views: number;
+  profile_clicks: number;
+}
+
+export interface ThreadAnalyticsData {
+  thread_id: number;
+  impressions: number;
+  follower_reach: number;
+  non_follower_reach: number;
+  detail_views: number;
+  profile_clicks: number;
+  engagements: number;
+  engagement_rate: number; // engagements per impression
+  buckets: ThreadAnalyticsBucket[];
+}
+
+export interface LinkPreviewData {
+  url: string;
+  title: string;
+  description?: string;
+  image_url?: string;
+  site_name?: string;
+}
+
+export interface PollOptionData {
+  id: number;
+  text: string;
+  vote_count: number; // 0 until results are visible
+}
+
+export interface PollData {
+  id: number;
+  options: PollOptionData[];
+  closes_at: string; // ISO String
+  is_closed: boolean;
+  results_visible: boolean; // true once the viewer voted or the poll closed
+  voted_option_id?: number;
+  total_votes: number;
+}
+
+export interface ThreadRevisionData {
+  id: number;
+  thread_id: number;
+  content: string;
+  media_ids: number[];
+  media?: MediaMetadata[];
+  created_at: string; // When this version was replaced
+  is_masked?: boolean; // Withheld like the thread itself; the viewer hasn't opted in
+}
+
+export interface ConversationNode {
+  thread: ThreadData;
+  replies: ConversationNode[]; // Oldest first
+  more_replies_cursor?: string; // Pass to getRepliesForThread to load the rest of this node's replies
+}
+
+export interface ConversationResponse {
+  ancestors: ThreadData[]; // Root first, ending with the parent of the focused thread
+  focus: ConversationNode;
 }
 
 export interface FeedResponse {
   threads: ThreadData[];
   has_more: boolean;
+  next_cursor?: string; // Pass back to fetch the next page without skips or duplicates
+}
+
+export interface DeletedThreadData extends ThreadData {
+  deleted_at: string;
+  purge_at: string; // can't be restored after this
+}
+
+export interface DeletedThreadsResponse {
+  threads: DeletedThreadData[];
+  has_more: boolean;
+  next_cursor?: string;
 }
 
 export interface SearchUsersApiResponse {
@@ -97,6 +208,56 @@ export interface TrendingHashtagItem {
   count: number;
 }
 
+export interface RelatedHashtagItem {
+  tag: string;
+  co_occurrences: number;
+}
+
+export interface HashtagThreadsResponse extends FeedResponse {
+  hashtag: string;
+  thread_count?: number; // first page only
+  related_hashtags?: RelatedHashtagItem[]; // first page only
+}
+
+export interface CategoryExploreItem {
+  category: string; // world, sports, business or sci_tech
+  post_count: number; // posts in the window
+  previous_post_count: number; // posts in the window before it
+  top_threads: ThreadData[];
+}
+
+export interface AdCampaign {
+  id: number;
+  thread_id: number;
+  budget_cents: number;
+  spent_cents: number;
+  starts_at: string;
+  ends_at: string;
+  target_categories: string[];
+  target_follower_of_user_ids: number[];
+  impressions: number;
+  clicks: number;
+  thread?: ThreadData;
+  created_at: string;
+}
+
+export interface CreatePromotedThreadPayload {
+  content: string;
+  media_ids?: number[];
+  categories?: string[];
+  reply_restriction?: string;
+  budget_cents: number;
+  starts_at?: string; // ISO 8601, defaults to now
+  ends_at: string; // ISO 8601
+  target_categories?: string[];
+  target_follower_of?: string[]; // usernames
+}
+
+export interface CategoryExploreResponse {
+  window_hours: number;
+  categories: CategoryExploreItem[]; // busiest first
+}
+
 export interface ErrorResponse {
   error?: string;
   message?: string;
@@ -165,6 +326,18 @@ export async function refreshAccessToken(): Promise<boolean> {
 
 // --- Generic Fetch Wrapper ---
 
+const SESSION_ID_KEY = "sessionId";
+
+// One ID per browser tab, so the backend serves each ad once per session.
+function getSessionId(): string {
+  let sessionId = sessionStorage.getItem(SESSION_ID_KEY);
+  if (!sessionId) {
+    sessionId = crypto.randomUUID();
+    sessionStorage.setItem(SESSION_ID_KEY, sessionId);
+  }
+  return sessionId;
+}
+
 /**
  * Generic fetch wrapper with JWT handling and improved error management.
  * @param endpoint API endpoint path (e.g., "/users/health")
@@ -195,6 +368,7 @@ async function apiFetch<T>(
   if (token) {
     requestHeaders.set("Authorization", `Bearer ${token}`);
   }
+  requestHeaders.set("X-Session-ID", getSessionId());
 
   let response: Response;
   try {
@@ -314,6 +488,8 @@ export interface UpdateUserProfileRequestData {
   date_of_birth?: string | null;
   account_privacy?: "public" | "private" | null;
   subscribed_to_newsletter?: boolean | null;
+  mention_permission?: MentionPermission | null;
+  show_sensitive_content?: boolean | null;
 }
 
 export interface ResendVerificationRequestData {
@@ -328,6 +504,24 @@ export interface CreateThreadRequestData {
   community_id?: number | null;
   media_ids?: number[];
   categories?: string[];
+  quoted_thread_id?: number | null;
+  poll_options?: string[]; // 2-4 options, 25 characters each
+  poll_closes_at?: string | null; // ISO String, defaults to 24h after posting
+  content_warning?: string; // up to 100 characters
+  is_sensitive?: boolean;
+}
+
+export interface ChainPostData {
+  content: string;
+  media_ids?: number[];
+  categories?: string[];
+}
+
+export interface CreateThreadChainRequestData {
+  posts: ChainPostData[]; // 2-25 posts, each replying to the one before
+  reply_restriction?: string;
+  scheduled_at?: string | null;
+  community_id?: number | null;
 }
 
 export interface UploadMediaResponseData {
@@ -352,6 +546,53 @@ export interface SocialListResponseData {
   has_more: boolean;
 }
 
+// Where a muted word is hidden.
+export type MuteScope = "home" | "replies" | "notifications" | "search";
+
+export interface MutedWord {
+  id: number;
+  phrase: string; // lowercased; a word, a phrase or a #hashtag
+  scopes: MuteScope[];
+  expires_at?: string; // unset mutes forever
+  created_at: string;
+}
+
+export interface AddMutedWordPayload {
+  phrase: string;
+  scopes?: MuteScope[]; // empty mutes everywhere
+  expires_at?: string; // ISO 8601
+}
+
+// A private list is only visible to its owner, and can't be followed.
+export interface UserList {
+  id: number;
+  owner?: UserProfileBasic;
+  name: string;
+  description: string;
+  is_private: boolean;
+  member_count: number;
+  follower_count: number;
+  is_followed_by_requester: boolean;
+  created_at: string;
+}
+
+export interface UserListsResponse {
+  lists: UserList[];
+  has_more: boolean;
+}
+
+export interface CreateListPayload {
+  name: string; // up to 25 characters
+  description?: string; // up to 100 characters
+  is_private?: boolean;
+}
+
+export interface UpdateListPayload {
+  name?: string;
+  description?: string;
+  is_private?: boolean; // making a list private removes its followers
+}
+
 export interface NotificationData {
   id: number;
   user_id: number;
@@ -594,6 +835,11 @@ export interface PremiumApplicationStatusResponse {
   is_user_verified: boolean;
 }
 
+/** Query string fragment for an optional pagination cursor. */
+function cursorParam(cursor?: string): string {
+  return cursor ? `&cursor=${encodeURIComponent(cursor)}` : "";
+}
+
 // --- API Methods ---
 export const api = {
   getHealth: (): Promise<HealthResponse> =>
@@ -667,6 +913,70 @@ export const api = {
     apiFetch<void>(`/profiles/${username}/block`, { method: "POST" }),
   unblockUser: (username: string): Promise<void> =>
     apiFetch<void>(`/profiles/${username}/block`, { method: "DELETE" }),
+  muteUser: (username: string): Promise<void> =>
+    apiFetch<void>(`/profiles/${username}/mute`, { method: "POST" }),
+  unmuteUser: (username: string): Promise<void> =>
+    apiFetch<void>(`/profiles/${username}/mute`, { method: "DELETE" }),
+
+  getMutedAccounts: (
+    page: number = 1,
+    limit: number = 20
+  ): Promise<SocialListResponseData> =>
+    apiFetch<SocialListResponseData>(
+      `/users/me/muted/accounts?page=${page}&limit=${limit}`,
+      { method: "GET" }
+    ),
+  getMutedWords: (): Promise<{ muted_words: MutedWord[] }> =>
+    apiFetch<{ muted_words: MutedWord[] }>("/users/me/muted/words", {
+      method: "GET",
+    }),
+  addMutedWord: (payload: AddMutedWordPayload): Promise<MutedWord> =>
+    apiFetch<MutedWord>("/users/me/muted/words", {
+      method: "POST",
+      body: JSON.stringify(payload),
+    }),
+  removeMutedWord: (wordId: number): Promise<void> =>
+    apiFetch<void>(`/users/me/muted/words/${wordId}`, { method: "DELETE" }),
+
+  createList: (payload: CreateListPayload): Promise<UserList> =>
+    apiFetch<UserList>("/lists", {
+      method: "POST",
+      body: JSON.stringify(payload),
+    }),
+  getList: (listId: number): Promise<UserList> =>
+    apiFetch<UserList>(`/lists/${listId}`, { method: "GET" }),
+  updateList: (listId: number, payload: UpdateListPayload): Promise<UserList> =>
+    apiFetch<UserList>(`/lists/${listId}`, {
+      method: "PATCH",
+      body: JSON.stringify(payload),
+    }),
+  deleteList: (listId: number): Promise<void> =>
+    apiFetch<void>(`/lists/${listId}`, { method: "DELETE" }),
+  getMyLists: (page: number = 1, limit: number = 20): Promise<UserListsResponse> =>
+    apiFetch<UserListsResponse>(`/users/me/lists?page=${page}&limit=${limit}`, { method: "GET" }),
+  getMyFollowedLists: (page: number = 1, limit: number = 20): Promise<UserListsResponse> =>
+    apiFetch<UserListsResponse>(`/users/me/lists/followed?page=${page}&limit=${limit}`, { method: "GET" }),
+  getUserLists: (username: string, page: number = 1, limit: number = 20): Promise<UserListsResponse> =>
+    apiFetch<UserListsResponse>(`/profiles/${username}/lists?page=${page}&limit=${limit}`, { method: "GET" }),
+  getListMembers: (
+    listId: number,
+    page: number = 1,
+    limit: number = 20
+  ): Promise<SocialListResponseData> =>
+    apiFetch<SocialListResponseData>(
+      `/lists/${listId}/members?page=${page}&limit=${limit}`,
+      { method: "GET" }
+    ),
+  addListMember: (listId: number, username: string): Promise<void> =>
+    apiFetch<void>(`/lists/${listId}/members/${username}`, { method: "POST" }),
+  removeListMember: (listId: number, username: string): Promise<void> =>
+    apiFetch<void>(`/lists/${listId}/members/${username}`, { method: "DELETE" }),
+  followList: (listId: number): Promise<void> =>
+    apiFetch<void>(`/lists/${listId}/follow`, { method: "POST" }),
+  unfollowList: (listId: number): Promise<void> =>
+    apiFetch<void>(`/lists/${listId}/follow`, { method: "DELETE" }),
+  getListThreads: (listId: number, limit: number = 20, cursor?: string): Promise<FeedResponse> =>
+    apiFetch<FeedResponse>(`/lists/${listId}/threads?limit=${limit}` + cursorParam(cursor), { method: "GET" }),
 
   getFollowers: (
     username: string,
@@ -748,12 +1058,23 @@ export const api = {
       method: "POST",
       body: JSON.stringify(data),
     }),
+  createThreadChain: (
+    data: CreateThreadChainRequestData
+  ): Promise<{ threads: ThreadData[] }> => // All posts are created or none are
+    apiFetch<{ threads: ThreadData[] }>("/threads/chain", {
+      method: "POST",
+      body: JSON.stringify(data),
+    }),
   getThread: (
     threadId: number
   ): Promise<ThreadData> => // Expect backend to return hydrated thread
     apiFetch<ThreadData>(`/threads/${threadId}`, { method: "GET" }),
   deleteThread: (threadId: number): Promise<void> =>
     apiFetch<void>(`/threads/${threadId}`, { method: "DELETE" }),
+  getDeletedThreads: (page: number = 1, limit: number = 20, cursor?: string): Promise<DeletedThreadsResponse> => // own threads still in the restore window
+    apiFetch<DeletedThreadsResponse>(`/threads/deleted?page=${page}&limit=${limit}${cursorParam(cursor)}`, { method: "GET" }),
+  restoreThread: (threadId: number): Promise<ThreadData> =>
+    apiFetch<ThreadData>(`/threads/${threadId}/restore`, { method: "POST" }),
   likeThread: (threadId: number): Promise<void> =>
     apiFetch<void>(`/threads/${threadId}/like`, { method: "POST" }),
   unlikeThread: (threadId: number): Promise<void> =>
@@ -762,15 +1083,26 @@ export const api = {
     apiFetch<void>(`/threads/${threadId}/bookmark`, { method: "POST" }),
   unbookmarkThread: (threadId: number): Promise<void> =>
     apiFetch<void>(`/threads/${threadId}/bookmark`, { method: "DELETE" }),
+  editThread: (threadId: number, data: { content: string; media_ids?: number[] }): Promise<ThreadData> =>
+    apiFetch<ThreadData>(`/threads/${threadId}`, { method: "PUT", body: JSON.stringify(data) }),
+  getThreadRevisions: (threadId: number): Promise<{ revisions: ThreadRevisionData[] }> =>
+    apiFetch<{ revisions: ThreadRevisionData[] }>(`/threads/${threadId}/revisions`, { method: "GET" }),
+  repostThread: (threadId: number): Promise<void> =>
+    apiFetch<void>(`/threads/${threadId}/repost`, { method: "POST" }),
+  unrepostThread: (threadId: number): Promise<void> =>
+    apiFetch<void>(`/threads/${threadId}/repost`, { method: "DELETE" }),
+  getQuotes: (threadId: number, page: number = 1, limit: number = 20): Promise<FeedResponse> =>
+    apiFetch<FeedResponse>(`/threads/${threadId}/quotes?page=${page}&limit=${limit}`, { method: "GET" }),
 
   // Feed Method Placeholder (adjust endpoint/params as needed)
   getFeedThreads: (
     page: number = 1,
     limit: number = 20,
-    type: "foryou" | "following" = "foryou"
+    type: "foryou" | "following" = "foryou",
+    cursor?: string
   ): Promise<FeedResponse> =>
     apiFetch<FeedResponse>(
-      `/threads/feed?type=${type}&page=${page}&limit=${limit}`,
+      `/threads/feed?type=${type}&page=${page}&limit=${limit}${cursorParam(cursor)}`,
       { method: "GET" }
     ),
 
@@ -778,10 +1110,11 @@ export const api = {
     username: string,
     type: "posts" | "replies" | "likes" | "media",
     page: number = 1,
-    limit: number = 10
+    limit: number = 10,
+    cursor?: string
   ): Promise<FeedResponse> =>
     apiFetch<FeedResponse>(
-      `/profiles/${username}/threads?type=${type}&page=${page}&limit=${limit}`,
+      `/profiles/${username}/threads?type=${type}&page=${page}&limit=${limit}${cursorParam(cursor)}`,
       { method: "GET" }
     ),
 
@@ -824,6 +1157,52 @@ export const api = {
       { method: "GET" }
     ),
 
+  getHashtagThreads: (
+    tag: string,
+    sort: "latest" | "top" = "latest",
+    limit: number = 20,
+    cursor?: string
+  ): Promise<HashtagThreadsResponse> => {
+    let url = `/hashtags/${encodeURIComponent(tag)}/threads?sort=${sort}&limit=${limit}`;
+    if (cursor) url += `&cursor=${encodeURIComponent(cursor)}`;
+    return apiFetch<HashtagThreadsResponse>(url, { method: "GET" });
+  },
+
+  getCategoryThreads: (
+    category: string,
+    sort: "latest" | "top" = "latest",
+    limit: number = 20,
+    cursor?: string,
+    windowHours?: number
+  ): Promise<FeedResponse> => {
+    let url = `/categories/${encodeURIComponent(category)}/threads?sort=${sort}&limit=${limit}`;
+    if (windowHours) url += `&window_hours=${windowHours}`;
+    return apiFetch<FeedResponse>(url + cursorParam(cursor), { method: "GET" });
+  },
+
+  getCategoryExplore: (
+    windowHours: number = 24,
+    perCategory: number = 3
+  ): Promise<CategoryExploreResponse> =>
+    apiFetch<CategoryExploreResponse>(
+      `/categories/explore?window_hours=${windowHours}&per_category=${perCategory}`,
+      { method: "GET" }
+    ),
+
+  createPromotedThread: (
+    payload: CreatePromotedThreadPayload
+  ): Promise<AdCampaign> =>
+    apiFetch<AdCampaign>("/ads/campaigns", {
+      method: "POST",
+      body: JSON.stringify(payload),
+    }),
+
+  getAdCampaigns: (): Promise<{ campaigns: AdCampaign[] }> =>
+    apiFetch<{ campaigns: AdCampaign[] }>("/ads/campaigns", { method: "GET" }),
+
+  recordAdClick: (campaignId: number): Promise<void> =>
+    apiFetch<void>(`/ads/campaigns/${campaignId}/click`, { method: "POST" }),
+
   getWhoToFollow: (limit: number = 3): Promise<GetWhoToFollowApiResponse> =>
     apiFetch<GetWhoToFollowApiResponse>(
       `/suggestions/who-to-follow?limit=${limit}`,
@@ -832,9 +1211,15 @@ export const api = {
 
   getBookmarkedThreads: (
     page: number = 1,
-    limit: number = 20
+    limit: number = 20,
+    cursor?: string
   ): Promise<FeedResponse> =>
-    apiFetch<FeedResponse>(`/threads/bookmarked?page=${page}&limit=${limit}`, {
+    apiFetch<FeedResponse>(`/threads/bookmarked?page=${page}&limit=${limit}${cursorParam(cursor)}`, {
+      method: "GET",
+    }),
+
+  getMentions: (limit: number = 20, cursor?: string): Promise<FeedResponse> =>
+    apiFetch<FeedResponse>(`/users/me/mentions?limit=${limit}${cursorParam(cursor)}`, {
       method: "GET",
     }),
 
@@ -1084,10 +1469,73 @@ export const api = {
   getRepliesForThread: (
     parentThreadId: number,
     page: number = 1,
-    limit: number = 10
+    limit: number = 10,
+    cursor?: string
+  ): Promise<FeedResponse> =>
+    apiFetch<FeedResponse>(
+      `/threads/${parentThreadId}/replies?page=${page}&limit=${limit}${cursorParam(cursor)}`,
+      { method: "GET" }
+    ),
+
+  getHiddenReplies: (
+    parentThreadId: number,
+    page: number = 1,
+    limit: number = 10,
+    cursor?: string
   ): Promise<FeedResponse> =>
     apiFetch<FeedResponse>(
-      `/threads/${parentThreadId}/replies?page=${page}&limit=${limit}`,
+      `/threads/${parentThreadId}/replies/hidden?page=${page}&limit=${limit}${cursorParam(cursor)}`,
+      { method: "GET" }
+    ),
+  hideReply: (replyThreadId: number): Promise<void> =>
+    apiFetch<void>(`/threads/${replyThreadId}/hide`, { method: "POST" }),
+  unhideReply: (replyThreadId: number): Promise<void> =>
+    apiFetch<void>(`/threads/${replyThreadId}/hide`, { method: "DELETE" }),
+  removeMention: (threadId: number): Promise<void> => // Takes the current user's @mention off the thread
+    apiFetch<void>(`/threads/${threadId}/mention`, { method: "DELETE" }),
+  updateReplyRestriction: (threadId: number, replyRestriction: string): Promise<ThreadData> =>
+    apiFetch<ThreadData>(`/threads/${threadId}/reply-restriction`, {
+      method: "PUT",
+      body: JSON.stringify({ reply_restriction: replyRestriction }),
+    }),
+  setThreadSensitivity: (threadId: number, isSensitive: boolean, contentWarning: string = ""): Promise<ThreadData> => // author or moderator
+    apiFetch<ThreadData>(`/threads/${threadId}/sensitivity`, {
+      method: "PUT",
+      body: JSON.stringify({ is_sensitive: isSensitive, content_warning: contentWarning }),
+    }),
+  getThreadAnalytics: (threadId: number, hours: number = 168): Promise<ThreadAnalyticsData> => // author only
+    apiFetch<ThreadAnalyticsData>(`/threads/${threadId}/analytics?hours=${hours}`, { method: "GET" }),
+  recordProfileClick: (threadId: number): Promise<void> =>
+    apiFetch<void>(`/threads/${threadId}/profile-click`, { method: "POST" }),
+  getModerationLog: (
+    threadId: number,
+    page: number = 1,
+    limit: number = 20
+  ): Promise<{ actions: ModerationActionData[]; has_more: boolean }> =>
+    apiFetch<{ actions: ModerationActionData[]; has_more: boolean }>(
+      `/threads/${threadId}/moderation-log?page=${page}&limit=${limit}`,
       { method: "GET" }
     ),
+
+  getConversation: (
+    threadId: number,
+    depth?: number,
+    fanOut?: number
+  ): Promise<ConversationResponse> => {
+    let url = `/threads/${threadId}/conversation`;
+    const params: string[] = [];
+    if (depth) params.push(`depth=${depth}`);
+    if (fanOut) params.push(`fan_out=${fanOut}`);
+    if (params.length > 0) url += `?${params.join("&")}`;
+    return apiFetch<ConversationResponse>(url, { method: "GET" });
+  },
+
+  votePoll: (threadId: number, optionId: number): Promise<PollData> =>
+    apiFetch<PollData>(`/threads/${threadId}/poll/vote`, {
+      method: "POST",
+      body: JSON.stringify({ option_id: optionId }),
+    }),
+
+  getPollResults: (threadId: number): Promise<PollData> =>
+    apiFetch<PollData>(`/threads/${threadId}/poll`, { method: "GET" }),
 };
@@ -2,8 +2,13 @@ import "linkify-plugin-hashtag";
 import "linkify-plugin-mention";
 import linkifyHtml from "linkify-html";
 
-export function linkifyContent(text: string): string {
+// linkifyContent turns URLs, hashtags and mentions into links. When mentionedUsernames is given,
+// only those @handles are linked; the rest were not allowed as mentions and stay plain text.
+export function linkifyContent(text: string, mentionedUsernames?: string[]): string {
   if (!text) return "";
+  const allowedMentions = mentionedUsernames
+    ? new Set(mentionedUsernames.map((u) => u.toLowerCase()))
+    : null;
 
   const options = {
     // Attributes to add to the generated <a> tags
@@ -43,7 +48,10 @@ export function linkifyContent(text: string): string {
       }
       return "";
     },
-    validate: true,
+    validate: {
+      mention: (value: string) =>
+        !allowedMentions || allowedMentions.has(value.substring(1).toLowerCase()),
+    },
     nl2br: true,
   };
 